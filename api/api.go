@@ -0,0 +1,5604 @@
+// Code generated by monday-apigen. DO NOT EDIT.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/di-wu/monday"
+	"github.com/di-wu/monday/introspection"
+)
+
+// Your monday.com account.
+type Account struct {
+	// The first day of the week for the account.
+	FirstDayOfTheWeek FirstDayOfTheWeek `json:"first_day_of_the_week,omitempty"`
+	// The account's unique identifier.
+	ID int `json:"id,omitempty"`
+	// The account's logo.
+	Logo string `json:"logo,omitempty"`
+	// The account's name.
+	Name string `json:"name,omitempty"`
+	// The account's payment plan.
+	Plan *Plan `json:"plan,omitempty"`
+	// Show weekends in the timeline.
+	ShowTimelineWeekends bool `json:"show_timeline_weekends,omitempty"`
+	// The account's slug.
+	Slug string `json:"slug,omitempty"`
+}
+
+// The Account's graphql field(s).
+type AccountField struct {
+	field monday.Field
+}
+
+func accountFields(fields []AccountField) []monday.Field {
+	var selection []monday.Field
+	for _, f := range fields {
+		selection = append(selection, f.field)
+	}
+	return selection
+}
+
+// The Account's graphql fragment, a named selection of its fields that is defined once per payload.
+type AccountFragment struct {
+	fragment monday.Fragment
+}
+
+// NewAccountFragment returns the fragment with the given name of the Account's fields.
+func NewAccountFragment(name string, fields []AccountField) AccountFragment {
+	return AccountFragment{monday.NewFragment(name, "Account", accountFields(fields))}
+}
+
+// AccountFragmentField spreads the fragment of the Account's fields.
+func AccountFragmentField(fragment AccountFragment) AccountField {
+	return AccountField{fragment.fragment.Spread()}
+}
+
+// The first day of the week for the account.
+func AccountFirstDayOfTheWeekField() AccountField {
+	return AccountField{monday.NewField("first_day_of_the_week", nil)}
+}
+
+// The account's unique identifier.
+func AccountIDField() AccountField {
+	return AccountField{monday.NewField("id", nil)}
+}
+
+// The account's logo.
+func AccountLogoField() AccountField {
+	return AccountField{monday.NewField("logo", nil)}
+}
+
+// The account's name.
+func AccountNameField() AccountField {
+	return AccountField{monday.NewField("name", nil)}
+}
+
+// The account's payment plan.
+func NewAccountPlanField(fields []PlanField) AccountField {
+	arguments := []monday.Argument{}
+	return AccountField{monday.NewObjectField("plan", planFields(fields), arguments)}
+}
+
+// Show weekends in the timeline.
+func AccountShowTimelineWeekendsField() AccountField {
+	return AccountField{monday.NewField("show_timeline_weekends", nil)}
+}
+
+// The account's slug.
+func AccountSlugField() AccountField {
+	return AccountField{monday.NewField("slug", nil)}
+}
+
+// A monday.com board.
+type Board struct {
+	// The board's folder unique identifier.
+	BoardFolderID int `json:"board_folder_id,omitempty"`
+	// The board's kind (public / private / share).
+	BoardKind BoardKind `json:"board_kind,omitempty"`
+	// The board's visible columns.
+	Columns []Column `json:"columns,omitempty"`
+	// The board's description.
+	Description string `json:"description,omitempty"`
+	// The board's visible groups.
+	Groups []Group `json:"groups,omitempty"`
+	// The unique identifier of the board.
+	ID string `json:"id,omitempty"`
+	// The board's items (rows).
+	Items []Item `json:"items,omitempty"`
+	// The board's name.
+	Name string `json:"name,omitempty"`
+	// The owner of the board.
+	Owner *User `json:"owner,omitempty"`
+	// The board's permissions.
+	Permissions string `json:"permissions,omitempty"`
+	// The board's position.
+	Pos string `json:"pos,omitempty"`
+	// The board's state (all / active / archived / deleted).
+	State State `json:"state,omitempty"`
+	// The board's subscribers.
+	Subscribers []User `json:"subscribers,omitempty"`
+	// The board's specific tags.
+	Tags []Tag `json:"tags,omitempty"`
+	// The board's updates.
+	Updates []Update `json:"updates,omitempty"`
+	// The workspace that contains this board.
+	Workspace *Workspace `json:"workspace,omitempty"`
+	// The board's workspace unique identifier.
+	WorkspaceID int `json:"workspace_id,omitempty"`
+}
+
+// The Board's graphql field(s).
+type BoardField struct {
+	field monday.Field
+}
+
+func boardFields(fields []BoardField) []monday.Field {
+	var selection []monday.Field
+	for _, f := range fields {
+		selection = append(selection, f.field)
+	}
+	return selection
+}
+
+// The Board's graphql fragment, a named selection of its fields that is defined once per payload.
+type BoardFragment struct {
+	fragment monday.Fragment
+}
+
+// NewBoardFragment returns the fragment with the given name of the Board's fields.
+func NewBoardFragment(name string, fields []BoardField) BoardFragment {
+	return BoardFragment{monday.NewFragment(name, "Board", boardFields(fields))}
+}
+
+// BoardFragmentField spreads the fragment of the Board's fields.
+func BoardFragmentField(fragment BoardFragment) BoardField {
+	return BoardField{fragment.fragment.Spread()}
+}
+
+// The board's folder unique identifier.
+func BoardBoardFolderIDField() BoardField {
+	return BoardField{monday.NewField("board_folder_id", nil)}
+}
+
+// The board's kind (public / private / share).
+func BoardBoardKindField() BoardField {
+	return BoardField{monday.NewField("board_kind", nil)}
+}
+
+// The board's visible columns.
+func NewBoardColumnsField(fields []ColumnField, args ...BoardColumnsArgument) BoardField {
+	arguments := []monday.Argument{}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return BoardField{monday.NewObjectField("columns", columnFields(fields), arguments)}
+}
+
+// The Board's columns field's graphql argument(s).
+type BoardColumnsArgument struct {
+	argument monday.Argument
+}
+
+// The ids argument.
+func NewBoardColumnsIDsArgument(value []string) BoardColumnsArgument {
+	return BoardColumnsArgument{monday.NewArgument("ids", value)}
+}
+
+// The board's description.
+func BoardDescriptionField() BoardField {
+	return BoardField{monday.NewField("description", nil)}
+}
+
+// The board's visible groups.
+func NewBoardGroupsField(fields []GroupField, args ...BoardGroupsArgument) BoardField {
+	arguments := []monday.Argument{}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return BoardField{monday.NewObjectField("groups", groupFields(fields), arguments)}
+}
+
+// The Board's groups field's graphql argument(s).
+type BoardGroupsArgument struct {
+	argument monday.Argument
+}
+
+// The ids argument.
+func NewBoardGroupsIDsArgument(value []string) BoardGroupsArgument {
+	return BoardGroupsArgument{monday.NewArgument("ids", value)}
+}
+
+// The unique identifier of the board.
+func BoardIDField() BoardField {
+	return BoardField{monday.NewField("id", nil)}
+}
+
+// The board's items (rows).
+func NewBoardItemsField(fields []ItemField, args ...BoardItemsArgument) BoardField {
+	arguments := []monday.Argument{}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return BoardField{monday.NewObjectField("items", itemFields(fields), arguments)}
+}
+
+// The Board's items field's graphql argument(s).
+type BoardItemsArgument struct {
+	argument monday.Argument
+}
+
+// The ids argument.
+func NewBoardItemsIDsArgument(value []int) BoardItemsArgument {
+	return BoardItemsArgument{monday.NewArgument("ids", value)}
+}
+
+// The limit argument.
+func NewBoardItemsLimitArgument(value int) BoardItemsArgument {
+	return BoardItemsArgument{monday.NewArgument("limit", value)}
+}
+
+// The page argument.
+func NewBoardItemsPageArgument(value int) BoardItemsArgument {
+	return BoardItemsArgument{monday.NewArgument("page", value)}
+}
+
+// The newest_first argument.
+func NewBoardItemsNewestFirstArgument(value bool) BoardItemsArgument {
+	return BoardItemsArgument{monday.NewArgument("newest_first", value)}
+}
+
+// The board's name.
+func BoardNameField() BoardField {
+	return BoardField{monday.NewField("name", nil)}
+}
+
+// The owner of the board.
+func NewBoardOwnerField(fields []UserField) BoardField {
+	arguments := []monday.Argument{}
+	return BoardField{monday.NewObjectField("owner", userFields(fields), arguments)}
+}
+
+// The board's permissions.
+func BoardPermissionsField() BoardField {
+	return BoardField{monday.NewField("permissions", nil)}
+}
+
+// The board's position.
+func BoardPosField() BoardField {
+	return BoardField{monday.NewField("pos", nil)}
+}
+
+// The board's state (all / active / archived / deleted).
+func BoardStateField() BoardField {
+	return BoardField{monday.NewField("state", nil)}
+}
+
+// The board's subscribers.
+func NewBoardSubscribersField(fields []UserField) BoardField {
+	arguments := []monday.Argument{}
+	return BoardField{monday.NewObjectField("subscribers", userFields(fields), arguments)}
+}
+
+// The board's specific tags.
+func NewBoardTagsField(fields []TagField) BoardField {
+	arguments := []monday.Argument{}
+	return BoardField{monday.NewObjectField("tags", tagFields(fields), arguments)}
+}
+
+// The board's updates.
+func NewBoardUpdatesField(fields []UpdateField, args ...BoardUpdatesArgument) BoardField {
+	arguments := []monday.Argument{}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return BoardField{monday.NewObjectField("updates", updateFields(fields), arguments)}
+}
+
+// The Board's updates field's graphql argument(s).
+type BoardUpdatesArgument struct {
+	argument monday.Argument
+}
+
+// The limit argument.
+func NewBoardUpdatesLimitArgument(value int) BoardUpdatesArgument {
+	return BoardUpdatesArgument{monday.NewArgument("limit", value)}
+}
+
+// The page argument.
+func NewBoardUpdatesPageArgument(value int) BoardUpdatesArgument {
+	return BoardUpdatesArgument{monday.NewArgument("page", value)}
+}
+
+// The workspace that contains this board.
+func NewBoardWorkspaceField(fields []WorkspaceField) BoardField {
+	arguments := []monday.Argument{}
+	return BoardField{monday.NewObjectField("workspace", workspaceFields(fields), arguments)}
+}
+
+// The board's workspace unique identifier.
+func BoardWorkspaceIDField() BoardField {
+	return BoardField{monday.NewField("workspace_id", nil)}
+}
+
+// The board attributes available.
+type BoardAttributes struct {
+	value string
+}
+
+// communication value of BoardAttributes.
+func BoardAttributesCommunication() BoardAttributes {
+	return BoardAttributes{"communication"}
+}
+
+// description value of BoardAttributes.
+func BoardAttributesDescription() BoardAttributes {
+	return BoardAttributes{"description"}
+}
+
+// name value of BoardAttributes.
+func BoardAttributesName() BoardAttributes {
+	return BoardAttributes{"name"}
+}
+
+// String returns the value as it appears in a query.
+func (v BoardAttributes) String() string {
+	return v.value
+}
+
+// EnumValue returns the value as it appears in a query.
+func (v BoardAttributes) EnumValue() string {
+	return v.value
+}
+
+// MarshalJSON encodes the value as a json string.
+func (v BoardAttributes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.value)
+}
+
+// UnmarshalJSON decodes the value from a json string.
+func (v *BoardAttributes) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &v.value)
+}
+
+// The board access level.
+type BoardKind struct {
+	value string
+}
+
+// private value of BoardKind.
+func BoardKindPrivate() BoardKind {
+	return BoardKind{"private"}
+}
+
+// public value of BoardKind.
+func BoardKindPublic() BoardKind {
+	return BoardKind{"public"}
+}
+
+// share value of BoardKind.
+func BoardKindShare() BoardKind {
+	return BoardKind{"share"}
+}
+
+// String returns the value as it appears in a query.
+func (v BoardKind) String() string {
+	return v.value
+}
+
+// EnumValue returns the value as it appears in a query.
+func (v BoardKind) EnumValue() string {
+	return v.value
+}
+
+// MarshalJSON encodes the value as a json string.
+func (v BoardKind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.value)
+}
+
+// UnmarshalJSON decodes the value from a json string.
+func (v *BoardKind) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &v.value)
+}
+
+// A column of a board.
+type Column struct {
+	// Is the column archived or not.
+	Archived bool `json:"archived,omitempty"`
+	// The column's description.
+	Description string `json:"description,omitempty"`
+	// The column's unique identifier.
+	ID string `json:"id,omitempty"`
+	// The column's settings in a string form.
+	SettingsStr string `json:"settings_str,omitempty"`
+	// The column's title.
+	Title string `json:"title,omitempty"`
+	// The column's type.
+	Type string `json:"type,omitempty"`
+	// The column's width.
+	Width int `json:"width,omitempty"`
+}
+
+// The Column's graphql field(s).
+type ColumnField struct {
+	field monday.Field
+}
+
+func columnFields(fields []ColumnField) []monday.Field {
+	var selection []monday.Field
+	for _, f := range fields {
+		selection = append(selection, f.field)
+	}
+	return selection
+}
+
+// The Column's graphql fragment, a named selection of its fields that is defined once per payload.
+type ColumnFragment struct {
+	fragment monday.Fragment
+}
+
+// NewColumnFragment returns the fragment with the given name of the Column's fields.
+func NewColumnFragment(name string, fields []ColumnField) ColumnFragment {
+	return ColumnFragment{monday.NewFragment(name, "Column", columnFields(fields))}
+}
+
+// ColumnFragmentField spreads the fragment of the Column's fields.
+func ColumnFragmentField(fragment ColumnFragment) ColumnField {
+	return ColumnField{fragment.fragment.Spread()}
+}
+
+// Is the column archived or not.
+func ColumnArchivedField() ColumnField {
+	return ColumnField{monday.NewField("archived", nil)}
+}
+
+// The column's description.
+func ColumnDescriptionField() ColumnField {
+	return ColumnField{monday.NewField("description", nil)}
+}
+
+// The column's unique identifier.
+func ColumnIDField() ColumnField {
+	return ColumnField{monday.NewField("id", nil)}
+}
+
+// The column's settings in a string form.
+func ColumnSettingsStrField() ColumnField {
+	return ColumnField{monday.NewField("settings_str", nil)}
+}
+
+// The column's title.
+func ColumnTitleField() ColumnField {
+	return ColumnField{monday.NewField("title", nil)}
+}
+
+// The column's type.
+func ColumnTypeField() ColumnField {
+	return ColumnField{monday.NewField("type", nil)}
+}
+
+// The column's width.
+func ColumnWidthField() ColumnField {
+	return ColumnField{monday.NewField("width", nil)}
+}
+
+// The mapping of a column of the source board to a column of the target board.
+type ColumnMappingInput struct {
+	// The unique identifier of the column on the source board.
+	Source int `json:"source"`
+	// The unique identifier of the column on the target board, its values are dropped if empty.
+	Target int `json:"target,omitempty"`
+}
+
+// NewColumnMappingInput returns the ColumnMappingInput input object with the required fields, optional fields can be set on the result.
+func NewColumnMappingInput(source int) ColumnMappingInput {
+	return ColumnMappingInput{Source: source}
+}
+
+// The columns properties available.
+type ColumnProperty struct {
+	value string
+}
+
+// description value of ColumnProperty.
+func ColumnPropertyDescription() ColumnProperty {
+	return ColumnProperty{"description"}
+}
+
+// title value of ColumnProperty.
+func ColumnPropertyTitle() ColumnProperty {
+	return ColumnProperty{"title"}
+}
+
+// String returns the value as it appears in a query.
+func (v ColumnProperty) String() string {
+	return v.value
+}
+
+// EnumValue returns the value as it appears in a query.
+func (v ColumnProperty) EnumValue() string {
+	return v.value
+}
+
+// MarshalJSON encodes the value as a json string.
+func (v ColumnProperty) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.value)
+}
+
+// UnmarshalJSON decodes the value from a json string.
+func (v *ColumnProperty) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &v.value)
+}
+
+// The columns types available.
+type ColumnType struct {
+	value string
+}
+
+// auto_number value of ColumnType.
+func ColumnTypeAutoNumber() ColumnType {
+	return ColumnType{"auto_number"}
+}
+
+// checkbox value of ColumnType.
+func ColumnTypeCheckbox() ColumnType {
+	return ColumnType{"checkbox"}
+}
+
+// color_picker value of ColumnType.
+func ColumnTypeColorPicker() ColumnType {
+	return ColumnType{"color_picker"}
+}
+
+// country value of ColumnType.
+func ColumnTypeCountry() ColumnType {
+	return ColumnType{"country"}
+}
+
+// creation_log value of ColumnType.
+func ColumnTypeCreationLog() ColumnType {
+	return ColumnType{"creation_log"}
+}
+
+// date value of ColumnType.
+func ColumnTypeDate() ColumnType {
+	return ColumnType{"date"}
+}
+
+// dropdown value of ColumnType.
+func ColumnTypeDropdown() ColumnType {
+	return ColumnType{"dropdown"}
+}
+
+// email value of ColumnType.
+func ColumnTypeEmail() ColumnType {
+	return ColumnType{"email"}
+}
+
+// hour value of ColumnType.
+func ColumnTypeHour() ColumnType {
+	return ColumnType{"hour"}
+}
+
+// item_id value of ColumnType.
+func ColumnTypeItemID() ColumnType {
+	return ColumnType{"item_id"}
+}
+
+// last_updated value of ColumnType.
+func ColumnTypeLastUpdated() ColumnType {
+	return ColumnType{"last_updated"}
+}
+
+// link value of ColumnType.
+func ColumnTypeLink() ColumnType {
+	return ColumnType{"link"}
+}
+
+// location value of ColumnType.
+func ColumnTypeLocation() ColumnType {
+	return ColumnType{"location"}
+}
+
+// long_text value of ColumnType.
+func ColumnTypeLongText() ColumnType {
+	return ColumnType{"long_text"}
+}
+
+// numbers value of ColumnType.
+func ColumnTypeNumbers() ColumnType {
+	return ColumnType{"numbers"}
+}
+
+// people value of ColumnType.
+func ColumnTypePeople() ColumnType {
+	return ColumnType{"people"}
+}
+
+// phone value of ColumnType.
+func ColumnTypePhone() ColumnType {
+	return ColumnType{"phone"}
+}
+
+// progress value of ColumnType.
+func ColumnTypeProgress() ColumnType {
+	return ColumnType{"progress"}
+}
+
+// rating value of ColumnType.
+func ColumnTypeRating() ColumnType {
+	return ColumnType{"rating"}
+}
+
+// status value of ColumnType.
+func ColumnTypeStatus() ColumnType {
+	return ColumnType{"status"}
+}
+
+// tags value of ColumnType.
+func ColumnTypeTags() ColumnType {
+	return ColumnType{"tags"}
+}
+
+// team value of ColumnType.
+func ColumnTypeTeam() ColumnType {
+	return ColumnType{"team"}
+}
+
+// text value of ColumnType.
+func ColumnTypeText() ColumnType {
+	return ColumnType{"text"}
+}
+
+// time_tracking value of ColumnType.
+func ColumnTypeTimeTracking() ColumnType {
+	return ColumnType{"time_tracking"}
+}
+
+// timeline value of ColumnType.
+func ColumnTypeTimeline() ColumnType {
+	return ColumnType{"timeline"}
+}
+
+// vote value of ColumnType.
+func ColumnTypeVote() ColumnType {
+	return ColumnType{"vote"}
+}
+
+// week value of ColumnType.
+func ColumnTypeWeek() ColumnType {
+	return ColumnType{"week"}
+}
+
+// world_clock value of ColumnType.
+func ColumnTypeWorldClock() ColumnType {
+	return ColumnType{"world_clock"}
+}
+
+// String returns the value as it appears in a query.
+func (v ColumnType) String() string {
+	return v.value
+}
+
+// EnumValue returns the value as it appears in a query.
+func (v ColumnType) EnumValue() string {
+	return v.value
+}
+
+// MarshalJSON encodes the value as a json string.
+func (v ColumnType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.value)
+}
+
+// UnmarshalJSON decodes the value from a json string.
+func (v *ColumnType) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &v.value)
+}
+
+// The value of an item's column.
+type ColumnValue struct {
+	// The column value's additional information.
+	AdditionalInfo string `json:"additional_info,omitempty"`
+	// The column's unique identifier.
+	ID string `json:"id,omitempty"`
+	// The column's textual value in string form.
+	Text string `json:"text,omitempty"`
+	// The column's title.
+	Title string `json:"title,omitempty"`
+	// The column's type.
+	Type string `json:"type,omitempty"`
+	// The column's raw value in JSON format.
+	Value string `json:"value,omitempty"`
+}
+
+// The ColumnValue's graphql field(s).
+type ColumnValueField struct {
+	field monday.Field
+}
+
+func columnValueFields(fields []ColumnValueField) []monday.Field {
+	var selection []monday.Field
+	for _, f := range fields {
+		selection = append(selection, f.field)
+	}
+	return selection
+}
+
+// The ColumnValue's graphql fragment, a named selection of its fields that is defined once per payload.
+type ColumnValueFragment struct {
+	fragment monday.Fragment
+}
+
+// NewColumnValueFragment returns the fragment with the given name of the ColumnValue's fields.
+func NewColumnValueFragment(name string, fields []ColumnValueField) ColumnValueFragment {
+	return ColumnValueFragment{monday.NewFragment(name, "ColumnValue", columnValueFields(fields))}
+}
+
+// ColumnValueFragmentField spreads the fragment of the ColumnValue's fields.
+func ColumnValueFragmentField(fragment ColumnValueFragment) ColumnValueField {
+	return ColumnValueField{fragment.fragment.Spread()}
+}
+
+// The column value's additional information.
+func ColumnValueAdditionalInfoField() ColumnValueField {
+	return ColumnValueField{monday.NewField("additional_info", nil)}
+}
+
+// The column's unique identifier.
+func ColumnValueIDField() ColumnValueField {
+	return ColumnValueField{monday.NewField("id", nil)}
+}
+
+// The column's textual value in string form.
+func ColumnValueTextField() ColumnValueField {
+	return ColumnValueField{monday.NewField("text", nil)}
+}
+
+// The column's title.
+func ColumnValueTitleField() ColumnValueField {
+	return ColumnValueField{monday.NewField("title", nil)}
+}
+
+// The column's type.
+func ColumnValueTypeField() ColumnValueField {
+	return ColumnValueField{monday.NewField("type", nil)}
+}
+
+// The column's raw value in JSON format.
+func ColumnValueValueField() ColumnValueField {
+	return ColumnValueField{monday.NewField("value", nil)}
+}
+
+// The complexity data of the query.
+type Complexity struct {
+	// The remainder of complexity after the query's execution.
+	After int `json:"after,omitempty"`
+	// The remainder of complexity before the query's execution.
+	Before int `json:"before,omitempty"`
+	// The specific query's complexity.
+	Query int `json:"query,omitempty"`
+}
+
+// The Complexity's graphql field(s).
+type ComplexityField struct {
+	field monday.Field
+}
+
+func complexityFields(fields []ComplexityField) []monday.Field {
+	var selection []monday.Field
+	for _, f := range fields {
+		selection = append(selection, f.field)
+	}
+	return selection
+}
+
+// The Complexity's graphql fragment, a named selection of its fields that is defined once per payload.
+type ComplexityFragment struct {
+	fragment monday.Fragment
+}
+
+// NewComplexityFragment returns the fragment with the given name of the Complexity's fields.
+func NewComplexityFragment(name string, fields []ComplexityField) ComplexityFragment {
+	return ComplexityFragment{monday.NewFragment(name, "Complexity", complexityFields(fields))}
+}
+
+// ComplexityFragmentField spreads the fragment of the Complexity's fields.
+func ComplexityFragmentField(fragment ComplexityFragment) ComplexityField {
+	return ComplexityField{fragment.fragment.Spread()}
+}
+
+// The remainder of complexity after the query's execution.
+func ComplexityAfterField() ComplexityField {
+	return ComplexityField{monday.NewField("after", nil)}
+}
+
+// The remainder of complexity before the query's execution.
+func ComplexityBeforeField() ComplexityField {
+	return ComplexityField{monday.NewField("before", nil)}
+}
+
+// The specific query's complexity.
+func ComplexityQueryField() ComplexityField {
+	return ComplexityField{monday.NewField("query", nil)}
+}
+
+// A monday.com doc.
+type Document struct {
+	// The document's content blocks.
+	Blocks []DocumentBlock `json:"blocks,omitempty"`
+	// The document's creation date.
+	CreatedAt string `json:"created_at,omitempty"`
+	// The document's creator.
+	CreatedBy *User `json:"created_by,omitempty"`
+	// The document's kind (public / private / share).
+	DocKind BoardKind `json:"doc_kind,omitempty"`
+	// The document's unique identifier.
+	ID string `json:"id,omitempty"`
+	// The document's name.
+	Name string `json:"name,omitempty"`
+	// The associated board or object's unique identifier.
+	ObjectID string `json:"object_id,omitempty"`
+	// The document's url.
+	URL string `json:"url,omitempty"`
+	// The workspace's unique identifier.
+	WorkspaceID int `json:"workspace_id,omitempty"`
+}
+
+// The Document's graphql field(s).
+type DocumentField struct {
+	field monday.Field
+}
+
+func documentFields(fields []DocumentField) []monday.Field {
+	var selection []monday.Field
+	for _, f := range fields {
+		selection = append(selection, f.field)
+	}
+	return selection
+}
+
+// The Document's graphql fragment, a named selection of its fields that is defined once per payload.
+type DocumentFragment struct {
+	fragment monday.Fragment
+}
+
+// NewDocumentFragment returns the fragment with the given name of the Document's fields.
+func NewDocumentFragment(name string, fields []DocumentField) DocumentFragment {
+	return DocumentFragment{monday.NewFragment(name, "Document", documentFields(fields))}
+}
+
+// DocumentFragmentField spreads the fragment of the Document's fields.
+func DocumentFragmentField(fragment DocumentFragment) DocumentField {
+	return DocumentField{fragment.fragment.Spread()}
+}
+
+// The document's content blocks.
+func NewDocumentBlocksField(fields []DocumentBlockField, args ...DocumentBlocksArgument) DocumentField {
+	arguments := []monday.Argument{}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return DocumentField{monday.NewObjectField("blocks", documentBlockFields(fields), arguments)}
+}
+
+// The Document's blocks field's graphql argument(s).
+type DocumentBlocksArgument struct {
+	argument monday.Argument
+}
+
+// The limit argument.
+func NewDocumentBlocksLimitArgument(value int) DocumentBlocksArgument {
+	return DocumentBlocksArgument{monday.NewArgument("limit", value)}
+}
+
+// The page argument.
+func NewDocumentBlocksPageArgument(value int) DocumentBlocksArgument {
+	return DocumentBlocksArgument{monday.NewArgument("page", value)}
+}
+
+// The document's creation date.
+func DocumentCreatedAtField() DocumentField {
+	return DocumentField{monday.NewField("created_at", nil)}
+}
+
+// The document's creator.
+func NewDocumentCreatedByField(fields []UserField) DocumentField {
+	arguments := []monday.Argument{}
+	return DocumentField{monday.NewObjectField("created_by", userFields(fields), arguments)}
+}
+
+// The document's kind (public / private / share).
+func DocumentDocKindField() DocumentField {
+	return DocumentField{monday.NewField("doc_kind", nil)}
+}
+
+// The document's unique identifier.
+func DocumentIDField() DocumentField {
+	return DocumentField{monday.NewField("id", nil)}
+}
+
+// The document's name.
+func DocumentNameField() DocumentField {
+	return DocumentField{monday.NewField("name", nil)}
+}
+
+// The associated board or object's unique identifier.
+func DocumentObjectIDField() DocumentField {
+	return DocumentField{monday.NewField("object_id", nil)}
+}
+
+// The document's url.
+func DocumentURLField() DocumentField {
+	return DocumentField{monday.NewField("url", nil)}
+}
+
+// The workspace's unique identifier.
+func DocumentWorkspaceIDField() DocumentField {
+	return DocumentField{monday.NewField("workspace_id", nil)}
+}
+
+// A content block of a doc.
+type DocumentBlock struct {
+	// The block's content.
+	Content string `json:"content,omitempty"`
+	// The block's creation date.
+	CreatedAt string `json:"created_at,omitempty"`
+	// The block's unique identifier.
+	ID string `json:"id,omitempty"`
+	// The block's parent block unique identifier.
+	ParentBlockID string `json:"parent_block_id,omitempty"`
+	// The block's content type.
+	Type string `json:"type,omitempty"`
+}
+
+// The DocumentBlock's graphql field(s).
+type DocumentBlockField struct {
+	field monday.Field
+}
+
+func documentBlockFields(fields []DocumentBlockField) []monday.Field {
+	var selection []monday.Field
+	for _, f := range fields {
+		selection = append(selection, f.field)
+	}
+	return selection
+}
+
+// The DocumentBlock's graphql fragment, a named selection of its fields that is defined once per payload.
+type DocumentBlockFragment struct {
+	fragment monday.Fragment
+}
+
+// NewDocumentBlockFragment returns the fragment with the given name of the DocumentBlock's fields.
+func NewDocumentBlockFragment(name string, fields []DocumentBlockField) DocumentBlockFragment {
+	return DocumentBlockFragment{monday.NewFragment(name, "DocumentBlock", documentBlockFields(fields))}
+}
+
+// DocumentBlockFragmentField spreads the fragment of the DocumentBlock's fields.
+func DocumentBlockFragmentField(fragment DocumentBlockFragment) DocumentBlockField {
+	return DocumentBlockField{fragment.fragment.Spread()}
+}
+
+// The block's content.
+func DocumentBlockContentField() DocumentBlockField {
+	return DocumentBlockField{monday.NewField("content", nil)}
+}
+
+// The block's creation date.
+func DocumentBlockCreatedAtField() DocumentBlockField {
+	return DocumentBlockField{monday.NewField("created_at", nil)}
+}
+
+// The block's unique identifier.
+func DocumentBlockIDField() DocumentBlockField {
+	return DocumentBlockField{monday.NewField("id", nil)}
+}
+
+// The block's parent block unique identifier.
+func DocumentBlockParentBlockIDField() DocumentBlockField {
+	return DocumentBlockField{monday.NewField("parent_block_id", nil)}
+}
+
+// The block's content type.
+func DocumentBlockTypeField() DocumentBlockField {
+	return DocumentBlockField{monday.NewField("type", nil)}
+}
+
+// The first day of work week.
+type FirstDayOfTheWeek struct {
+	value string
+}
+
+// monday value of FirstDayOfTheWeek.
+func FirstDayOfTheWeekMonday() FirstDayOfTheWeek {
+	return FirstDayOfTheWeek{"monday"}
+}
+
+// sunday value of FirstDayOfTheWeek.
+func FirstDayOfTheWeekSunday() FirstDayOfTheWeek {
+	return FirstDayOfTheWeek{"sunday"}
+}
+
+// String returns the value as it appears in a query.
+func (v FirstDayOfTheWeek) String() string {
+	return v.value
+}
+
+// EnumValue returns the value as it appears in a query.
+func (v FirstDayOfTheWeek) EnumValue() string {
+	return v.value
+}
+
+// MarshalJSON encodes the value as a json string.
+func (v FirstDayOfTheWeek) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.value)
+}
+
+// UnmarshalJSON decodes the value from a json string.
+func (v *FirstDayOfTheWeek) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &v.value)
+}
+
+// A group of items in a board.
+type Group struct {
+	// Is the group archived or not.
+	Archived bool `json:"archived,omitempty"`
+	// The group's color.
+	Color string `json:"color,omitempty"`
+	// Is the group deleted or not.
+	Deleted bool `json:"deleted,omitempty"`
+	// The group's unique identifier.
+	ID string `json:"id,omitempty"`
+	// The items in the group.
+	Items []Item `json:"items,omitempty"`
+	// The group's position in the board.
+	Position string `json:"position,omitempty"`
+	// The group's title.
+	Title string `json:"title,omitempty"`
+}
+
+// The Group's graphql field(s).
+type GroupField struct {
+	field monday.Field
+}
+
+func groupFields(fields []GroupField) []monday.Field {
+	var selection []monday.Field
+	for _, f := range fields {
+		selection = append(selection, f.field)
+	}
+	return selection
+}
+
+// The Group's graphql fragment, a named selection of its fields that is defined once per payload.
+type GroupFragment struct {
+	fragment monday.Fragment
+}
+
+// NewGroupFragment returns the fragment with the given name of the Group's fields.
+func NewGroupFragment(name string, fields []GroupField) GroupFragment {
+	return GroupFragment{monday.NewFragment(name, "Group", groupFields(fields))}
+}
+
+// GroupFragmentField spreads the fragment of the Group's fields.
+func GroupFragmentField(fragment GroupFragment) GroupField {
+	return GroupField{fragment.fragment.Spread()}
+}
+
+// Is the group archived or not.
+func GroupArchivedField() GroupField {
+	return GroupField{monday.NewField("archived", nil)}
+}
+
+// The group's color.
+func GroupColorField() GroupField {
+	return GroupField{monday.NewField("color", nil)}
+}
+
+// Is the group deleted or not.
+func GroupDeletedField() GroupField {
+	return GroupField{monday.NewField("deleted", nil)}
+}
+
+// The group's unique identifier.
+func GroupIDField() GroupField {
+	return GroupField{monday.NewField("id", nil)}
+}
+
+// The items in the group.
+func NewGroupItemsField(fields []ItemField, args ...GroupItemsArgument) GroupField {
+	arguments := []monday.Argument{}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return GroupField{monday.NewObjectField("items", itemFields(fields), arguments)}
+}
+
+// The Group's items field's graphql argument(s).
+type GroupItemsArgument struct {
+	argument monday.Argument
+}
+
+// The ids argument.
+func NewGroupItemsIDsArgument(value []int) GroupItemsArgument {
+	return GroupItemsArgument{monday.NewArgument("ids", value)}
+}
+
+// The limit argument.
+func NewGroupItemsLimitArgument(value int) GroupItemsArgument {
+	return GroupItemsArgument{monday.NewArgument("limit", value)}
+}
+
+// The page argument.
+func NewGroupItemsPageArgument(value int) GroupItemsArgument {
+	return GroupItemsArgument{monday.NewArgument("page", value)}
+}
+
+// The newest_first argument.
+func NewGroupItemsNewestFirstArgument(value bool) GroupItemsArgument {
+	return GroupItemsArgument{monday.NewArgument("newest_first", value)}
+}
+
+// The group's position in the board.
+func GroupPositionField() GroupField {
+	return GroupField{monday.NewField("position", nil)}
+}
+
+// The group's title.
+func GroupTitleField() GroupField {
+	return GroupField{monday.NewField("title", nil)}
+}
+
+// The group attributes available.
+type GroupAttributes struct {
+	value string
+}
+
+// color value of GroupAttributes.
+func GroupAttributesColor() GroupAttributes {
+	return GroupAttributes{"color"}
+}
+
+// position value of GroupAttributes.
+func GroupAttributesPosition() GroupAttributes {
+	return GroupAttributes{"position"}
+}
+
+// relative_position_after value of GroupAttributes.
+func GroupAttributesRelativePositionAfter() GroupAttributes {
+	return GroupAttributes{"relative_position_after"}
+}
+
+// relative_position_before value of GroupAttributes.
+func GroupAttributesRelativePositionBefore() GroupAttributes {
+	return GroupAttributes{"relative_position_before"}
+}
+
+// title value of GroupAttributes.
+func GroupAttributesTitle() GroupAttributes {
+	return GroupAttributes{"title"}
+}
+
+// String returns the value as it appears in a query.
+func (v GroupAttributes) String() string {
+	return v.value
+}
+
+// EnumValue returns the value as it appears in a query.
+func (v GroupAttributes) EnumValue() string {
+	return v.value
+}
+
+// MarshalJSON encodes the value as a json string.
+func (v GroupAttributes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.value)
+}
+
+// UnmarshalJSON decodes the value from a json string.
+func (v *GroupAttributes) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &v.value)
+}
+
+// An item (row) of a board.
+type Item struct {
+	// The board that contains this item.
+	Board *Board `json:"board,omitempty"`
+	// The item's column values.
+	ColumnValues []ColumnValue `json:"column_values,omitempty"`
+	// The item's creation date.
+	CreatedAt string `json:"created_at,omitempty"`
+	// The item's creator.
+	Creator *User `json:"creator,omitempty"`
+	// The unique identifier of the item creator.
+	CreatorID string `json:"creator_id,omitempty"`
+	// The group that contains this item.
+	Group *Group `json:"group,omitempty"`
+	// The item's unique identifier.
+	ID string `json:"id,omitempty"`
+	// The item's name.
+	Name string `json:"name,omitempty"`
+	// The board's state (all / active / archived / deleted).
+	State State `json:"state,omitempty"`
+	// The item's subitems.
+	Subitems []Item `json:"subitems,omitempty"`
+	// The pulses's subscribers.
+	Subscribers []User `json:"subscribers,omitempty"`
+	// The item's last update date.
+	UpdatedAt string `json:"updated_at,omitempty"`
+	// The item's updates.
+	Updates []Update `json:"updates,omitempty"`
+}
+
+// The Item's graphql field(s).
+type ItemField struct {
+	field monday.Field
+}
+
+func itemFields(fields []ItemField) []monday.Field {
+	var selection []monday.Field
+	for _, f := range fields {
+		selection = append(selection, f.field)
+	}
+	return selection
+}
+
+// The Item's graphql fragment, a named selection of its fields that is defined once per payload.
+type ItemFragment struct {
+	fragment monday.Fragment
+}
+
+// NewItemFragment returns the fragment with the given name of the Item's fields.
+func NewItemFragment(name string, fields []ItemField) ItemFragment {
+	return ItemFragment{monday.NewFragment(name, "Item", itemFields(fields))}
+}
+
+// ItemFragmentField spreads the fragment of the Item's fields.
+func ItemFragmentField(fragment ItemFragment) ItemField {
+	return ItemField{fragment.fragment.Spread()}
+}
+
+// The board that contains this item.
+func NewItemBoardField(fields []BoardField) ItemField {
+	arguments := []monday.Argument{}
+	return ItemField{monday.NewObjectField("board", boardFields(fields), arguments)}
+}
+
+// The item's column values.
+func NewItemColumnValuesField(fields []ColumnValueField, args ...ItemColumnValuesArgument) ItemField {
+	arguments := []monday.Argument{}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return ItemField{monday.NewObjectField("column_values", columnValueFields(fields), arguments)}
+}
+
+// The Item's column_values field's graphql argument(s).
+type ItemColumnValuesArgument struct {
+	argument monday.Argument
+}
+
+// The ids argument.
+func NewItemColumnValuesIDsArgument(value []string) ItemColumnValuesArgument {
+	return ItemColumnValuesArgument{monday.NewArgument("ids", value)}
+}
+
+// The item's creation date.
+func ItemCreatedAtField() ItemField {
+	return ItemField{monday.NewField("created_at", nil)}
+}
+
+// The item's creator.
+func NewItemCreatorField(fields []UserField) ItemField {
+	arguments := []monday.Argument{}
+	return ItemField{monday.NewObjectField("creator", userFields(fields), arguments)}
+}
+
+// The unique identifier of the item creator.
+func ItemCreatorIDField() ItemField {
+	return ItemField{monday.NewField("creator_id", nil)}
+}
+
+// The group that contains this item.
+func NewItemGroupField(fields []GroupField) ItemField {
+	arguments := []monday.Argument{}
+	return ItemField{monday.NewObjectField("group", groupFields(fields), arguments)}
+}
+
+// The item's unique identifier.
+func ItemIDField() ItemField {
+	return ItemField{monday.NewField("id", nil)}
+}
+
+// The item's name.
+func ItemNameField() ItemField {
+	return ItemField{monday.NewField("name", nil)}
+}
+
+// The board's state (all / active / archived / deleted).
+func ItemStateField() ItemField {
+	return ItemField{monday.NewField("state", nil)}
+}
+
+// The item's subitems.
+func NewItemSubitemsField(fields []ItemField) ItemField {
+	arguments := []monday.Argument{}
+	return ItemField{monday.NewObjectField("subitems", itemFields(fields), arguments)}
+}
+
+// The pulses's subscribers.
+func NewItemSubscribersField(fields []UserField) ItemField {
+	arguments := []monday.Argument{}
+	return ItemField{monday.NewObjectField("subscribers", userFields(fields), arguments)}
+}
+
+// The item's last update date.
+func ItemUpdatedAtField() ItemField {
+	return ItemField{monday.NewField("updated_at", nil)}
+}
+
+// The item's updates.
+func NewItemUpdatesField(fields []UpdateField, args ...ItemUpdatesArgument) ItemField {
+	arguments := []monday.Argument{}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return ItemField{monday.NewObjectField("updates", updateFields(fields), arguments)}
+}
+
+// The Item's updates field's graphql argument(s).
+type ItemUpdatesArgument struct {
+	argument monday.Argument
+}
+
+// The limit argument.
+func NewItemUpdatesLimitArgument(value int) ItemUpdatesArgument {
+	return ItemUpdatesArgument{monday.NewArgument("limit", value)}
+}
+
+// The page argument.
+func NewItemUpdatesPageArgument(value int) ItemUpdatesArgument {
+	return ItemUpdatesArgument{monday.NewArgument("page", value)}
+}
+
+// Root mutation type.
+type Mutation struct {
+	// Add subscribers to a board.
+	AddSubscribersToBoard []User `json:"add_subscribers_to_board,omitempty"`
+	// Archive a board.
+	ArchiveBoard *Board `json:"archive_board,omitempty"`
+	// Archive a group.
+	ArchiveGroup *Group `json:"archive_group,omitempty"`
+	// Archive an item.
+	ArchiveItem *Item `json:"archive_item,omitempty"`
+	// Change the title or description of a column.
+	ChangeColumnMetadata *Column `json:"change_column_metadata,omitempty"`
+	// Change the value of a column of an item.
+	ChangeColumnValue *Item `json:"change_column_value,omitempty"`
+	// Change multiple column values of an item.
+	ChangeMultipleColumnValues *Item `json:"change_multiple_column_values,omitempty"`
+	// Create a new board.
+	CreateBoard *Board `json:"create_board,omitempty"`
+	// Create a new column in a board.
+	CreateColumn *Column `json:"create_column,omitempty"`
+	// Create a new group.
+	CreateGroup *Group `json:"create_group,omitempty"`
+	// Create a new item.
+	CreateItem *Item `json:"create_item,omitempty"`
+	// Create a new notification.
+	CreateNotification *Notification `json:"create_notification,omitempty"`
+	// Create a new tag or get it if it already exists.
+	CreateOrGetTag *Tag `json:"create_or_get_tag,omitempty"`
+	// Create a subitem.
+	CreateSubitem *Item `json:"create_subitem,omitempty"`
+	// Create a new update or a reply on an update.
+	CreateUpdate *Update `json:"create_update,omitempty"`
+	// Create a new webhook.
+	CreateWebhook *Webhook `json:"create_webhook,omitempty"`
+	// Create a new workspace.
+	CreateWorkspace *Workspace `json:"create_workspace,omitempty"`
+	// Delete a column.
+	DeleteColumn *Column `json:"delete_column,omitempty"`
+	// Delete a group.
+	DeleteGroup *Group `json:"delete_group,omitempty"`
+	// Delete an item.
+	DeleteItem *Item `json:"delete_item,omitempty"`
+	// Delete a webhook.
+	DeleteWebhook *Webhook `json:"delete_webhook,omitempty"`
+	// Duplicate a group.
+	DuplicateGroup *Group `json:"duplicate_group,omitempty"`
+	// Move an item to a different board.
+	MoveItemToBoard *Item `json:"move_item_to_board,omitempty"`
+	// Move an item to a different group.
+	MoveItemToGroup *Item `json:"move_item_to_group,omitempty"`
+	// Update an attribute of a board.
+	UpdateBoard string `json:"update_board,omitempty"`
+	// Update the type and settings of a column.
+	UpdateColumn *Column `json:"update_column,omitempty"`
+	// Update an attribute of a group.
+	UpdateGroup *Group `json:"update_group,omitempty"`
+}
+
+// A notification.
+type Notification struct {
+	// The notification's unique identifier.
+	ID string `json:"id,omitempty"`
+	// The notification text.
+	Text string `json:"text,omitempty"`
+}
+
+// The Notification's graphql field(s).
+type NotificationField struct {
+	field monday.Field
+}
+
+func notificationFields(fields []NotificationField) []monday.Field {
+	var selection []monday.Field
+	for _, f := range fields {
+		selection = append(selection, f.field)
+	}
+	return selection
+}
+
+// The Notification's graphql fragment, a named selection of its fields that is defined once per payload.
+type NotificationFragment struct {
+	fragment monday.Fragment
+}
+
+// NewNotificationFragment returns the fragment with the given name of the Notification's fields.
+func NewNotificationFragment(name string, fields []NotificationField) NotificationFragment {
+	return NotificationFragment{monday.NewFragment(name, "Notification", notificationFields(fields))}
+}
+
+// NotificationFragmentField spreads the fragment of the Notification's fields.
+func NotificationFragmentField(fragment NotificationFragment) NotificationField {
+	return NotificationField{fragment.fragment.Spread()}
+}
+
+// The notification's unique identifier.
+func NotificationIDField() NotificationField {
+	return NotificationField{monday.NewField("id", nil)}
+}
+
+// The notification text.
+func NotificationTextField() NotificationField {
+	return NotificationField{monday.NewField("text", nil)}
+}
+
+// The notification's target type.
+type NotificationTargetType struct {
+	value string
+}
+
+// post value of NotificationTargetType.
+func NotificationTargetTypePost() NotificationTargetType {
+	return NotificationTargetType{"post"}
+}
+
+// project value of NotificationTargetType.
+func NotificationTargetTypeProject() NotificationTargetType {
+	return NotificationTargetType{"project"}
+}
+
+// String returns the value as it appears in a query.
+func (v NotificationTargetType) String() string {
+	return v.value
+}
+
+// EnumValue returns the value as it appears in a query.
+func (v NotificationTargetType) EnumValue() string {
+	return v.value
+}
+
+// MarshalJSON encodes the value as a json string.
+func (v NotificationTargetType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.value)
+}
+
+// UnmarshalJSON decodes the value from a json string.
+func (v *NotificationTargetType) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &v.value)
+}
+
+// A payment plan.
+type Plan struct {
+	// The maximum users allowed in the plan.
+	MaxUsers int `json:"max_users,omitempty"`
+	// The plan's time period.
+	Period string `json:"period,omitempty"`
+	// The plan's tier.
+	Tier string `json:"tier,omitempty"`
+	// The plan's version.
+	Version int `json:"version,omitempty"`
+}
+
+// The Plan's graphql field(s).
+type PlanField struct {
+	field monday.Field
+}
+
+func planFields(fields []PlanField) []monday.Field {
+	var selection []monday.Field
+	for _, f := range fields {
+		selection = append(selection, f.field)
+	}
+	return selection
+}
+
+// The Plan's graphql fragment, a named selection of its fields that is defined once per payload.
+type PlanFragment struct {
+	fragment monday.Fragment
+}
+
+// NewPlanFragment returns the fragment with the given name of the Plan's fields.
+func NewPlanFragment(name string, fields []PlanField) PlanFragment {
+	return PlanFragment{monday.NewFragment(name, "Plan", planFields(fields))}
+}
+
+// PlanFragmentField spreads the fragment of the Plan's fields.
+func PlanFragmentField(fragment PlanFragment) PlanField {
+	return PlanField{fragment.fragment.Spread()}
+}
+
+// The maximum users allowed in the plan.
+func PlanMaxUsersField() PlanField {
+	return PlanField{monday.NewField("max_users", nil)}
+}
+
+// The plan's time period.
+func PlanPeriodField() PlanField {
+	return PlanField{monday.NewField("period", nil)}
+}
+
+// The plan's tier.
+func PlanTierField() PlanField {
+	return PlanField{monday.NewField("tier", nil)}
+}
+
+// The plan's version.
+func PlanVersionField() PlanField {
+	return PlanField{monday.NewField("version", nil)}
+}
+
+// Root query type.
+type Query struct {
+	// Get the connected account's information.
+	Account *Account `json:"account,omitempty"`
+	// Get a collection of boards.
+	Boards []Board `json:"boards,omitempty"`
+	// Get the complexity data of your queries.
+	Complexity *Complexity `json:"complexity,omitempty"`
+	// Get a collection of docs.
+	Docs []Document `json:"docs,omitempty"`
+	// Get a collection of items.
+	Items []Item `json:"items,omitempty"`
+	// Search items by the value of a column.
+	ItemsByColumnValues []Item `json:"items_by_column_values,omitempty"`
+	// Get the connected user's information.
+	Me *User `json:"me,omitempty"`
+	// Get a collection of tags.
+	Tags []Tag `json:"tags,omitempty"`
+	// Get a collection of teams.
+	Teams []Team `json:"teams,omitempty"`
+	// Get a collection of updates.
+	Updates []Update `json:"updates,omitempty"`
+	// Get a collection of users.
+	Users []User `json:"users,omitempty"`
+	// Get the webhooks of a board.
+	Webhooks []Webhook `json:"webhooks,omitempty"`
+	// Get a collection of workspaces.
+	Workspaces []Workspace `json:"workspaces,omitempty"`
+}
+
+// A reply for an update.
+type Reply struct {
+	// The reply's html formatted body.
+	Body string `json:"body,omitempty"`
+	// The reply's creation date.
+	CreatedAt string `json:"created_at,omitempty"`
+	// The reply's creator.
+	Creator *User `json:"creator,omitempty"`
+	// The unique identifier of the reply creator.
+	CreatorID string `json:"creator_id,omitempty"`
+	// The reply's unique identifier.
+	ID string `json:"id,omitempty"`
+	// The reply's text body.
+	TextBody string `json:"text_body,omitempty"`
+	// The reply's last edit date.
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// The Reply's graphql field(s).
+type ReplyField struct {
+	field monday.Field
+}
+
+func replyFields(fields []ReplyField) []monday.Field {
+	var selection []monday.Field
+	for _, f := range fields {
+		selection = append(selection, f.field)
+	}
+	return selection
+}
+
+// The Reply's graphql fragment, a named selection of its fields that is defined once per payload.
+type ReplyFragment struct {
+	fragment monday.Fragment
+}
+
+// NewReplyFragment returns the fragment with the given name of the Reply's fields.
+func NewReplyFragment(name string, fields []ReplyField) ReplyFragment {
+	return ReplyFragment{monday.NewFragment(name, "Reply", replyFields(fields))}
+}
+
+// ReplyFragmentField spreads the fragment of the Reply's fields.
+func ReplyFragmentField(fragment ReplyFragment) ReplyField {
+	return ReplyField{fragment.fragment.Spread()}
+}
+
+// The reply's html formatted body.
+func ReplyBodyField() ReplyField {
+	return ReplyField{monday.NewField("body", nil)}
+}
+
+// The reply's creation date.
+func ReplyCreatedAtField() ReplyField {
+	return ReplyField{monday.NewField("created_at", nil)}
+}
+
+// The reply's creator.
+func NewReplyCreatorField(fields []UserField) ReplyField {
+	arguments := []monday.Argument{}
+	return ReplyField{monday.NewObjectField("creator", userFields(fields), arguments)}
+}
+
+// The unique identifier of the reply creator.
+func ReplyCreatorIDField() ReplyField {
+	return ReplyField{monday.NewField("creator_id", nil)}
+}
+
+// The reply's unique identifier.
+func ReplyIDField() ReplyField {
+	return ReplyField{monday.NewField("id", nil)}
+}
+
+// The reply's text body.
+func ReplyTextBodyField() ReplyField {
+	return ReplyField{monday.NewField("text_body", nil)}
+}
+
+// The reply's last edit date.
+func ReplyUpdatedAtField() ReplyField {
+	return ReplyField{monday.NewField("updated_at", nil)}
+}
+
+// The state of a board, item, group or workspace.
+type State struct {
+	value string
+}
+
+// active value of State.
+func StateActive() State {
+	return State{"active"}
+}
+
+// all value of State.
+func StateAll() State {
+	return State{"all"}
+}
+
+// archived value of State.
+func StateArchived() State {
+	return State{"archived"}
+}
+
+// deleted value of State.
+func StateDeleted() State {
+	return State{"deleted"}
+}
+
+// String returns the value as it appears in a query.
+func (v State) String() string {
+	return v.value
+}
+
+// EnumValue returns the value as it appears in a query.
+func (v State) EnumValue() string {
+	return v.value
+}
+
+// MarshalJSON encodes the value as a json string.
+func (v State) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.value)
+}
+
+// UnmarshalJSON decodes the value from a json string.
+func (v *State) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &v.value)
+}
+
+// The subscriber's kind (owner / subscriber).
+type SubscriberKind struct {
+	value string
+}
+
+// owner value of SubscriberKind.
+func SubscriberKindOwner() SubscriberKind {
+	return SubscriberKind{"owner"}
+}
+
+// subscriber value of SubscriberKind.
+func SubscriberKindSubscriber() SubscriberKind {
+	return SubscriberKind{"subscriber"}
+}
+
+// String returns the value as it appears in a query.
+func (v SubscriberKind) String() string {
+	return v.value
+}
+
+// EnumValue returns the value as it appears in a query.
+func (v SubscriberKind) EnumValue() string {
+	return v.value
+}
+
+// MarshalJSON encodes the value as a json string.
+func (v SubscriberKind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.value)
+}
+
+// UnmarshalJSON decodes the value from a json string.
+func (v *SubscriberKind) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &v.value)
+}
+
+// A tag.
+type Tag struct {
+	// The tag's color.
+	Color string `json:"color,omitempty"`
+	// The tag's unique identifier.
+	ID int `json:"id,omitempty"`
+	// The tag's name.
+	Name string `json:"name,omitempty"`
+}
+
+// The Tag's graphql field(s).
+type TagField struct {
+	field monday.Field
+}
+
+func tagFields(fields []TagField) []monday.Field {
+	var selection []monday.Field
+	for _, f := range fields {
+		selection = append(selection, f.field)
+	}
+	return selection
+}
+
+// The Tag's graphql fragment, a named selection of its fields that is defined once per payload.
+type TagFragment struct {
+	fragment monday.Fragment
+}
+
+// NewTagFragment returns the fragment with the given name of the Tag's fields.
+func NewTagFragment(name string, fields []TagField) TagFragment {
+	return TagFragment{monday.NewFragment(name, "Tag", tagFields(fields))}
+}
+
+// TagFragmentField spreads the fragment of the Tag's fields.
+func TagFragmentField(fragment TagFragment) TagField {
+	return TagField{fragment.fragment.Spread()}
+}
+
+// The tag's color.
+func TagColorField() TagField {
+	return TagField{monday.NewField("color", nil)}
+}
+
+// The tag's unique identifier.
+func TagIDField() TagField {
+	return TagField{monday.NewField("id", nil)}
+}
+
+// The tag's name.
+func TagNameField() TagField {
+	return TagField{monday.NewField("name", nil)}
+}
+
+// A team of users.
+type Team struct {
+	// The team's unique identifier.
+	ID int `json:"id,omitempty"`
+	// The team's name.
+	Name string `json:"name,omitempty"`
+	// The team's picture url.
+	PictureURL string `json:"picture_url,omitempty"`
+	// The users in the team.
+	Users []User `json:"users,omitempty"`
+}
+
+// The Team's graphql field(s).
+type TeamField struct {
+	field monday.Field
+}
+
+func teamFields(fields []TeamField) []monday.Field {
+	var selection []monday.Field
+	for _, f := range fields {
+		selection = append(selection, f.field)
+	}
+	return selection
+}
+
+// The Team's graphql fragment, a named selection of its fields that is defined once per payload.
+type TeamFragment struct {
+	fragment monday.Fragment
+}
+
+// NewTeamFragment returns the fragment with the given name of the Team's fields.
+func NewTeamFragment(name string, fields []TeamField) TeamFragment {
+	return TeamFragment{monday.NewFragment(name, "Team", teamFields(fields))}
+}
+
+// TeamFragmentField spreads the fragment of the Team's fields.
+func TeamFragmentField(fragment TeamFragment) TeamField {
+	return TeamField{fragment.fragment.Spread()}
+}
+
+// The team's unique identifier.
+func TeamIDField() TeamField {
+	return TeamField{monday.NewField("id", nil)}
+}
+
+// The team's name.
+func TeamNameField() TeamField {
+	return TeamField{monday.NewField("name", nil)}
+}
+
+// The team's picture url.
+func TeamPictureURLField() TeamField {
+	return TeamField{monday.NewField("picture_url", nil)}
+}
+
+// The users in the team.
+func NewTeamUsersField(fields []UserField, args ...TeamUsersArgument) TeamField {
+	arguments := []monday.Argument{}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return TeamField{monday.NewObjectField("users", userFields(fields), arguments)}
+}
+
+// The Team's users field's graphql argument(s).
+type TeamUsersArgument struct {
+	argument monday.Argument
+}
+
+// The ids argument.
+func NewTeamUsersIDsArgument(value []int) TeamUsersArgument {
+	return TeamUsersArgument{monday.NewArgument("ids", value)}
+}
+
+// The kind argument.
+func NewTeamUsersKindArgument(value UserKind) TeamUsersArgument {
+	return TeamUsersArgument{monday.NewArgument("kind", value)}
+}
+
+// The newest_first argument.
+func NewTeamUsersNewestFirstArgument(value bool) TeamUsersArgument {
+	return TeamUsersArgument{monday.NewArgument("newest_first", value)}
+}
+
+// The limit argument.
+func NewTeamUsersLimitArgument(value int) TeamUsersArgument {
+	return TeamUsersArgument{monday.NewArgument("limit", value)}
+}
+
+// An update.
+type Update struct {
+	// The update's html formatted body.
+	Body string `json:"body,omitempty"`
+	// The update's creation date.
+	CreatedAt string `json:"created_at,omitempty"`
+	// The update's creator.
+	Creator *User `json:"creator,omitempty"`
+	// The unique identifier of the update creator.
+	CreatorID string `json:"creator_id,omitempty"`
+	// The update's unique identifier.
+	ID string `json:"id,omitempty"`
+	// The update's item ID.
+	ItemID string `json:"item_id,omitempty"`
+	// The update's replies.
+	Replies []Reply `json:"replies,omitempty"`
+	// The update's text body.
+	TextBody string `json:"text_body,omitempty"`
+	// The update's last edit date.
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// The Update's graphql field(s).
+type UpdateField struct {
+	field monday.Field
+}
+
+func updateFields(fields []UpdateField) []monday.Field {
+	var selection []monday.Field
+	for _, f := range fields {
+		selection = append(selection, f.field)
+	}
+	return selection
+}
+
+// The Update's graphql fragment, a named selection of its fields that is defined once per payload.
+type UpdateFragment struct {
+	fragment monday.Fragment
+}
+
+// NewUpdateFragment returns the fragment with the given name of the Update's fields.
+func NewUpdateFragment(name string, fields []UpdateField) UpdateFragment {
+	return UpdateFragment{monday.NewFragment(name, "Update", updateFields(fields))}
+}
+
+// UpdateFragmentField spreads the fragment of the Update's fields.
+func UpdateFragmentField(fragment UpdateFragment) UpdateField {
+	return UpdateField{fragment.fragment.Spread()}
+}
+
+// The update's html formatted body.
+func UpdateBodyField() UpdateField {
+	return UpdateField{monday.NewField("body", nil)}
+}
+
+// The update's creation date.
+func UpdateCreatedAtField() UpdateField {
+	return UpdateField{monday.NewField("created_at", nil)}
+}
+
+// The update's creator.
+func NewUpdateCreatorField(fields []UserField) UpdateField {
+	arguments := []monday.Argument{}
+	return UpdateField{monday.NewObjectField("creator", userFields(fields), arguments)}
+}
+
+// The unique identifier of the update creator.
+func UpdateCreatorIDField() UpdateField {
+	return UpdateField{monday.NewField("creator_id", nil)}
+}
+
+// The update's unique identifier.
+func UpdateIDField() UpdateField {
+	return UpdateField{monday.NewField("id", nil)}
+}
+
+// The update's item ID.
+func UpdateItemIDField() UpdateField {
+	return UpdateField{monday.NewField("item_id", nil)}
+}
+
+// The update's replies.
+func NewUpdateRepliesField(fields []ReplyField) UpdateField {
+	arguments := []monday.Argument{}
+	return UpdateField{monday.NewObjectField("replies", replyFields(fields), arguments)}
+}
+
+// The update's text body.
+func UpdateTextBodyField() UpdateField {
+	return UpdateField{monday.NewField("text_body", nil)}
+}
+
+// The update's last edit date.
+func UpdateUpdatedAtField() UpdateField {
+	return UpdateField{monday.NewField("updated_at", nil)}
+}
+
+// A monday.com user.
+type User struct {
+	// The user's account.
+	Account *Account `json:"account,omitempty"`
+	// The user's birthday.
+	Birthday string `json:"birthday,omitempty"`
+	// The user's country code.
+	CountryCode string `json:"country_code,omitempty"`
+	// The user's creation date.
+	CreatedAt string `json:"created_at,omitempty"`
+	// The user's email.
+	Email string `json:"email,omitempty"`
+	// Is the user enabled or not.
+	Enabled bool `json:"enabled,omitempty"`
+	// The user's unique identifier.
+	ID int `json:"id,omitempty"`
+	// Is the user an account admin.
+	IsAdmin bool `json:"is_admin,omitempty"`
+	// Is the user a guest or not.
+	IsGuest bool `json:"is_guest,omitempty"`
+	// Is the user a pending user.
+	IsPending bool `json:"is_pending,omitempty"`
+	// The user's join date.
+	JoinDate string `json:"join_date,omitempty"`
+	// The user's location.
+	Location string `json:"location,omitempty"`
+	// The user's mobile phone number.
+	MobilePhone string `json:"mobile_phone,omitempty"`
+	// The user's name.
+	Name string `json:"name,omitempty"`
+	// The user's phone number.
+	Phone string `json:"phone,omitempty"`
+	// The user's photo in the original size.
+	PhotoOriginal string `json:"photo_original,omitempty"`
+	// The user's photo in small size (150x150).
+	PhotoSmall string `json:"photo_small,omitempty"`
+	// The user's photo in thumbnail size (100x100).
+	PhotoThumb string `json:"photo_thumb,omitempty"`
+	// The user's photo in small thumbnail size (50x50).
+	PhotoThumbSmall string `json:"photo_thumb_small,omitempty"`
+	// The user's photo in tiny size (30x30).
+	PhotoTiny string `json:"photo_tiny,omitempty"`
+	// The teams the user is a member in.
+	Teams []Team `json:"teams,omitempty"`
+	// The user's timezone identifier.
+	TimeZoneIdentifier string `json:"time_zone_identifier,omitempty"`
+	// The user's title.
+	Title string `json:"title,omitempty"`
+	// The user's profile url.
+	URL string `json:"url,omitempty"`
+	// The user's UTC hours difference.
+	UTCHoursDiff int `json:"utc_hours_diff,omitempty"`
+}
+
+// The User's graphql field(s).
+type UserField struct {
+	field monday.Field
+}
+
+func userFields(fields []UserField) []monday.Field {
+	var selection []monday.Field
+	for _, f := range fields {
+		selection = append(selection, f.field)
+	}
+	return selection
+}
+
+// The User's graphql fragment, a named selection of its fields that is defined once per payload.
+type UserFragment struct {
+	fragment monday.Fragment
+}
+
+// NewUserFragment returns the fragment with the given name of the User's fields.
+func NewUserFragment(name string, fields []UserField) UserFragment {
+	return UserFragment{monday.NewFragment(name, "User", userFields(fields))}
+}
+
+// UserFragmentField spreads the fragment of the User's fields.
+func UserFragmentField(fragment UserFragment) UserField {
+	return UserField{fragment.fragment.Spread()}
+}
+
+// The user's account.
+func NewUserAccountField(fields []AccountField) UserField {
+	arguments := []monday.Argument{}
+	return UserField{monday.NewObjectField("account", accountFields(fields), arguments)}
+}
+
+// The user's birthday.
+func UserBirthdayField() UserField {
+	return UserField{monday.NewField("birthday", nil)}
+}
+
+// The user's country code.
+func UserCountryCodeField() UserField {
+	return UserField{monday.NewField("country_code", nil)}
+}
+
+// The user's creation date.
+func UserCreatedAtField() UserField {
+	return UserField{monday.NewField("created_at", nil)}
+}
+
+// The user's email.
+func UserEmailField() UserField {
+	return UserField{monday.NewField("email", nil)}
+}
+
+// Is the user enabled or not.
+func UserEnabledField() UserField {
+	return UserField{monday.NewField("enabled", nil)}
+}
+
+// The user's unique identifier.
+func UserIDField() UserField {
+	return UserField{monday.NewField("id", nil)}
+}
+
+// Is the user an account admin.
+func UserIsAdminField() UserField {
+	return UserField{monday.NewField("is_admin", nil)}
+}
+
+// Is the user a guest or not.
+func UserIsGuestField() UserField {
+	return UserField{monday.NewField("is_guest", nil)}
+}
+
+// Is the user a pending user.
+func UserIsPendingField() UserField {
+	return UserField{monday.NewField("is_pending", nil)}
+}
+
+// The user's join date.
+func UserJoinDateField() UserField {
+	return UserField{monday.NewField("join_date", nil)}
+}
+
+// The user's location.
+func UserLocationField() UserField {
+	return UserField{monday.NewField("location", nil)}
+}
+
+// The user's mobile phone number.
+func UserMobilePhoneField() UserField {
+	return UserField{monday.NewField("mobile_phone", nil)}
+}
+
+// The user's name.
+func UserNameField() UserField {
+	return UserField{monday.NewField("name", nil)}
+}
+
+// The user's phone number.
+func UserPhoneField() UserField {
+	return UserField{monday.NewField("phone", nil)}
+}
+
+// The user's photo in the original size.
+func UserPhotoOriginalField() UserField {
+	return UserField{monday.NewField("photo_original", nil)}
+}
+
+// The user's photo in small size (150x150).
+func UserPhotoSmallField() UserField {
+	return UserField{monday.NewField("photo_small", nil)}
+}
+
+// The user's photo in thumbnail size (100x100).
+func UserPhotoThumbField() UserField {
+	return UserField{monday.NewField("photo_thumb", nil)}
+}
+
+// The user's photo in small thumbnail size (50x50).
+func UserPhotoThumbSmallField() UserField {
+	return UserField{monday.NewField("photo_thumb_small", nil)}
+}
+
+// The user's photo in tiny size (30x30).
+func UserPhotoTinyField() UserField {
+	return UserField{monday.NewField("photo_tiny", nil)}
+}
+
+// The teams the user is a member in.
+func NewUserTeamsField(fields []TeamField, args ...UserTeamsArgument) UserField {
+	arguments := []monday.Argument{}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return UserField{monday.NewObjectField("teams", teamFields(fields), arguments)}
+}
+
+// The User's teams field's graphql argument(s).
+type UserTeamsArgument struct {
+	argument monday.Argument
+}
+
+// The ids argument.
+func NewUserTeamsIDsArgument(value []int) UserTeamsArgument {
+	return UserTeamsArgument{monday.NewArgument("ids", value)}
+}
+
+// The user's timezone identifier.
+func UserTimeZoneIdentifierField() UserField {
+	return UserField{monday.NewField("time_zone_identifier", nil)}
+}
+
+// The user's title.
+func UserTitleField() UserField {
+	return UserField{monday.NewField("title", nil)}
+}
+
+// The user's profile url.
+func UserURLField() UserField {
+	return UserField{monday.NewField("url", nil)}
+}
+
+// The user's UTC hours difference.
+func UserUTCHoursDiffField() UserField {
+	return UserField{monday.NewField("utc_hours_diff", nil)}
+}
+
+// The kind of users to return.
+type UserKind struct {
+	value string
+}
+
+// all value of UserKind.
+func UserKindAll() UserKind {
+	return UserKind{"all"}
+}
+
+// guests value of UserKind.
+func UserKindGuests() UserKind {
+	return UserKind{"guests"}
+}
+
+// non_guests value of UserKind.
+func UserKindNonGuests() UserKind {
+	return UserKind{"non_guests"}
+}
+
+// non_pending value of UserKind.
+func UserKindNonPending() UserKind {
+	return UserKind{"non_pending"}
+}
+
+// String returns the value as it appears in a query.
+func (v UserKind) String() string {
+	return v.value
+}
+
+// EnumValue returns the value as it appears in a query.
+func (v UserKind) EnumValue() string {
+	return v.value
+}
+
+// MarshalJSON encodes the value as a json string.
+func (v UserKind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.value)
+}
+
+// UnmarshalJSON decodes the value from a json string.
+func (v *UserKind) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &v.value)
+}
+
+// Monday webhooks.
+type Webhook struct {
+	// The webhook's board id.
+	BoardID string `json:"board_id,omitempty"`
+	// The webhooks's config.
+	Config string `json:"config,omitempty"`
+	// The event webhook will listen to.
+	Event string `json:"event,omitempty"`
+	// The webhook's unique identifier.
+	ID string `json:"id,omitempty"`
+}
+
+// The Webhook's graphql field(s).
+type WebhookField struct {
+	field monday.Field
+}
+
+func webhookFields(fields []WebhookField) []monday.Field {
+	var selection []monday.Field
+	for _, f := range fields {
+		selection = append(selection, f.field)
+	}
+	return selection
+}
+
+// The Webhook's graphql fragment, a named selection of its fields that is defined once per payload.
+type WebhookFragment struct {
+	fragment monday.Fragment
+}
+
+// NewWebhookFragment returns the fragment with the given name of the Webhook's fields.
+func NewWebhookFragment(name string, fields []WebhookField) WebhookFragment {
+	return WebhookFragment{monday.NewFragment(name, "Webhook", webhookFields(fields))}
+}
+
+// WebhookFragmentField spreads the fragment of the Webhook's fields.
+func WebhookFragmentField(fragment WebhookFragment) WebhookField {
+	return WebhookField{fragment.fragment.Spread()}
+}
+
+// The webhook's board id.
+func WebhookBoardIDField() WebhookField {
+	return WebhookField{monday.NewField("board_id", nil)}
+}
+
+// The webhooks's config.
+func WebhookConfigField() WebhookField {
+	return WebhookField{monday.NewField("config", nil)}
+}
+
+// The event webhook will listen to.
+func WebhookEventField() WebhookField {
+	return WebhookField{monday.NewField("event", nil)}
+}
+
+// The webhook's unique identifier.
+func WebhookIDField() WebhookField {
+	return WebhookField{monday.NewField("id", nil)}
+}
+
+// The webhook's target type.
+type WebhookEventType struct {
+	value string
+}
+
+// change_column_value value of WebhookEventType.
+func WebhookEventTypeChangeColumnValue() WebhookEventType {
+	return WebhookEventType{"change_column_value"}
+}
+
+// change_name value of WebhookEventType.
+func WebhookEventTypeChangeName() WebhookEventType {
+	return WebhookEventType{"change_name"}
+}
+
+// change_specific_column_value value of WebhookEventType.
+func WebhookEventTypeChangeSpecificColumnValue() WebhookEventType {
+	return WebhookEventType{"change_specific_column_value"}
+}
+
+// change_status_column_value value of WebhookEventType.
+func WebhookEventTypeChangeStatusColumnValue() WebhookEventType {
+	return WebhookEventType{"change_status_column_value"}
+}
+
+// change_subitem_column_value value of WebhookEventType.
+func WebhookEventTypeChangeSubitemColumnValue() WebhookEventType {
+	return WebhookEventType{"change_subitem_column_value"}
+}
+
+// change_subitem_name value of WebhookEventType.
+func WebhookEventTypeChangeSubitemName() WebhookEventType {
+	return WebhookEventType{"change_subitem_name"}
+}
+
+// create_column value of WebhookEventType.
+func WebhookEventTypeCreateColumn() WebhookEventType {
+	return WebhookEventType{"create_column"}
+}
+
+// create_item value of WebhookEventType.
+func WebhookEventTypeCreateItem() WebhookEventType {
+	return WebhookEventType{"create_item"}
+}
+
+// create_subitem value of WebhookEventType.
+func WebhookEventTypeCreateSubitem() WebhookEventType {
+	return WebhookEventType{"create_subitem"}
+}
+
+// create_subitem_update value of WebhookEventType.
+func WebhookEventTypeCreateSubitemUpdate() WebhookEventType {
+	return WebhookEventType{"create_subitem_update"}
+}
+
+// create_update value of WebhookEventType.
+func WebhookEventTypeCreateUpdate() WebhookEventType {
+	return WebhookEventType{"create_update"}
+}
+
+// item_archived value of WebhookEventType.
+func WebhookEventTypeItemArchived() WebhookEventType {
+	return WebhookEventType{"item_archived"}
+}
+
+// item_deleted value of WebhookEventType.
+func WebhookEventTypeItemDeleted() WebhookEventType {
+	return WebhookEventType{"item_deleted"}
+}
+
+// item_moved_to_any_group value of WebhookEventType.
+func WebhookEventTypeItemMovedToAnyGroup() WebhookEventType {
+	return WebhookEventType{"item_moved_to_any_group"}
+}
+
+// item_restored value of WebhookEventType.
+func WebhookEventTypeItemRestored() WebhookEventType {
+	return WebhookEventType{"item_restored"}
+}
+
+// move_item_to_group value of WebhookEventType.
+func WebhookEventTypeMoveItemToGroup() WebhookEventType {
+	return WebhookEventType{"move_item_to_group"}
+}
+
+// subitem_archived value of WebhookEventType.
+func WebhookEventTypeSubitemArchived() WebhookEventType {
+	return WebhookEventType{"subitem_archived"}
+}
+
+// subitem_deleted value of WebhookEventType.
+func WebhookEventTypeSubitemDeleted() WebhookEventType {
+	return WebhookEventType{"subitem_deleted"}
+}
+
+// String returns the value as it appears in a query.
+func (v WebhookEventType) String() string {
+	return v.value
+}
+
+// EnumValue returns the value as it appears in a query.
+func (v WebhookEventType) EnumValue() string {
+	return v.value
+}
+
+// MarshalJSON encodes the value as a json string.
+func (v WebhookEventType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.value)
+}
+
+// UnmarshalJSON decodes the value from a json string.
+func (v *WebhookEventType) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &v.value)
+}
+
+// A workspace.
+type Workspace struct {
+	// The workspace's creation date.
+	CreatedAt string `json:"created_at,omitempty"`
+	// The workspace's description.
+	Description string `json:"description,omitempty"`
+	// The workspace's unique identifier.
+	ID int `json:"id,omitempty"`
+	// The workspace's kind (open / closed).
+	Kind WorkspaceKind `json:"kind,omitempty"`
+	// The workspace's name.
+	Name string `json:"name,omitempty"`
+	// The workspace's state (all / active / archived / deleted).
+	State State `json:"state,omitempty"`
+}
+
+// The Workspace's graphql field(s).
+type WorkspaceField struct {
+	field monday.Field
+}
+
+func workspaceFields(fields []WorkspaceField) []monday.Field {
+	var selection []monday.Field
+	for _, f := range fields {
+		selection = append(selection, f.field)
+	}
+	return selection
+}
+
+// The Workspace's graphql fragment, a named selection of its fields that is defined once per payload.
+type WorkspaceFragment struct {
+	fragment monday.Fragment
+}
+
+// NewWorkspaceFragment returns the fragment with the given name of the Workspace's fields.
+func NewWorkspaceFragment(name string, fields []WorkspaceField) WorkspaceFragment {
+	return WorkspaceFragment{monday.NewFragment(name, "Workspace", workspaceFields(fields))}
+}
+
+// WorkspaceFragmentField spreads the fragment of the Workspace's fields.
+func WorkspaceFragmentField(fragment WorkspaceFragment) WorkspaceField {
+	return WorkspaceField{fragment.fragment.Spread()}
+}
+
+// The workspace's creation date.
+func WorkspaceCreatedAtField() WorkspaceField {
+	return WorkspaceField{monday.NewField("created_at", nil)}
+}
+
+// The workspace's description.
+func WorkspaceDescriptionField() WorkspaceField {
+	return WorkspaceField{monday.NewField("description", nil)}
+}
+
+// The workspace's unique identifier.
+func WorkspaceIDField() WorkspaceField {
+	return WorkspaceField{monday.NewField("id", nil)}
+}
+
+// The workspace's kind (open / closed).
+func WorkspaceKindField() WorkspaceField {
+	return WorkspaceField{monday.NewField("kind", nil)}
+}
+
+// The workspace's name.
+func WorkspaceNameField() WorkspaceField {
+	return WorkspaceField{monday.NewField("name", nil)}
+}
+
+// The workspace's state (all / active / archived / deleted).
+func WorkspaceStateField() WorkspaceField {
+	return WorkspaceField{monday.NewField("state", nil)}
+}
+
+// The workspace's kind.
+type WorkspaceKind struct {
+	value string
+}
+
+// closed value of WorkspaceKind.
+func WorkspaceKindClosed() WorkspaceKind {
+	return WorkspaceKind{"closed"}
+}
+
+// open value of WorkspaceKind.
+func WorkspaceKindOpen() WorkspaceKind {
+	return WorkspaceKind{"open"}
+}
+
+// String returns the value as it appears in a query.
+func (v WorkspaceKind) String() string {
+	return v.value
+}
+
+// EnumValue returns the value as it appears in a query.
+func (v WorkspaceKind) EnumValue() string {
+	return v.value
+}
+
+// MarshalJSON encodes the value as a json string.
+func (v WorkspaceKind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.value)
+}
+
+// UnmarshalJSON decodes the value from a json string.
+func (v *WorkspaceKind) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &v.value)
+}
+
+// Get the connected account's information.
+func NewAccountQuery(fields []AccountField) monday.Query {
+	arguments := []monday.Argument{}
+	return monday.NewQuery("account", accountFields(fields), arguments)
+}
+
+// Get a collection of boards.
+func NewBoardsQuery(fields []BoardField, args ...QueryBoardsArgument) monday.Query {
+	arguments := []monday.Argument{}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewQuery("boards", boardFields(fields), arguments)
+}
+
+// The boards query's graphql argument(s).
+type QueryBoardsArgument struct {
+	argument monday.Argument
+}
+
+// The ids argument.
+func NewQueryBoardsIDsArgument(value []int) QueryBoardsArgument {
+	return QueryBoardsArgument{monday.NewArgument("ids", value)}
+}
+
+// The limit argument.
+func NewQueryBoardsLimitArgument(value int) QueryBoardsArgument {
+	return QueryBoardsArgument{monday.NewArgument("limit", value)}
+}
+
+// The page argument.
+func NewQueryBoardsPageArgument(value int) QueryBoardsArgument {
+	return QueryBoardsArgument{monday.NewArgument("page", value)}
+}
+
+// The board_kind argument.
+func NewQueryBoardsBoardKindArgument(value BoardKind) QueryBoardsArgument {
+	return QueryBoardsArgument{monday.NewArgument("board_kind", value)}
+}
+
+// The state argument.
+func NewQueryBoardsStateArgument(value State) QueryBoardsArgument {
+	return QueryBoardsArgument{monday.NewArgument("state", value)}
+}
+
+// The newest_first argument.
+func NewQueryBoardsNewestFirstArgument(value bool) QueryBoardsArgument {
+	return QueryBoardsArgument{monday.NewArgument("newest_first", value)}
+}
+
+// The workspace_ids argument.
+func NewQueryBoardsWorkspaceIDsArgument(value []int) QueryBoardsArgument {
+	return QueryBoardsArgument{monday.NewArgument("workspace_ids", value)}
+}
+
+// Get the complexity data of your queries.
+func NewComplexityQuery(fields []ComplexityField) monday.Query {
+	arguments := []monday.Argument{}
+	return monday.NewQuery("complexity", complexityFields(fields), arguments)
+}
+
+// Get a collection of docs.
+func NewDocsQuery(fields []DocumentField, args ...QueryDocsArgument) monday.Query {
+	arguments := []monday.Argument{}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewQuery("docs", documentFields(fields), arguments)
+}
+
+// The docs query's graphql argument(s).
+type QueryDocsArgument struct {
+	argument monday.Argument
+}
+
+// The ids argument.
+func NewQueryDocsIDsArgument(value []int) QueryDocsArgument {
+	return QueryDocsArgument{monday.NewArgument("ids", value)}
+}
+
+// The object_ids argument.
+func NewQueryDocsObjectIDsArgument(value []int) QueryDocsArgument {
+	return QueryDocsArgument{monday.NewArgument("object_ids", value)}
+}
+
+// The workspace_ids argument.
+func NewQueryDocsWorkspaceIDsArgument(value []int) QueryDocsArgument {
+	return QueryDocsArgument{monday.NewArgument("workspace_ids", value)}
+}
+
+// The limit argument.
+func NewQueryDocsLimitArgument(value int) QueryDocsArgument {
+	return QueryDocsArgument{monday.NewArgument("limit", value)}
+}
+
+// The page argument.
+func NewQueryDocsPageArgument(value int) QueryDocsArgument {
+	return QueryDocsArgument{monday.NewArgument("page", value)}
+}
+
+// Get a collection of items.
+func NewItemsQuery(fields []ItemField, args ...QueryItemsArgument) monday.Query {
+	arguments := []monday.Argument{}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewQuery("items", itemFields(fields), arguments)
+}
+
+// The items query's graphql argument(s).
+type QueryItemsArgument struct {
+	argument monday.Argument
+}
+
+// The ids argument.
+func NewQueryItemsIDsArgument(value []int) QueryItemsArgument {
+	return QueryItemsArgument{monday.NewArgument("ids", value)}
+}
+
+// The limit argument.
+func NewQueryItemsLimitArgument(value int) QueryItemsArgument {
+	return QueryItemsArgument{monday.NewArgument("limit", value)}
+}
+
+// The page argument.
+func NewQueryItemsPageArgument(value int) QueryItemsArgument {
+	return QueryItemsArgument{monday.NewArgument("page", value)}
+}
+
+// The newest_first argument.
+func NewQueryItemsNewestFirstArgument(value bool) QueryItemsArgument {
+	return QueryItemsArgument{monday.NewArgument("newest_first", value)}
+}
+
+// Search items by the value of a column.
+func NewItemsByColumnValuesQuery(boardID int, columnID string, columnValue string, fields []ItemField, args ...QueryItemsByColumnValuesArgument) monday.Query {
+	arguments := []monday.Argument{
+		monday.NewArgument("board_id", boardID),
+		monday.NewArgument("column_id", columnID),
+		monday.NewArgument("column_value", columnValue),
+	}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewQuery("items_by_column_values", itemFields(fields), arguments)
+}
+
+// The items_by_column_values query's graphql argument(s).
+type QueryItemsByColumnValuesArgument struct {
+	argument monday.Argument
+}
+
+// The column_type argument.
+func NewQueryItemsByColumnValuesColumnTypeArgument(value string) QueryItemsByColumnValuesArgument {
+	return QueryItemsByColumnValuesArgument{monday.NewArgument("column_type", value)}
+}
+
+// The state argument.
+func NewQueryItemsByColumnValuesStateArgument(value State) QueryItemsByColumnValuesArgument {
+	return QueryItemsByColumnValuesArgument{monday.NewArgument("state", value)}
+}
+
+// The limit argument.
+func NewQueryItemsByColumnValuesLimitArgument(value int) QueryItemsByColumnValuesArgument {
+	return QueryItemsByColumnValuesArgument{monday.NewArgument("limit", value)}
+}
+
+// The page argument.
+func NewQueryItemsByColumnValuesPageArgument(value int) QueryItemsByColumnValuesArgument {
+	return QueryItemsByColumnValuesArgument{monday.NewArgument("page", value)}
+}
+
+// Get the connected user's information.
+func NewMeQuery(fields []UserField) monday.Query {
+	arguments := []monday.Argument{}
+	return monday.NewQuery("me", userFields(fields), arguments)
+}
+
+// Get a collection of tags.
+func NewTagsQuery(fields []TagField, args ...QueryTagsArgument) monday.Query {
+	arguments := []monday.Argument{}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewQuery("tags", tagFields(fields), arguments)
+}
+
+// The tags query's graphql argument(s).
+type QueryTagsArgument struct {
+	argument monday.Argument
+}
+
+// The ids argument.
+func NewQueryTagsIDsArgument(value []int) QueryTagsArgument {
+	return QueryTagsArgument{monday.NewArgument("ids", value)}
+}
+
+// Get a collection of teams.
+func NewTeamsQuery(fields []TeamField, args ...QueryTeamsArgument) monday.Query {
+	arguments := []monday.Argument{}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewQuery("teams", teamFields(fields), arguments)
+}
+
+// The teams query's graphql argument(s).
+type QueryTeamsArgument struct {
+	argument monday.Argument
+}
+
+// The ids argument.
+func NewQueryTeamsIDsArgument(value []int) QueryTeamsArgument {
+	return QueryTeamsArgument{monday.NewArgument("ids", value)}
+}
+
+// Get a collection of updates.
+func NewUpdatesQuery(fields []UpdateField, args ...QueryUpdatesArgument) monday.Query {
+	arguments := []monday.Argument{}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewQuery("updates", updateFields(fields), arguments)
+}
+
+// The updates query's graphql argument(s).
+type QueryUpdatesArgument struct {
+	argument monday.Argument
+}
+
+// The limit argument.
+func NewQueryUpdatesLimitArgument(value int) QueryUpdatesArgument {
+	return QueryUpdatesArgument{monday.NewArgument("limit", value)}
+}
+
+// The page argument.
+func NewQueryUpdatesPageArgument(value int) QueryUpdatesArgument {
+	return QueryUpdatesArgument{monday.NewArgument("page", value)}
+}
+
+// Get a collection of users.
+func NewUsersQuery(fields []UserField, args ...QueryUsersArgument) monday.Query {
+	arguments := []monday.Argument{}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewQuery("users", userFields(fields), arguments)
+}
+
+// The users query's graphql argument(s).
+type QueryUsersArgument struct {
+	argument monday.Argument
+}
+
+// The ids argument.
+func NewQueryUsersIDsArgument(value []int) QueryUsersArgument {
+	return QueryUsersArgument{monday.NewArgument("ids", value)}
+}
+
+// The kind argument.
+func NewQueryUsersKindArgument(value UserKind) QueryUsersArgument {
+	return QueryUsersArgument{monday.NewArgument("kind", value)}
+}
+
+// The newest_first argument.
+func NewQueryUsersNewestFirstArgument(value bool) QueryUsersArgument {
+	return QueryUsersArgument{monday.NewArgument("newest_first", value)}
+}
+
+// The limit argument.
+func NewQueryUsersLimitArgument(value int) QueryUsersArgument {
+	return QueryUsersArgument{monday.NewArgument("limit", value)}
+}
+
+// Get the webhooks of a board.
+func NewWebhooksQuery(boardID int, fields []WebhookField, args ...QueryWebhooksArgument) monday.Query {
+	arguments := []monday.Argument{
+		monday.NewArgument("board_id", boardID),
+	}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewQuery("webhooks", webhookFields(fields), arguments)
+}
+
+// The webhooks query's graphql argument(s).
+type QueryWebhooksArgument struct {
+	argument monday.Argument
+}
+
+// The app_webhooks_only argument.
+func NewQueryWebhooksAppWebhooksOnlyArgument(value bool) QueryWebhooksArgument {
+	return QueryWebhooksArgument{monday.NewArgument("app_webhooks_only", value)}
+}
+
+// Get a collection of workspaces.
+func NewWorkspacesQuery(fields []WorkspaceField, args ...QueryWorkspacesArgument) monday.Query {
+	arguments := []monday.Argument{}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewQuery("workspaces", workspaceFields(fields), arguments)
+}
+
+// The workspaces query's graphql argument(s).
+type QueryWorkspacesArgument struct {
+	argument monday.Argument
+}
+
+// The ids argument.
+func NewQueryWorkspacesIDsArgument(value []int) QueryWorkspacesArgument {
+	return QueryWorkspacesArgument{monday.NewArgument("ids", value)}
+}
+
+// The limit argument.
+func NewQueryWorkspacesLimitArgument(value int) QueryWorkspacesArgument {
+	return QueryWorkspacesArgument{monday.NewArgument("limit", value)}
+}
+
+// The page argument.
+func NewQueryWorkspacesPageArgument(value int) QueryWorkspacesArgument {
+	return QueryWorkspacesArgument{monday.NewArgument("page", value)}
+}
+
+// The kind argument.
+func NewQueryWorkspacesKindArgument(value WorkspaceKind) QueryWorkspacesArgument {
+	return QueryWorkspacesArgument{monday.NewArgument("kind", value)}
+}
+
+// The state argument.
+func NewQueryWorkspacesStateArgument(value State) QueryWorkspacesArgument {
+	return QueryWorkspacesArgument{monday.NewArgument("state", value)}
+}
+
+// Add subscribers to a board.
+func NewAddSubscribersToBoardMutation(boardID int, userIDs []int, fields []UserField, args ...MutationAddSubscribersToBoardArgument) monday.Mutation {
+	arguments := []monday.Argument{
+		monday.NewArgument("board_id", boardID),
+		monday.NewArgument("user_ids", userIDs),
+	}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewMutation("add_subscribers_to_board", userFields(fields), arguments)
+}
+
+// The add_subscribers_to_board mutation's graphql argument(s).
+type MutationAddSubscribersToBoardArgument struct {
+	argument monday.Argument
+}
+
+// The kind argument.
+func NewMutationAddSubscribersToBoardKindArgument(value SubscriberKind) MutationAddSubscribersToBoardArgument {
+	return MutationAddSubscribersToBoardArgument{monday.NewArgument("kind", value)}
+}
+
+// Archive a board.
+func NewArchiveBoardMutation(boardID int, fields []BoardField) monday.Mutation {
+	arguments := []monday.Argument{
+		monday.NewArgument("board_id", boardID),
+	}
+	return monday.NewMutation("archive_board", boardFields(fields), arguments)
+}
+
+// Archive a group.
+func NewArchiveGroupMutation(boardID int, groupID string, fields []GroupField) monday.Mutation {
+	arguments := []monday.Argument{
+		monday.NewArgument("board_id", boardID),
+		monday.NewArgument("group_id", groupID),
+	}
+	return monday.NewMutation("archive_group", groupFields(fields), arguments)
+}
+
+// Archive an item.
+func NewArchiveItemMutation(fields []ItemField, args ...MutationArchiveItemArgument) monday.Mutation {
+	arguments := []monday.Argument{}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewMutation("archive_item", itemFields(fields), arguments)
+}
+
+// The archive_item mutation's graphql argument(s).
+type MutationArchiveItemArgument struct {
+	argument monday.Argument
+}
+
+// The item_id argument.
+func NewMutationArchiveItemItemIDArgument(value int) MutationArchiveItemArgument {
+	return MutationArchiveItemArgument{monday.NewArgument("item_id", value)}
+}
+
+// Change the title or description of a column.
+func NewChangeColumnMetadataMutation(boardID int, columnID string, fields []ColumnField, args ...MutationChangeColumnMetadataArgument) monday.Mutation {
+	arguments := []monday.Argument{
+		monday.NewArgument("board_id", boardID),
+		monday.NewArgument("column_id", columnID),
+	}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewMutation("change_column_metadata", columnFields(fields), arguments)
+}
+
+// The change_column_metadata mutation's graphql argument(s).
+type MutationChangeColumnMetadataArgument struct {
+	argument monday.Argument
+}
+
+// The column_property argument.
+func NewMutationChangeColumnMetadataColumnPropertyArgument(value ColumnProperty) MutationChangeColumnMetadataArgument {
+	return MutationChangeColumnMetadataArgument{monday.NewArgument("column_property", value)}
+}
+
+// The value argument.
+func NewMutationChangeColumnMetadataValueArgument(value string) MutationChangeColumnMetadataArgument {
+	return MutationChangeColumnMetadataArgument{monday.NewArgument("value", value)}
+}
+
+// Change the value of a column of an item.
+func NewChangeColumnValueMutation(columnID string, boardID int, value string, fields []ItemField, args ...MutationChangeColumnValueArgument) monday.Mutation {
+	arguments := []monday.Argument{
+		monday.NewArgument("column_id", columnID),
+		monday.NewArgument("board_id", boardID),
+		monday.NewArgument("value", value),
+	}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewMutation("change_column_value", itemFields(fields), arguments)
+}
+
+// The change_column_value mutation's graphql argument(s).
+type MutationChangeColumnValueArgument struct {
+	argument monday.Argument
+}
+
+// The item_id argument.
+func NewMutationChangeColumnValueItemIDArgument(value int) MutationChangeColumnValueArgument {
+	return MutationChangeColumnValueArgument{monday.NewArgument("item_id", value)}
+}
+
+// Change multiple column values of an item.
+func NewChangeMultipleColumnValuesMutation(boardID int, columnValues string, fields []ItemField, args ...MutationChangeMultipleColumnValuesArgument) monday.Mutation {
+	arguments := []monday.Argument{
+		monday.NewArgument("board_id", boardID),
+		monday.NewArgument("column_values", columnValues),
+	}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewMutation("change_multiple_column_values", itemFields(fields), arguments)
+}
+
+// The change_multiple_column_values mutation's graphql argument(s).
+type MutationChangeMultipleColumnValuesArgument struct {
+	argument monday.Argument
+}
+
+// The item_id argument.
+func NewMutationChangeMultipleColumnValuesItemIDArgument(value int) MutationChangeMultipleColumnValuesArgument {
+	return MutationChangeMultipleColumnValuesArgument{monday.NewArgument("item_id", value)}
+}
+
+// Create a new board.
+func NewCreateBoardMutation(boardName string, boardKind BoardKind, fields []BoardField, args ...MutationCreateBoardArgument) monday.Mutation {
+	arguments := []monday.Argument{
+		monday.NewArgument("board_name", boardName),
+		monday.NewArgument("board_kind", boardKind),
+	}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewMutation("create_board", boardFields(fields), arguments)
+}
+
+// The create_board mutation's graphql argument(s).
+type MutationCreateBoardArgument struct {
+	argument monday.Argument
+}
+
+// The template_id argument.
+func NewMutationCreateBoardTemplateIDArgument(value int) MutationCreateBoardArgument {
+	return MutationCreateBoardArgument{monday.NewArgument("template_id", value)}
+}
+
+// The workspace_id argument.
+func NewMutationCreateBoardWorkspaceIDArgument(value int) MutationCreateBoardArgument {
+	return MutationCreateBoardArgument{monday.NewArgument("workspace_id", value)}
+}
+
+// Create a new column in a board.
+func NewCreateColumnMutation(boardID int, title string, fields []ColumnField, args ...MutationCreateColumnArgument) monday.Mutation {
+	arguments := []monday.Argument{
+		monday.NewArgument("board_id", boardID),
+		monday.NewArgument("title", title),
+	}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewMutation("create_column", columnFields(fields), arguments)
+}
+
+// The create_column mutation's graphql argument(s).
+type MutationCreateColumnArgument struct {
+	argument monday.Argument
+}
+
+// The column_type argument.
+func NewMutationCreateColumnColumnTypeArgument(value ColumnType) MutationCreateColumnArgument {
+	return MutationCreateColumnArgument{monday.NewArgument("column_type", value)}
+}
+
+// The defaults argument.
+func NewMutationCreateColumnDefaultsArgument(value string) MutationCreateColumnArgument {
+	return MutationCreateColumnArgument{monday.NewArgument("defaults", value)}
+}
+
+// The description argument.
+func NewMutationCreateColumnDescriptionArgument(value string) MutationCreateColumnArgument {
+	return MutationCreateColumnArgument{monday.NewArgument("description", value)}
+}
+
+// Create a new group.
+func NewCreateGroupMutation(boardID int, groupName string, fields []GroupField) monday.Mutation {
+	arguments := []monday.Argument{
+		monday.NewArgument("board_id", boardID),
+		monday.NewArgument("group_name", groupName),
+	}
+	return monday.NewMutation("create_group", groupFields(fields), arguments)
+}
+
+// Create a new item.
+func NewCreateItemMutation(boardID int, fields []ItemField, args ...MutationCreateItemArgument) monday.Mutation {
+	arguments := []monday.Argument{
+		monday.NewArgument("board_id", boardID),
+	}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewMutation("create_item", itemFields(fields), arguments)
+}
+
+// The create_item mutation's graphql argument(s).
+type MutationCreateItemArgument struct {
+	argument monday.Argument
+}
+
+// The item_name argument.
+func NewMutationCreateItemItemNameArgument(value string) MutationCreateItemArgument {
+	return MutationCreateItemArgument{monday.NewArgument("item_name", value)}
+}
+
+// The group_id argument.
+func NewMutationCreateItemGroupIDArgument(value string) MutationCreateItemArgument {
+	return MutationCreateItemArgument{monday.NewArgument("group_id", value)}
+}
+
+// The column_values argument.
+func NewMutationCreateItemColumnValuesArgument(value string) MutationCreateItemArgument {
+	return MutationCreateItemArgument{monday.NewArgument("column_values", value)}
+}
+
+// Create a new notification.
+func NewCreateNotificationMutation(text string, userID int, targetID int, targetType NotificationTargetType, fields []NotificationField) monday.Mutation {
+	arguments := []monday.Argument{
+		monday.NewArgument("text", text),
+		monday.NewArgument("user_id", userID),
+		monday.NewArgument("target_id", targetID),
+		monday.NewArgument("target_type", targetType),
+	}
+	return monday.NewMutation("create_notification", notificationFields(fields), arguments)
+}
+
+// Create a new tag or get it if it already exists.
+func NewCreateOrGetTagMutation(fields []TagField, args ...MutationCreateOrGetTagArgument) monday.Mutation {
+	arguments := []monday.Argument{}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewMutation("create_or_get_tag", tagFields(fields), arguments)
+}
+
+// The create_or_get_tag mutation's graphql argument(s).
+type MutationCreateOrGetTagArgument struct {
+	argument monday.Argument
+}
+
+// The tag_name argument.
+func NewMutationCreateOrGetTagTagNameArgument(value string) MutationCreateOrGetTagArgument {
+	return MutationCreateOrGetTagArgument{monday.NewArgument("tag_name", value)}
+}
+
+// The board_id argument.
+func NewMutationCreateOrGetTagBoardIDArgument(value int) MutationCreateOrGetTagArgument {
+	return MutationCreateOrGetTagArgument{monday.NewArgument("board_id", value)}
+}
+
+// Create a subitem.
+func NewCreateSubitemMutation(parentItemID int, itemName string, fields []ItemField, args ...MutationCreateSubitemArgument) monday.Mutation {
+	arguments := []monday.Argument{
+		monday.NewArgument("parent_item_id", parentItemID),
+		monday.NewArgument("item_name", itemName),
+	}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewMutation("create_subitem", itemFields(fields), arguments)
+}
+
+// The create_subitem mutation's graphql argument(s).
+type MutationCreateSubitemArgument struct {
+	argument monday.Argument
+}
+
+// The column_values argument.
+func NewMutationCreateSubitemColumnValuesArgument(value string) MutationCreateSubitemArgument {
+	return MutationCreateSubitemArgument{monday.NewArgument("column_values", value)}
+}
+
+// Create a new update or a reply on an update.
+func NewCreateUpdateMutation(body string, fields []UpdateField, args ...MutationCreateUpdateArgument) monday.Mutation {
+	arguments := []monday.Argument{
+		monday.NewArgument("body", body),
+	}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewMutation("create_update", updateFields(fields), arguments)
+}
+
+// The create_update mutation's graphql argument(s).
+type MutationCreateUpdateArgument struct {
+	argument monday.Argument
+}
+
+// The item_id argument.
+func NewMutationCreateUpdateItemIDArgument(value int) MutationCreateUpdateArgument {
+	return MutationCreateUpdateArgument{monday.NewArgument("item_id", value)}
+}
+
+// The parent_id argument.
+func NewMutationCreateUpdateParentIDArgument(value int) MutationCreateUpdateArgument {
+	return MutationCreateUpdateArgument{monday.NewArgument("parent_id", value)}
+}
+
+// Create a new webhook.
+func NewCreateWebhookMutation(boardID int, url string, event WebhookEventType, fields []WebhookField, args ...MutationCreateWebhookArgument) monday.Mutation {
+	arguments := []monday.Argument{
+		monday.NewArgument("board_id", boardID),
+		monday.NewArgument("url", url),
+		monday.NewArgument("event", event),
+	}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewMutation("create_webhook", webhookFields(fields), arguments)
+}
+
+// The create_webhook mutation's graphql argument(s).
+type MutationCreateWebhookArgument struct {
+	argument monday.Argument
+}
+
+// The config argument.
+func NewMutationCreateWebhookConfigArgument(value string) MutationCreateWebhookArgument {
+	return MutationCreateWebhookArgument{monday.NewArgument("config", value)}
+}
+
+// Create a new workspace.
+func NewCreateWorkspaceMutation(name string, kind WorkspaceKind, fields []WorkspaceField, args ...MutationCreateWorkspaceArgument) monday.Mutation {
+	arguments := []monday.Argument{
+		monday.NewArgument("name", name),
+		monday.NewArgument("kind", kind),
+	}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewMutation("create_workspace", workspaceFields(fields), arguments)
+}
+
+// The create_workspace mutation's graphql argument(s).
+type MutationCreateWorkspaceArgument struct {
+	argument monday.Argument
+}
+
+// The description argument.
+func NewMutationCreateWorkspaceDescriptionArgument(value string) MutationCreateWorkspaceArgument {
+	return MutationCreateWorkspaceArgument{monday.NewArgument("description", value)}
+}
+
+// Delete a column.
+func NewDeleteColumnMutation(boardID int, columnID string, fields []ColumnField) monday.Mutation {
+	arguments := []monday.Argument{
+		monday.NewArgument("board_id", boardID),
+		monday.NewArgument("column_id", columnID),
+	}
+	return monday.NewMutation("delete_column", columnFields(fields), arguments)
+}
+
+// Delete a group.
+func NewDeleteGroupMutation(boardID int, groupID string, fields []GroupField) monday.Mutation {
+	arguments := []monday.Argument{
+		monday.NewArgument("board_id", boardID),
+		monday.NewArgument("group_id", groupID),
+	}
+	return monday.NewMutation("delete_group", groupFields(fields), arguments)
+}
+
+// Delete an item.
+func NewDeleteItemMutation(fields []ItemField, args ...MutationDeleteItemArgument) monday.Mutation {
+	arguments := []monday.Argument{}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewMutation("delete_item", itemFields(fields), arguments)
+}
+
+// The delete_item mutation's graphql argument(s).
+type MutationDeleteItemArgument struct {
+	argument monday.Argument
+}
+
+// The item_id argument.
+func NewMutationDeleteItemItemIDArgument(value int) MutationDeleteItemArgument {
+	return MutationDeleteItemArgument{monday.NewArgument("item_id", value)}
+}
+
+// Delete a webhook.
+func NewDeleteWebhookMutation(id int, fields []WebhookField) monday.Mutation {
+	arguments := []monday.Argument{
+		monday.NewArgument("id", id),
+	}
+	return monday.NewMutation("delete_webhook", webhookFields(fields), arguments)
+}
+
+// Duplicate a group.
+func NewDuplicateGroupMutation(boardID int, groupID string, fields []GroupField, args ...MutationDuplicateGroupArgument) monday.Mutation {
+	arguments := []monday.Argument{
+		monday.NewArgument("board_id", boardID),
+		monday.NewArgument("group_id", groupID),
+	}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewMutation("duplicate_group", groupFields(fields), arguments)
+}
+
+// The duplicate_group mutation's graphql argument(s).
+type MutationDuplicateGroupArgument struct {
+	argument monday.Argument
+}
+
+// The add_to_top argument.
+func NewMutationDuplicateGroupAddToTopArgument(value bool) MutationDuplicateGroupArgument {
+	return MutationDuplicateGroupArgument{monday.NewArgument("add_to_top", value)}
+}
+
+// The group_title argument.
+func NewMutationDuplicateGroupGroupTitleArgument(value string) MutationDuplicateGroupArgument {
+	return MutationDuplicateGroupArgument{monday.NewArgument("group_title", value)}
+}
+
+// Move an item to a different board.
+func NewMoveItemToBoardMutation(boardID int, groupID string, itemID int, fields []ItemField, args ...MutationMoveItemToBoardArgument) monday.Mutation {
+	arguments := []monday.Argument{
+		monday.NewArgument("board_id", boardID),
+		monday.NewArgument("group_id", groupID),
+		monday.NewArgument("item_id", itemID),
+	}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewMutation("move_item_to_board", itemFields(fields), arguments)
+}
+
+// The move_item_to_board mutation's graphql argument(s).
+type MutationMoveItemToBoardArgument struct {
+	argument monday.Argument
+}
+
+// The mapping of the columns of the item to the columns of the target board.
+func NewMutationMoveItemToBoardColumnsMappingArgument(value []ColumnMappingInput) MutationMoveItemToBoardArgument {
+	return MutationMoveItemToBoardArgument{monday.NewArgument("columns_mapping", value)}
+}
+
+// The mapping of the columns of the subitems to the columns of the target subitems board.
+func NewMutationMoveItemToBoardSubitemsColumnsMappingArgument(value []ColumnMappingInput) MutationMoveItemToBoardArgument {
+	return MutationMoveItemToBoardArgument{monday.NewArgument("subitems_columns_mapping", value)}
+}
+
+// Move an item to a different group.
+func NewMoveItemToGroupMutation(groupID string, fields []ItemField, args ...MutationMoveItemToGroupArgument) monday.Mutation {
+	arguments := []monday.Argument{
+		monday.NewArgument("group_id", groupID),
+	}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewMutation("move_item_to_group", itemFields(fields), arguments)
+}
+
+// The move_item_to_group mutation's graphql argument(s).
+type MutationMoveItemToGroupArgument struct {
+	argument monday.Argument
+}
+
+// The item_id argument.
+func NewMutationMoveItemToGroupItemIDArgument(value int) MutationMoveItemToGroupArgument {
+	return MutationMoveItemToGroupArgument{monday.NewArgument("item_id", value)}
+}
+
+// Update an attribute of a board.
+func NewUpdateBoardMutation(boardID int, boardAttribute BoardAttributes, newValue string) monday.Mutation {
+	arguments := []monday.Argument{
+		monday.NewArgument("board_id", boardID),
+		monday.NewArgument("board_attribute", boardAttribute),
+		monday.NewArgument("new_value", newValue),
+	}
+	return monday.NewMutation("update_board", nil, arguments)
+}
+
+// Update the type and settings of a column.
+func NewUpdateColumnMutation(boardID int, id string, columnType ColumnType, fields []ColumnField, args ...MutationUpdateColumnArgument) monday.Mutation {
+	arguments := []monday.Argument{
+		monday.NewArgument("board_id", boardID),
+		monday.NewArgument("id", id),
+		monday.NewArgument("column_type", columnType),
+	}
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return monday.NewMutation("update_column", columnFields(fields), arguments)
+}
+
+// The update_column mutation's graphql argument(s).
+type MutationUpdateColumnArgument struct {
+	argument monday.Argument
+}
+
+// The settings argument.
+func NewMutationUpdateColumnSettingsArgument(value string) MutationUpdateColumnArgument {
+	return MutationUpdateColumnArgument{monday.NewArgument("settings", value)}
+}
+
+// The revision argument.
+func NewMutationUpdateColumnRevisionArgument(value string) MutationUpdateColumnArgument {
+	return MutationUpdateColumnArgument{monday.NewArgument("revision", value)}
+}
+
+// Update an attribute of a group.
+func NewUpdateGroupMutation(boardID int, groupID string, groupAttribute GroupAttributes, newValue string, fields []GroupField) monday.Mutation {
+	arguments := []monday.Argument{
+		monday.NewArgument("board_id", boardID),
+		monday.NewArgument("group_id", groupID),
+		monday.NewArgument("group_attribute", groupAttribute),
+		monday.NewArgument("new_value", newValue),
+	}
+	return monday.NewMutation("update_group", groupFields(fields), arguments)
+}
+
+// Client executes the queries and mutations of the package as fluent requests, e.g.
+//
+//	boards, err := api.NewClient(client).Boards().IDs(1).Select(api.BoardNameField()).Do(ctx)
+//
+// The requests coexist with the payloads of the monday package, see the Query and Mutation methods.
+type Client struct {
+	*monday.Client
+}
+
+// NewClient returns a client that executes the requests with the given client.
+func NewClient(client *monday.Client) *Client {
+	return &Client{client}
+}
+
+// request holds the client and the arguments of a request.
+type request struct {
+	client    *monday.Client
+	names     []string
+	arguments []monday.Argument
+}
+
+// set sets the argument with the given name, replacing an earlier value.
+func (r *request) set(name string, value interface{}) {
+	argument := monday.NewArgument(name, value)
+	for i, n := range r.names {
+		if n == name {
+			r.arguments[i] = argument
+			return
+		}
+	}
+	r.names = append(r.names, name)
+	r.arguments = append(r.arguments, argument)
+}
+
+// AccountRequest is a account query, see Client.Account.
+type AccountRequest struct {
+	request
+	fields []AccountField
+}
+
+// Get the connected account's information.
+func (c *Client) Account() *AccountRequest {
+	r := AccountRequest{request: request{client: c.Client}}
+	return &r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *AccountRequest) Select(fields ...AccountField) *AccountRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *AccountRequest) selection() []AccountField {
+	if len(r.fields) == 0 {
+		return defaultAccountFields()
+	}
+	return r.fields
+}
+
+// Query returns the query, e.g. to add it to a payload.
+func (r *AccountRequest) Query() monday.Query {
+	return monday.NewQuery("account", accountFields(r.selection()), r.arguments)
+}
+
+// Do executes the query and returns its result.
+func (r *AccountRequest) Do(ctx context.Context) (*Account, error) {
+	var data struct {
+		Result *Account `json:"account"`
+	}
+	err := r.client.Do(ctx, monday.NewQueryPayload(r.Query()), &data)
+	return data.Result, err
+}
+
+// BoardsRequest is a boards query, see Client.Boards.
+type BoardsRequest struct {
+	request
+	fields []BoardField
+}
+
+// Get a collection of boards.
+func (c *Client) Boards() *BoardsRequest {
+	r := BoardsRequest{request: request{client: c.Client}}
+	return &r
+}
+
+// IDs sets the ids argument.
+func (r *BoardsRequest) IDs(values ...int) *BoardsRequest {
+	r.set("ids", values)
+	return r
+}
+
+// Limit sets the limit argument.
+func (r *BoardsRequest) Limit(value int) *BoardsRequest {
+	r.set("limit", value)
+	return r
+}
+
+// Page sets the page argument.
+func (r *BoardsRequest) Page(value int) *BoardsRequest {
+	r.set("page", value)
+	return r
+}
+
+// BoardKind sets the board_kind argument.
+func (r *BoardsRequest) BoardKind(value BoardKind) *BoardsRequest {
+	r.set("board_kind", value)
+	return r
+}
+
+// State sets the state argument.
+func (r *BoardsRequest) State(value State) *BoardsRequest {
+	r.set("state", value)
+	return r
+}
+
+// NewestFirst sets the newest_first argument.
+func (r *BoardsRequest) NewestFirst(value bool) *BoardsRequest {
+	r.set("newest_first", value)
+	return r
+}
+
+// WorkspaceIDs sets the workspace_ids argument.
+func (r *BoardsRequest) WorkspaceIDs(values ...int) *BoardsRequest {
+	r.set("workspace_ids", values)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *BoardsRequest) Select(fields ...BoardField) *BoardsRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *BoardsRequest) selection() []BoardField {
+	if len(r.fields) == 0 {
+		return defaultBoardFields()
+	}
+	return r.fields
+}
+
+// Query returns the query, e.g. to add it to a payload.
+func (r *BoardsRequest) Query() monday.Query {
+	return monday.NewQuery("boards", boardFields(r.selection()), r.arguments)
+}
+
+// Do executes the query and returns its result.
+func (r *BoardsRequest) Do(ctx context.Context) ([]Board, error) {
+	var data struct {
+		Result []Board `json:"boards"`
+	}
+	err := r.client.Do(ctx, monday.NewQueryPayload(r.Query()), &data)
+	return data.Result, err
+}
+
+// ComplexityRequest is a complexity query, see Client.Complexity.
+type ComplexityRequest struct {
+	request
+	fields []ComplexityField
+}
+
+// Get the complexity data of your queries.
+func (c *Client) Complexity() *ComplexityRequest {
+	r := ComplexityRequest{request: request{client: c.Client}}
+	return &r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *ComplexityRequest) Select(fields ...ComplexityField) *ComplexityRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *ComplexityRequest) selection() []ComplexityField {
+	if len(r.fields) == 0 {
+		return defaultComplexityFields()
+	}
+	return r.fields
+}
+
+// Query returns the query, e.g. to add it to a payload.
+func (r *ComplexityRequest) Query() monday.Query {
+	return monday.NewQuery("complexity", complexityFields(r.selection()), r.arguments)
+}
+
+// Do executes the query and returns its result.
+func (r *ComplexityRequest) Do(ctx context.Context) (*Complexity, error) {
+	var data struct {
+		Result *Complexity `json:"complexity"`
+	}
+	err := r.client.Do(ctx, monday.NewQueryPayload(r.Query()), &data)
+	return data.Result, err
+}
+
+// DocsRequest is a docs query, see Client.Docs.
+type DocsRequest struct {
+	request
+	fields []DocumentField
+}
+
+// Get a collection of docs.
+func (c *Client) Docs() *DocsRequest {
+	r := DocsRequest{request: request{client: c.Client}}
+	return &r
+}
+
+// IDs sets the ids argument.
+func (r *DocsRequest) IDs(values ...int) *DocsRequest {
+	r.set("ids", values)
+	return r
+}
+
+// ObjectIDs sets the object_ids argument.
+func (r *DocsRequest) ObjectIDs(values ...int) *DocsRequest {
+	r.set("object_ids", values)
+	return r
+}
+
+// WorkspaceIDs sets the workspace_ids argument.
+func (r *DocsRequest) WorkspaceIDs(values ...int) *DocsRequest {
+	r.set("workspace_ids", values)
+	return r
+}
+
+// Limit sets the limit argument.
+func (r *DocsRequest) Limit(value int) *DocsRequest {
+	r.set("limit", value)
+	return r
+}
+
+// Page sets the page argument.
+func (r *DocsRequest) Page(value int) *DocsRequest {
+	r.set("page", value)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *DocsRequest) Select(fields ...DocumentField) *DocsRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *DocsRequest) selection() []DocumentField {
+	if len(r.fields) == 0 {
+		return defaultDocumentFields()
+	}
+	return r.fields
+}
+
+// Query returns the query, e.g. to add it to a payload.
+func (r *DocsRequest) Query() monday.Query {
+	return monday.NewQuery("docs", documentFields(r.selection()), r.arguments)
+}
+
+// Do executes the query and returns its result.
+func (r *DocsRequest) Do(ctx context.Context) ([]Document, error) {
+	var data struct {
+		Result []Document `json:"docs"`
+	}
+	err := r.client.Do(ctx, monday.NewQueryPayload(r.Query()), &data)
+	return data.Result, err
+}
+
+// ItemsRequest is a items query, see Client.Items.
+type ItemsRequest struct {
+	request
+	fields []ItemField
+}
+
+// Get a collection of items.
+func (c *Client) Items() *ItemsRequest {
+	r := ItemsRequest{request: request{client: c.Client}}
+	return &r
+}
+
+// IDs sets the ids argument.
+func (r *ItemsRequest) IDs(values ...int) *ItemsRequest {
+	r.set("ids", values)
+	return r
+}
+
+// Limit sets the limit argument.
+func (r *ItemsRequest) Limit(value int) *ItemsRequest {
+	r.set("limit", value)
+	return r
+}
+
+// Page sets the page argument.
+func (r *ItemsRequest) Page(value int) *ItemsRequest {
+	r.set("page", value)
+	return r
+}
+
+// NewestFirst sets the newest_first argument.
+func (r *ItemsRequest) NewestFirst(value bool) *ItemsRequest {
+	r.set("newest_first", value)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *ItemsRequest) Select(fields ...ItemField) *ItemsRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *ItemsRequest) selection() []ItemField {
+	if len(r.fields) == 0 {
+		return defaultItemFields()
+	}
+	return r.fields
+}
+
+// Query returns the query, e.g. to add it to a payload.
+func (r *ItemsRequest) Query() monday.Query {
+	return monday.NewQuery("items", itemFields(r.selection()), r.arguments)
+}
+
+// Do executes the query and returns its result.
+func (r *ItemsRequest) Do(ctx context.Context) ([]Item, error) {
+	var data struct {
+		Result []Item `json:"items"`
+	}
+	err := r.client.Do(ctx, monday.NewQueryPayload(r.Query()), &data)
+	return data.Result, err
+}
+
+// ItemsByColumnValuesRequest is a items_by_column_values query, see Client.ItemsByColumnValues.
+type ItemsByColumnValuesRequest struct {
+	request
+	fields []ItemField
+}
+
+// Search items by the value of a column.
+func (c *Client) ItemsByColumnValues(boardID int, columnID string, columnValue string) *ItemsByColumnValuesRequest {
+	r := ItemsByColumnValuesRequest{request: request{client: c.Client}}
+	r.set("board_id", boardID)
+	r.set("column_id", columnID)
+	r.set("column_value", columnValue)
+	return &r
+}
+
+// ColumnType sets the column_type argument.
+func (r *ItemsByColumnValuesRequest) ColumnType(value string) *ItemsByColumnValuesRequest {
+	r.set("column_type", value)
+	return r
+}
+
+// State sets the state argument.
+func (r *ItemsByColumnValuesRequest) State(value State) *ItemsByColumnValuesRequest {
+	r.set("state", value)
+	return r
+}
+
+// Limit sets the limit argument.
+func (r *ItemsByColumnValuesRequest) Limit(value int) *ItemsByColumnValuesRequest {
+	r.set("limit", value)
+	return r
+}
+
+// Page sets the page argument.
+func (r *ItemsByColumnValuesRequest) Page(value int) *ItemsByColumnValuesRequest {
+	r.set("page", value)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *ItemsByColumnValuesRequest) Select(fields ...ItemField) *ItemsByColumnValuesRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *ItemsByColumnValuesRequest) selection() []ItemField {
+	if len(r.fields) == 0 {
+		return defaultItemFields()
+	}
+	return r.fields
+}
+
+// Query returns the query, e.g. to add it to a payload.
+func (r *ItemsByColumnValuesRequest) Query() monday.Query {
+	return monday.NewQuery("items_by_column_values", itemFields(r.selection()), r.arguments)
+}
+
+// Do executes the query and returns its result.
+func (r *ItemsByColumnValuesRequest) Do(ctx context.Context) ([]Item, error) {
+	var data struct {
+		Result []Item `json:"items_by_column_values"`
+	}
+	err := r.client.Do(ctx, monday.NewQueryPayload(r.Query()), &data)
+	return data.Result, err
+}
+
+// MeRequest is a me query, see Client.Me.
+type MeRequest struct {
+	request
+	fields []UserField
+}
+
+// Get the connected user's information.
+func (c *Client) Me() *MeRequest {
+	r := MeRequest{request: request{client: c.Client}}
+	return &r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *MeRequest) Select(fields ...UserField) *MeRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *MeRequest) selection() []UserField {
+	if len(r.fields) == 0 {
+		return defaultUserFields()
+	}
+	return r.fields
+}
+
+// Query returns the query, e.g. to add it to a payload.
+func (r *MeRequest) Query() monday.Query {
+	return monday.NewQuery("me", userFields(r.selection()), r.arguments)
+}
+
+// Do executes the query and returns its result.
+func (r *MeRequest) Do(ctx context.Context) (*User, error) {
+	var data struct {
+		Result *User `json:"me"`
+	}
+	err := r.client.Do(ctx, monday.NewQueryPayload(r.Query()), &data)
+	return data.Result, err
+}
+
+// TagsRequest is a tags query, see Client.Tags.
+type TagsRequest struct {
+	request
+	fields []TagField
+}
+
+// Get a collection of tags.
+func (c *Client) Tags() *TagsRequest {
+	r := TagsRequest{request: request{client: c.Client}}
+	return &r
+}
+
+// IDs sets the ids argument.
+func (r *TagsRequest) IDs(values ...int) *TagsRequest {
+	r.set("ids", values)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *TagsRequest) Select(fields ...TagField) *TagsRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *TagsRequest) selection() []TagField {
+	if len(r.fields) == 0 {
+		return defaultTagFields()
+	}
+	return r.fields
+}
+
+// Query returns the query, e.g. to add it to a payload.
+func (r *TagsRequest) Query() monday.Query {
+	return monday.NewQuery("tags", tagFields(r.selection()), r.arguments)
+}
+
+// Do executes the query and returns its result.
+func (r *TagsRequest) Do(ctx context.Context) ([]Tag, error) {
+	var data struct {
+		Result []Tag `json:"tags"`
+	}
+	err := r.client.Do(ctx, monday.NewQueryPayload(r.Query()), &data)
+	return data.Result, err
+}
+
+// TeamsRequest is a teams query, see Client.Teams.
+type TeamsRequest struct {
+	request
+	fields []TeamField
+}
+
+// Get a collection of teams.
+func (c *Client) Teams() *TeamsRequest {
+	r := TeamsRequest{request: request{client: c.Client}}
+	return &r
+}
+
+// IDs sets the ids argument.
+func (r *TeamsRequest) IDs(values ...int) *TeamsRequest {
+	r.set("ids", values)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *TeamsRequest) Select(fields ...TeamField) *TeamsRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *TeamsRequest) selection() []TeamField {
+	if len(r.fields) == 0 {
+		return defaultTeamFields()
+	}
+	return r.fields
+}
+
+// Query returns the query, e.g. to add it to a payload.
+func (r *TeamsRequest) Query() monday.Query {
+	return monday.NewQuery("teams", teamFields(r.selection()), r.arguments)
+}
+
+// Do executes the query and returns its result.
+func (r *TeamsRequest) Do(ctx context.Context) ([]Team, error) {
+	var data struct {
+		Result []Team `json:"teams"`
+	}
+	err := r.client.Do(ctx, monday.NewQueryPayload(r.Query()), &data)
+	return data.Result, err
+}
+
+// UpdatesRequest is a updates query, see Client.Updates.
+type UpdatesRequest struct {
+	request
+	fields []UpdateField
+}
+
+// Get a collection of updates.
+func (c *Client) Updates() *UpdatesRequest {
+	r := UpdatesRequest{request: request{client: c.Client}}
+	return &r
+}
+
+// Limit sets the limit argument.
+func (r *UpdatesRequest) Limit(value int) *UpdatesRequest {
+	r.set("limit", value)
+	return r
+}
+
+// Page sets the page argument.
+func (r *UpdatesRequest) Page(value int) *UpdatesRequest {
+	r.set("page", value)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *UpdatesRequest) Select(fields ...UpdateField) *UpdatesRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *UpdatesRequest) selection() []UpdateField {
+	if len(r.fields) == 0 {
+		return defaultUpdateFields()
+	}
+	return r.fields
+}
+
+// Query returns the query, e.g. to add it to a payload.
+func (r *UpdatesRequest) Query() monday.Query {
+	return monday.NewQuery("updates", updateFields(r.selection()), r.arguments)
+}
+
+// Do executes the query and returns its result.
+func (r *UpdatesRequest) Do(ctx context.Context) ([]Update, error) {
+	var data struct {
+		Result []Update `json:"updates"`
+	}
+	err := r.client.Do(ctx, monday.NewQueryPayload(r.Query()), &data)
+	return data.Result, err
+}
+
+// UsersRequest is a users query, see Client.Users.
+type UsersRequest struct {
+	request
+	fields []UserField
+}
+
+// Get a collection of users.
+func (c *Client) Users() *UsersRequest {
+	r := UsersRequest{request: request{client: c.Client}}
+	return &r
+}
+
+// IDs sets the ids argument.
+func (r *UsersRequest) IDs(values ...int) *UsersRequest {
+	r.set("ids", values)
+	return r
+}
+
+// Kind sets the kind argument.
+func (r *UsersRequest) Kind(value UserKind) *UsersRequest {
+	r.set("kind", value)
+	return r
+}
+
+// NewestFirst sets the newest_first argument.
+func (r *UsersRequest) NewestFirst(value bool) *UsersRequest {
+	r.set("newest_first", value)
+	return r
+}
+
+// Limit sets the limit argument.
+func (r *UsersRequest) Limit(value int) *UsersRequest {
+	r.set("limit", value)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *UsersRequest) Select(fields ...UserField) *UsersRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *UsersRequest) selection() []UserField {
+	if len(r.fields) == 0 {
+		return defaultUserFields()
+	}
+	return r.fields
+}
+
+// Query returns the query, e.g. to add it to a payload.
+func (r *UsersRequest) Query() monday.Query {
+	return monday.NewQuery("users", userFields(r.selection()), r.arguments)
+}
+
+// Do executes the query and returns its result.
+func (r *UsersRequest) Do(ctx context.Context) ([]User, error) {
+	var data struct {
+		Result []User `json:"users"`
+	}
+	err := r.client.Do(ctx, monday.NewQueryPayload(r.Query()), &data)
+	return data.Result, err
+}
+
+// WebhooksRequest is a webhooks query, see Client.Webhooks.
+type WebhooksRequest struct {
+	request
+	fields []WebhookField
+}
+
+// Get the webhooks of a board.
+func (c *Client) Webhooks(boardID int) *WebhooksRequest {
+	r := WebhooksRequest{request: request{client: c.Client}}
+	r.set("board_id", boardID)
+	return &r
+}
+
+// AppWebhooksOnly sets the app_webhooks_only argument.
+func (r *WebhooksRequest) AppWebhooksOnly(value bool) *WebhooksRequest {
+	r.set("app_webhooks_only", value)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *WebhooksRequest) Select(fields ...WebhookField) *WebhooksRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *WebhooksRequest) selection() []WebhookField {
+	if len(r.fields) == 0 {
+		return defaultWebhookFields()
+	}
+	return r.fields
+}
+
+// Query returns the query, e.g. to add it to a payload.
+func (r *WebhooksRequest) Query() monday.Query {
+	return monday.NewQuery("webhooks", webhookFields(r.selection()), r.arguments)
+}
+
+// Do executes the query and returns its result.
+func (r *WebhooksRequest) Do(ctx context.Context) ([]Webhook, error) {
+	var data struct {
+		Result []Webhook `json:"webhooks"`
+	}
+	err := r.client.Do(ctx, monday.NewQueryPayload(r.Query()), &data)
+	return data.Result, err
+}
+
+// WorkspacesRequest is a workspaces query, see Client.Workspaces.
+type WorkspacesRequest struct {
+	request
+	fields []WorkspaceField
+}
+
+// Get a collection of workspaces.
+func (c *Client) Workspaces() *WorkspacesRequest {
+	r := WorkspacesRequest{request: request{client: c.Client}}
+	return &r
+}
+
+// IDs sets the ids argument.
+func (r *WorkspacesRequest) IDs(values ...int) *WorkspacesRequest {
+	r.set("ids", values)
+	return r
+}
+
+// Limit sets the limit argument.
+func (r *WorkspacesRequest) Limit(value int) *WorkspacesRequest {
+	r.set("limit", value)
+	return r
+}
+
+// Page sets the page argument.
+func (r *WorkspacesRequest) Page(value int) *WorkspacesRequest {
+	r.set("page", value)
+	return r
+}
+
+// Kind sets the kind argument.
+func (r *WorkspacesRequest) Kind(value WorkspaceKind) *WorkspacesRequest {
+	r.set("kind", value)
+	return r
+}
+
+// State sets the state argument.
+func (r *WorkspacesRequest) State(value State) *WorkspacesRequest {
+	r.set("state", value)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *WorkspacesRequest) Select(fields ...WorkspaceField) *WorkspacesRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *WorkspacesRequest) selection() []WorkspaceField {
+	if len(r.fields) == 0 {
+		return defaultWorkspaceFields()
+	}
+	return r.fields
+}
+
+// Query returns the query, e.g. to add it to a payload.
+func (r *WorkspacesRequest) Query() monday.Query {
+	return monday.NewQuery("workspaces", workspaceFields(r.selection()), r.arguments)
+}
+
+// Do executes the query and returns its result.
+func (r *WorkspacesRequest) Do(ctx context.Context) ([]Workspace, error) {
+	var data struct {
+		Result []Workspace `json:"workspaces"`
+	}
+	err := r.client.Do(ctx, monday.NewQueryPayload(r.Query()), &data)
+	return data.Result, err
+}
+
+// AddSubscribersToBoardRequest is a add_subscribers_to_board mutation, see Client.AddSubscribersToBoard.
+type AddSubscribersToBoardRequest struct {
+	request
+	fields []UserField
+}
+
+// Add subscribers to a board.
+func (c *Client) AddSubscribersToBoard(boardID int, userIDs []int) *AddSubscribersToBoardRequest {
+	r := AddSubscribersToBoardRequest{request: request{client: c.Client}}
+	r.set("board_id", boardID)
+	r.set("user_ids", userIDs)
+	return &r
+}
+
+// Kind sets the kind argument.
+func (r *AddSubscribersToBoardRequest) Kind(value SubscriberKind) *AddSubscribersToBoardRequest {
+	r.set("kind", value)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *AddSubscribersToBoardRequest) Select(fields ...UserField) *AddSubscribersToBoardRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *AddSubscribersToBoardRequest) selection() []UserField {
+	if len(r.fields) == 0 {
+		return defaultUserFields()
+	}
+	return r.fields
+}
+
+// Mutation returns the mutation, e.g. to add it to a payload.
+func (r *AddSubscribersToBoardRequest) Mutation() monday.Mutation {
+	return monday.NewMutation("add_subscribers_to_board", userFields(r.selection()), r.arguments)
+}
+
+// Do executes the mutation and returns its result.
+func (r *AddSubscribersToBoardRequest) Do(ctx context.Context) ([]User, error) {
+	var data struct {
+		Result []User `json:"add_subscribers_to_board"`
+	}
+	err := r.client.Do(ctx, monday.NewMutationPayload(r.Mutation()), &data)
+	return data.Result, err
+}
+
+// ArchiveBoardRequest is a archive_board mutation, see Client.ArchiveBoard.
+type ArchiveBoardRequest struct {
+	request
+	fields []BoardField
+}
+
+// Archive a board.
+func (c *Client) ArchiveBoard(boardID int) *ArchiveBoardRequest {
+	r := ArchiveBoardRequest{request: request{client: c.Client}}
+	r.set("board_id", boardID)
+	return &r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *ArchiveBoardRequest) Select(fields ...BoardField) *ArchiveBoardRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *ArchiveBoardRequest) selection() []BoardField {
+	if len(r.fields) == 0 {
+		return defaultBoardFields()
+	}
+	return r.fields
+}
+
+// Mutation returns the mutation, e.g. to add it to a payload.
+func (r *ArchiveBoardRequest) Mutation() monday.Mutation {
+	return monday.NewMutation("archive_board", boardFields(r.selection()), r.arguments)
+}
+
+// Do executes the mutation and returns its result.
+func (r *ArchiveBoardRequest) Do(ctx context.Context) (*Board, error) {
+	var data struct {
+		Result *Board `json:"archive_board"`
+	}
+	err := r.client.Do(ctx, monday.NewMutationPayload(r.Mutation()), &data)
+	return data.Result, err
+}
+
+// ArchiveGroupRequest is a archive_group mutation, see Client.ArchiveGroup.
+type ArchiveGroupRequest struct {
+	request
+	fields []GroupField
+}
+
+// Archive a group.
+func (c *Client) ArchiveGroup(boardID int, groupID string) *ArchiveGroupRequest {
+	r := ArchiveGroupRequest{request: request{client: c.Client}}
+	r.set("board_id", boardID)
+	r.set("group_id", groupID)
+	return &r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *ArchiveGroupRequest) Select(fields ...GroupField) *ArchiveGroupRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *ArchiveGroupRequest) selection() []GroupField {
+	if len(r.fields) == 0 {
+		return defaultGroupFields()
+	}
+	return r.fields
+}
+
+// Mutation returns the mutation, e.g. to add it to a payload.
+func (r *ArchiveGroupRequest) Mutation() monday.Mutation {
+	return monday.NewMutation("archive_group", groupFields(r.selection()), r.arguments)
+}
+
+// Do executes the mutation and returns its result.
+func (r *ArchiveGroupRequest) Do(ctx context.Context) (*Group, error) {
+	var data struct {
+		Result *Group `json:"archive_group"`
+	}
+	err := r.client.Do(ctx, monday.NewMutationPayload(r.Mutation()), &data)
+	return data.Result, err
+}
+
+// ArchiveItemRequest is a archive_item mutation, see Client.ArchiveItem.
+type ArchiveItemRequest struct {
+	request
+	fields []ItemField
+}
+
+// Archive an item.
+func (c *Client) ArchiveItem() *ArchiveItemRequest {
+	r := ArchiveItemRequest{request: request{client: c.Client}}
+	return &r
+}
+
+// ItemID sets the item_id argument.
+func (r *ArchiveItemRequest) ItemID(value int) *ArchiveItemRequest {
+	r.set("item_id", value)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *ArchiveItemRequest) Select(fields ...ItemField) *ArchiveItemRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *ArchiveItemRequest) selection() []ItemField {
+	if len(r.fields) == 0 {
+		return defaultItemFields()
+	}
+	return r.fields
+}
+
+// Mutation returns the mutation, e.g. to add it to a payload.
+func (r *ArchiveItemRequest) Mutation() monday.Mutation {
+	return monday.NewMutation("archive_item", itemFields(r.selection()), r.arguments)
+}
+
+// Do executes the mutation and returns its result.
+func (r *ArchiveItemRequest) Do(ctx context.Context) (*Item, error) {
+	var data struct {
+		Result *Item `json:"archive_item"`
+	}
+	err := r.client.Do(ctx, monday.NewMutationPayload(r.Mutation()), &data)
+	return data.Result, err
+}
+
+// ChangeColumnMetadataRequest is a change_column_metadata mutation, see Client.ChangeColumnMetadata.
+type ChangeColumnMetadataRequest struct {
+	request
+	fields []ColumnField
+}
+
+// Change the title or description of a column.
+func (c *Client) ChangeColumnMetadata(boardID int, columnID string) *ChangeColumnMetadataRequest {
+	r := ChangeColumnMetadataRequest{request: request{client: c.Client}}
+	r.set("board_id", boardID)
+	r.set("column_id", columnID)
+	return &r
+}
+
+// ColumnProperty sets the column_property argument.
+func (r *ChangeColumnMetadataRequest) ColumnProperty(value ColumnProperty) *ChangeColumnMetadataRequest {
+	r.set("column_property", value)
+	return r
+}
+
+// Value sets the value argument.
+func (r *ChangeColumnMetadataRequest) Value(value string) *ChangeColumnMetadataRequest {
+	r.set("value", value)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *ChangeColumnMetadataRequest) Select(fields ...ColumnField) *ChangeColumnMetadataRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *ChangeColumnMetadataRequest) selection() []ColumnField {
+	if len(r.fields) == 0 {
+		return defaultColumnFields()
+	}
+	return r.fields
+}
+
+// Mutation returns the mutation, e.g. to add it to a payload.
+func (r *ChangeColumnMetadataRequest) Mutation() monday.Mutation {
+	return monday.NewMutation("change_column_metadata", columnFields(r.selection()), r.arguments)
+}
+
+// Do executes the mutation and returns its result.
+func (r *ChangeColumnMetadataRequest) Do(ctx context.Context) (*Column, error) {
+	var data struct {
+		Result *Column `json:"change_column_metadata"`
+	}
+	err := r.client.Do(ctx, monday.NewMutationPayload(r.Mutation()), &data)
+	return data.Result, err
+}
+
+// ChangeColumnValueRequest is a change_column_value mutation, see Client.ChangeColumnValue.
+type ChangeColumnValueRequest struct {
+	request
+	fields []ItemField
+}
+
+// Change the value of a column of an item.
+func (c *Client) ChangeColumnValue(columnID string, boardID int, value string) *ChangeColumnValueRequest {
+	r := ChangeColumnValueRequest{request: request{client: c.Client}}
+	r.set("column_id", columnID)
+	r.set("board_id", boardID)
+	r.set("value", value)
+	return &r
+}
+
+// ItemID sets the item_id argument.
+func (r *ChangeColumnValueRequest) ItemID(value int) *ChangeColumnValueRequest {
+	r.set("item_id", value)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *ChangeColumnValueRequest) Select(fields ...ItemField) *ChangeColumnValueRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *ChangeColumnValueRequest) selection() []ItemField {
+	if len(r.fields) == 0 {
+		return defaultItemFields()
+	}
+	return r.fields
+}
+
+// Mutation returns the mutation, e.g. to add it to a payload.
+func (r *ChangeColumnValueRequest) Mutation() monday.Mutation {
+	return monday.NewMutation("change_column_value", itemFields(r.selection()), r.arguments)
+}
+
+// Do executes the mutation and returns its result.
+func (r *ChangeColumnValueRequest) Do(ctx context.Context) (*Item, error) {
+	var data struct {
+		Result *Item `json:"change_column_value"`
+	}
+	err := r.client.Do(ctx, monday.NewMutationPayload(r.Mutation()), &data)
+	return data.Result, err
+}
+
+// ChangeMultipleColumnValuesRequest is a change_multiple_column_values mutation, see Client.ChangeMultipleColumnValues.
+type ChangeMultipleColumnValuesRequest struct {
+	request
+	fields []ItemField
+}
+
+// Change multiple column values of an item.
+func (c *Client) ChangeMultipleColumnValues(boardID int, columnValues string) *ChangeMultipleColumnValuesRequest {
+	r := ChangeMultipleColumnValuesRequest{request: request{client: c.Client}}
+	r.set("board_id", boardID)
+	r.set("column_values", columnValues)
+	return &r
+}
+
+// ItemID sets the item_id argument.
+func (r *ChangeMultipleColumnValuesRequest) ItemID(value int) *ChangeMultipleColumnValuesRequest {
+	r.set("item_id", value)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *ChangeMultipleColumnValuesRequest) Select(fields ...ItemField) *ChangeMultipleColumnValuesRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *ChangeMultipleColumnValuesRequest) selection() []ItemField {
+	if len(r.fields) == 0 {
+		return defaultItemFields()
+	}
+	return r.fields
+}
+
+// Mutation returns the mutation, e.g. to add it to a payload.
+func (r *ChangeMultipleColumnValuesRequest) Mutation() monday.Mutation {
+	return monday.NewMutation("change_multiple_column_values", itemFields(r.selection()), r.arguments)
+}
+
+// Do executes the mutation and returns its result.
+func (r *ChangeMultipleColumnValuesRequest) Do(ctx context.Context) (*Item, error) {
+	var data struct {
+		Result *Item `json:"change_multiple_column_values"`
+	}
+	err := r.client.Do(ctx, monday.NewMutationPayload(r.Mutation()), &data)
+	return data.Result, err
+}
+
+// CreateBoardRequest is a create_board mutation, see Client.CreateBoard.
+type CreateBoardRequest struct {
+	request
+	fields []BoardField
+}
+
+// Create a new board.
+func (c *Client) CreateBoard(boardName string, boardKind BoardKind) *CreateBoardRequest {
+	r := CreateBoardRequest{request: request{client: c.Client}}
+	r.set("board_name", boardName)
+	r.set("board_kind", boardKind)
+	return &r
+}
+
+// TemplateID sets the template_id argument.
+func (r *CreateBoardRequest) TemplateID(value int) *CreateBoardRequest {
+	r.set("template_id", value)
+	return r
+}
+
+// WorkspaceID sets the workspace_id argument.
+func (r *CreateBoardRequest) WorkspaceID(value int) *CreateBoardRequest {
+	r.set("workspace_id", value)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *CreateBoardRequest) Select(fields ...BoardField) *CreateBoardRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *CreateBoardRequest) selection() []BoardField {
+	if len(r.fields) == 0 {
+		return defaultBoardFields()
+	}
+	return r.fields
+}
+
+// Mutation returns the mutation, e.g. to add it to a payload.
+func (r *CreateBoardRequest) Mutation() monday.Mutation {
+	return monday.NewMutation("create_board", boardFields(r.selection()), r.arguments)
+}
+
+// Do executes the mutation and returns its result.
+func (r *CreateBoardRequest) Do(ctx context.Context) (*Board, error) {
+	var data struct {
+		Result *Board `json:"create_board"`
+	}
+	err := r.client.Do(ctx, monday.NewMutationPayload(r.Mutation()), &data)
+	return data.Result, err
+}
+
+// CreateColumnRequest is a create_column mutation, see Client.CreateColumn.
+type CreateColumnRequest struct {
+	request
+	fields []ColumnField
+}
+
+// Create a new column in a board.
+func (c *Client) CreateColumn(boardID int, title string) *CreateColumnRequest {
+	r := CreateColumnRequest{request: request{client: c.Client}}
+	r.set("board_id", boardID)
+	r.set("title", title)
+	return &r
+}
+
+// ColumnType sets the column_type argument.
+func (r *CreateColumnRequest) ColumnType(value ColumnType) *CreateColumnRequest {
+	r.set("column_type", value)
+	return r
+}
+
+// Defaults sets the defaults argument.
+func (r *CreateColumnRequest) Defaults(value string) *CreateColumnRequest {
+	r.set("defaults", value)
+	return r
+}
+
+// Description sets the description argument.
+func (r *CreateColumnRequest) Description(value string) *CreateColumnRequest {
+	r.set("description", value)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *CreateColumnRequest) Select(fields ...ColumnField) *CreateColumnRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *CreateColumnRequest) selection() []ColumnField {
+	if len(r.fields) == 0 {
+		return defaultColumnFields()
+	}
+	return r.fields
+}
+
+// Mutation returns the mutation, e.g. to add it to a payload.
+func (r *CreateColumnRequest) Mutation() monday.Mutation {
+	return monday.NewMutation("create_column", columnFields(r.selection()), r.arguments)
+}
+
+// Do executes the mutation and returns its result.
+func (r *CreateColumnRequest) Do(ctx context.Context) (*Column, error) {
+	var data struct {
+		Result *Column `json:"create_column"`
+	}
+	err := r.client.Do(ctx, monday.NewMutationPayload(r.Mutation()), &data)
+	return data.Result, err
+}
+
+// CreateGroupRequest is a create_group mutation, see Client.CreateGroup.
+type CreateGroupRequest struct {
+	request
+	fields []GroupField
+}
+
+// Create a new group.
+func (c *Client) CreateGroup(boardID int, groupName string) *CreateGroupRequest {
+	r := CreateGroupRequest{request: request{client: c.Client}}
+	r.set("board_id", boardID)
+	r.set("group_name", groupName)
+	return &r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *CreateGroupRequest) Select(fields ...GroupField) *CreateGroupRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *CreateGroupRequest) selection() []GroupField {
+	if len(r.fields) == 0 {
+		return defaultGroupFields()
+	}
+	return r.fields
+}
+
+// Mutation returns the mutation, e.g. to add it to a payload.
+func (r *CreateGroupRequest) Mutation() monday.Mutation {
+	return monday.NewMutation("create_group", groupFields(r.selection()), r.arguments)
+}
+
+// Do executes the mutation and returns its result.
+func (r *CreateGroupRequest) Do(ctx context.Context) (*Group, error) {
+	var data struct {
+		Result *Group `json:"create_group"`
+	}
+	err := r.client.Do(ctx, monday.NewMutationPayload(r.Mutation()), &data)
+	return data.Result, err
+}
+
+// CreateItemRequest is a create_item mutation, see Client.CreateItem.
+type CreateItemRequest struct {
+	request
+	fields []ItemField
+}
+
+// Create a new item.
+func (c *Client) CreateItem(boardID int) *CreateItemRequest {
+	r := CreateItemRequest{request: request{client: c.Client}}
+	r.set("board_id", boardID)
+	return &r
+}
+
+// ItemName sets the item_name argument.
+func (r *CreateItemRequest) ItemName(value string) *CreateItemRequest {
+	r.set("item_name", value)
+	return r
+}
+
+// GroupID sets the group_id argument.
+func (r *CreateItemRequest) GroupID(value string) *CreateItemRequest {
+	r.set("group_id", value)
+	return r
+}
+
+// ColumnValues sets the column_values argument.
+func (r *CreateItemRequest) ColumnValues(value string) *CreateItemRequest {
+	r.set("column_values", value)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *CreateItemRequest) Select(fields ...ItemField) *CreateItemRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *CreateItemRequest) selection() []ItemField {
+	if len(r.fields) == 0 {
+		return defaultItemFields()
+	}
+	return r.fields
+}
+
+// Mutation returns the mutation, e.g. to add it to a payload.
+func (r *CreateItemRequest) Mutation() monday.Mutation {
+	return monday.NewMutation("create_item", itemFields(r.selection()), r.arguments)
+}
+
+// Do executes the mutation and returns its result.
+func (r *CreateItemRequest) Do(ctx context.Context) (*Item, error) {
+	var data struct {
+		Result *Item `json:"create_item"`
+	}
+	err := r.client.Do(ctx, monday.NewMutationPayload(r.Mutation()), &data)
+	return data.Result, err
+}
+
+// CreateNotificationRequest is a create_notification mutation, see Client.CreateNotification.
+type CreateNotificationRequest struct {
+	request
+	fields []NotificationField
+}
+
+// Create a new notification.
+func (c *Client) CreateNotification(text string, userID int, targetID int, targetType NotificationTargetType) *CreateNotificationRequest {
+	r := CreateNotificationRequest{request: request{client: c.Client}}
+	r.set("text", text)
+	r.set("user_id", userID)
+	r.set("target_id", targetID)
+	r.set("target_type", targetType)
+	return &r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *CreateNotificationRequest) Select(fields ...NotificationField) *CreateNotificationRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *CreateNotificationRequest) selection() []NotificationField {
+	if len(r.fields) == 0 {
+		return defaultNotificationFields()
+	}
+	return r.fields
+}
+
+// Mutation returns the mutation, e.g. to add it to a payload.
+func (r *CreateNotificationRequest) Mutation() monday.Mutation {
+	return monday.NewMutation("create_notification", notificationFields(r.selection()), r.arguments)
+}
+
+// Do executes the mutation and returns its result.
+func (r *CreateNotificationRequest) Do(ctx context.Context) (*Notification, error) {
+	var data struct {
+		Result *Notification `json:"create_notification"`
+	}
+	err := r.client.Do(ctx, monday.NewMutationPayload(r.Mutation()), &data)
+	return data.Result, err
+}
+
+// CreateOrGetTagRequest is a create_or_get_tag mutation, see Client.CreateOrGetTag.
+type CreateOrGetTagRequest struct {
+	request
+	fields []TagField
+}
+
+// Create a new tag or get it if it already exists.
+func (c *Client) CreateOrGetTag() *CreateOrGetTagRequest {
+	r := CreateOrGetTagRequest{request: request{client: c.Client}}
+	return &r
+}
+
+// TagName sets the tag_name argument.
+func (r *CreateOrGetTagRequest) TagName(value string) *CreateOrGetTagRequest {
+	r.set("tag_name", value)
+	return r
+}
+
+// BoardID sets the board_id argument.
+func (r *CreateOrGetTagRequest) BoardID(value int) *CreateOrGetTagRequest {
+	r.set("board_id", value)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *CreateOrGetTagRequest) Select(fields ...TagField) *CreateOrGetTagRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *CreateOrGetTagRequest) selection() []TagField {
+	if len(r.fields) == 0 {
+		return defaultTagFields()
+	}
+	return r.fields
+}
+
+// Mutation returns the mutation, e.g. to add it to a payload.
+func (r *CreateOrGetTagRequest) Mutation() monday.Mutation {
+	return monday.NewMutation("create_or_get_tag", tagFields(r.selection()), r.arguments)
+}
+
+// Do executes the mutation and returns its result.
+func (r *CreateOrGetTagRequest) Do(ctx context.Context) (*Tag, error) {
+	var data struct {
+		Result *Tag `json:"create_or_get_tag"`
+	}
+	err := r.client.Do(ctx, monday.NewMutationPayload(r.Mutation()), &data)
+	return data.Result, err
+}
+
+// CreateSubitemRequest is a create_subitem mutation, see Client.CreateSubitem.
+type CreateSubitemRequest struct {
+	request
+	fields []ItemField
+}
+
+// Create a subitem.
+func (c *Client) CreateSubitem(parentItemID int, itemName string) *CreateSubitemRequest {
+	r := CreateSubitemRequest{request: request{client: c.Client}}
+	r.set("parent_item_id", parentItemID)
+	r.set("item_name", itemName)
+	return &r
+}
+
+// ColumnValues sets the column_values argument.
+func (r *CreateSubitemRequest) ColumnValues(value string) *CreateSubitemRequest {
+	r.set("column_values", value)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *CreateSubitemRequest) Select(fields ...ItemField) *CreateSubitemRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *CreateSubitemRequest) selection() []ItemField {
+	if len(r.fields) == 0 {
+		return defaultItemFields()
+	}
+	return r.fields
+}
+
+// Mutation returns the mutation, e.g. to add it to a payload.
+func (r *CreateSubitemRequest) Mutation() monday.Mutation {
+	return monday.NewMutation("create_subitem", itemFields(r.selection()), r.arguments)
+}
+
+// Do executes the mutation and returns its result.
+func (r *CreateSubitemRequest) Do(ctx context.Context) (*Item, error) {
+	var data struct {
+		Result *Item `json:"create_subitem"`
+	}
+	err := r.client.Do(ctx, monday.NewMutationPayload(r.Mutation()), &data)
+	return data.Result, err
+}
+
+// CreateUpdateRequest is a create_update mutation, see Client.CreateUpdate.
+type CreateUpdateRequest struct {
+	request
+	fields []UpdateField
+}
+
+// Create a new update or a reply on an update.
+func (c *Client) CreateUpdate(body string) *CreateUpdateRequest {
+	r := CreateUpdateRequest{request: request{client: c.Client}}
+	r.set("body", body)
+	return &r
+}
+
+// ItemID sets the item_id argument.
+func (r *CreateUpdateRequest) ItemID(value int) *CreateUpdateRequest {
+	r.set("item_id", value)
+	return r
+}
+
+// ParentID sets the parent_id argument.
+func (r *CreateUpdateRequest) ParentID(value int) *CreateUpdateRequest {
+	r.set("parent_id", value)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *CreateUpdateRequest) Select(fields ...UpdateField) *CreateUpdateRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *CreateUpdateRequest) selection() []UpdateField {
+	if len(r.fields) == 0 {
+		return defaultUpdateFields()
+	}
+	return r.fields
+}
+
+// Mutation returns the mutation, e.g. to add it to a payload.
+func (r *CreateUpdateRequest) Mutation() monday.Mutation {
+	return monday.NewMutation("create_update", updateFields(r.selection()), r.arguments)
+}
+
+// Do executes the mutation and returns its result.
+func (r *CreateUpdateRequest) Do(ctx context.Context) (*Update, error) {
+	var data struct {
+		Result *Update `json:"create_update"`
+	}
+	err := r.client.Do(ctx, monday.NewMutationPayload(r.Mutation()), &data)
+	return data.Result, err
+}
+
+// CreateWebhookRequest is a create_webhook mutation, see Client.CreateWebhook.
+type CreateWebhookRequest struct {
+	request
+	fields []WebhookField
+}
+
+// Create a new webhook.
+func (c *Client) CreateWebhook(boardID int, url string, event WebhookEventType) *CreateWebhookRequest {
+	r := CreateWebhookRequest{request: request{client: c.Client}}
+	r.set("board_id", boardID)
+	r.set("url", url)
+	r.set("event", event)
+	return &r
+}
+
+// Config sets the config argument.
+func (r *CreateWebhookRequest) Config(value string) *CreateWebhookRequest {
+	r.set("config", value)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *CreateWebhookRequest) Select(fields ...WebhookField) *CreateWebhookRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *CreateWebhookRequest) selection() []WebhookField {
+	if len(r.fields) == 0 {
+		return defaultWebhookFields()
+	}
+	return r.fields
+}
+
+// Mutation returns the mutation, e.g. to add it to a payload.
+func (r *CreateWebhookRequest) Mutation() monday.Mutation {
+	return monday.NewMutation("create_webhook", webhookFields(r.selection()), r.arguments)
+}
+
+// Do executes the mutation and returns its result.
+func (r *CreateWebhookRequest) Do(ctx context.Context) (*Webhook, error) {
+	var data struct {
+		Result *Webhook `json:"create_webhook"`
+	}
+	err := r.client.Do(ctx, monday.NewMutationPayload(r.Mutation()), &data)
+	return data.Result, err
+}
+
+// CreateWorkspaceRequest is a create_workspace mutation, see Client.CreateWorkspace.
+type CreateWorkspaceRequest struct {
+	request
+	fields []WorkspaceField
+}
+
+// Create a new workspace.
+func (c *Client) CreateWorkspace(name string, kind WorkspaceKind) *CreateWorkspaceRequest {
+	r := CreateWorkspaceRequest{request: request{client: c.Client}}
+	r.set("name", name)
+	r.set("kind", kind)
+	return &r
+}
+
+// Description sets the description argument.
+func (r *CreateWorkspaceRequest) Description(value string) *CreateWorkspaceRequest {
+	r.set("description", value)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *CreateWorkspaceRequest) Select(fields ...WorkspaceField) *CreateWorkspaceRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *CreateWorkspaceRequest) selection() []WorkspaceField {
+	if len(r.fields) == 0 {
+		return defaultWorkspaceFields()
+	}
+	return r.fields
+}
+
+// Mutation returns the mutation, e.g. to add it to a payload.
+func (r *CreateWorkspaceRequest) Mutation() monday.Mutation {
+	return monday.NewMutation("create_workspace", workspaceFields(r.selection()), r.arguments)
+}
+
+// Do executes the mutation and returns its result.
+func (r *CreateWorkspaceRequest) Do(ctx context.Context) (*Workspace, error) {
+	var data struct {
+		Result *Workspace `json:"create_workspace"`
+	}
+	err := r.client.Do(ctx, monday.NewMutationPayload(r.Mutation()), &data)
+	return data.Result, err
+}
+
+// DeleteColumnRequest is a delete_column mutation, see Client.DeleteColumn.
+type DeleteColumnRequest struct {
+	request
+	fields []ColumnField
+}
+
+// Delete a column.
+func (c *Client) DeleteColumn(boardID int, columnID string) *DeleteColumnRequest {
+	r := DeleteColumnRequest{request: request{client: c.Client}}
+	r.set("board_id", boardID)
+	r.set("column_id", columnID)
+	return &r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *DeleteColumnRequest) Select(fields ...ColumnField) *DeleteColumnRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *DeleteColumnRequest) selection() []ColumnField {
+	if len(r.fields) == 0 {
+		return defaultColumnFields()
+	}
+	return r.fields
+}
+
+// Mutation returns the mutation, e.g. to add it to a payload.
+func (r *DeleteColumnRequest) Mutation() monday.Mutation {
+	return monday.NewMutation("delete_column", columnFields(r.selection()), r.arguments)
+}
+
+// Do executes the mutation and returns its result.
+func (r *DeleteColumnRequest) Do(ctx context.Context) (*Column, error) {
+	var data struct {
+		Result *Column `json:"delete_column"`
+	}
+	err := r.client.Do(ctx, monday.NewMutationPayload(r.Mutation()), &data)
+	return data.Result, err
+}
+
+// DeleteGroupRequest is a delete_group mutation, see Client.DeleteGroup.
+type DeleteGroupRequest struct {
+	request
+	fields []GroupField
+}
+
+// Delete a group.
+func (c *Client) DeleteGroup(boardID int, groupID string) *DeleteGroupRequest {
+	r := DeleteGroupRequest{request: request{client: c.Client}}
+	r.set("board_id", boardID)
+	r.set("group_id", groupID)
+	return &r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *DeleteGroupRequest) Select(fields ...GroupField) *DeleteGroupRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *DeleteGroupRequest) selection() []GroupField {
+	if len(r.fields) == 0 {
+		return defaultGroupFields()
+	}
+	return r.fields
+}
+
+// Mutation returns the mutation, e.g. to add it to a payload.
+func (r *DeleteGroupRequest) Mutation() monday.Mutation {
+	return monday.NewMutation("delete_group", groupFields(r.selection()), r.arguments)
+}
+
+// Do executes the mutation and returns its result.
+func (r *DeleteGroupRequest) Do(ctx context.Context) (*Group, error) {
+	var data struct {
+		Result *Group `json:"delete_group"`
+	}
+	err := r.client.Do(ctx, monday.NewMutationPayload(r.Mutation()), &data)
+	return data.Result, err
+}
+
+// DeleteItemRequest is a delete_item mutation, see Client.DeleteItem.
+type DeleteItemRequest struct {
+	request
+	fields []ItemField
+}
+
+// Delete an item.
+func (c *Client) DeleteItem() *DeleteItemRequest {
+	r := DeleteItemRequest{request: request{client: c.Client}}
+	return &r
+}
+
+// ItemID sets the item_id argument.
+func (r *DeleteItemRequest) ItemID(value int) *DeleteItemRequest {
+	r.set("item_id", value)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *DeleteItemRequest) Select(fields ...ItemField) *DeleteItemRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *DeleteItemRequest) selection() []ItemField {
+	if len(r.fields) == 0 {
+		return defaultItemFields()
+	}
+	return r.fields
+}
+
+// Mutation returns the mutation, e.g. to add it to a payload.
+func (r *DeleteItemRequest) Mutation() monday.Mutation {
+	return monday.NewMutation("delete_item", itemFields(r.selection()), r.arguments)
+}
+
+// Do executes the mutation and returns its result.
+func (r *DeleteItemRequest) Do(ctx context.Context) (*Item, error) {
+	var data struct {
+		Result *Item `json:"delete_item"`
+	}
+	err := r.client.Do(ctx, monday.NewMutationPayload(r.Mutation()), &data)
+	return data.Result, err
+}
+
+// DeleteWebhookRequest is a delete_webhook mutation, see Client.DeleteWebhook.
+type DeleteWebhookRequest struct {
+	request
+	fields []WebhookField
+}
+
+// Delete a webhook.
+func (c *Client) DeleteWebhook(id int) *DeleteWebhookRequest {
+	r := DeleteWebhookRequest{request: request{client: c.Client}}
+	r.set("id", id)
+	return &r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *DeleteWebhookRequest) Select(fields ...WebhookField) *DeleteWebhookRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *DeleteWebhookRequest) selection() []WebhookField {
+	if len(r.fields) == 0 {
+		return defaultWebhookFields()
+	}
+	return r.fields
+}
+
+// Mutation returns the mutation, e.g. to add it to a payload.
+func (r *DeleteWebhookRequest) Mutation() monday.Mutation {
+	return monday.NewMutation("delete_webhook", webhookFields(r.selection()), r.arguments)
+}
+
+// Do executes the mutation and returns its result.
+func (r *DeleteWebhookRequest) Do(ctx context.Context) (*Webhook, error) {
+	var data struct {
+		Result *Webhook `json:"delete_webhook"`
+	}
+	err := r.client.Do(ctx, monday.NewMutationPayload(r.Mutation()), &data)
+	return data.Result, err
+}
+
+// DuplicateGroupRequest is a duplicate_group mutation, see Client.DuplicateGroup.
+type DuplicateGroupRequest struct {
+	request
+	fields []GroupField
+}
+
+// Duplicate a group.
+func (c *Client) DuplicateGroup(boardID int, groupID string) *DuplicateGroupRequest {
+	r := DuplicateGroupRequest{request: request{client: c.Client}}
+	r.set("board_id", boardID)
+	r.set("group_id", groupID)
+	return &r
+}
+
+// AddToTop sets the add_to_top argument.
+func (r *DuplicateGroupRequest) AddToTop(value bool) *DuplicateGroupRequest {
+	r.set("add_to_top", value)
+	return r
+}
+
+// GroupTitle sets the group_title argument.
+func (r *DuplicateGroupRequest) GroupTitle(value string) *DuplicateGroupRequest {
+	r.set("group_title", value)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *DuplicateGroupRequest) Select(fields ...GroupField) *DuplicateGroupRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *DuplicateGroupRequest) selection() []GroupField {
+	if len(r.fields) == 0 {
+		return defaultGroupFields()
+	}
+	return r.fields
+}
+
+// Mutation returns the mutation, e.g. to add it to a payload.
+func (r *DuplicateGroupRequest) Mutation() monday.Mutation {
+	return monday.NewMutation("duplicate_group", groupFields(r.selection()), r.arguments)
+}
+
+// Do executes the mutation and returns its result.
+func (r *DuplicateGroupRequest) Do(ctx context.Context) (*Group, error) {
+	var data struct {
+		Result *Group `json:"duplicate_group"`
+	}
+	err := r.client.Do(ctx, monday.NewMutationPayload(r.Mutation()), &data)
+	return data.Result, err
+}
+
+// MoveItemToBoardRequest is a move_item_to_board mutation, see Client.MoveItemToBoard.
+type MoveItemToBoardRequest struct {
+	request
+	fields []ItemField
+}
+
+// Move an item to a different board.
+func (c *Client) MoveItemToBoard(boardID int, groupID string, itemID int) *MoveItemToBoardRequest {
+	r := MoveItemToBoardRequest{request: request{client: c.Client}}
+	r.set("board_id", boardID)
+	r.set("group_id", groupID)
+	r.set("item_id", itemID)
+	return &r
+}
+
+// The mapping of the columns of the item to the columns of the target board.
+func (r *MoveItemToBoardRequest) ColumnsMapping(values ...ColumnMappingInput) *MoveItemToBoardRequest {
+	r.set("columns_mapping", values)
+	return r
+}
+
+// The mapping of the columns of the subitems to the columns of the target subitems board.
+func (r *MoveItemToBoardRequest) SubitemsColumnsMapping(values ...ColumnMappingInput) *MoveItemToBoardRequest {
+	r.set("subitems_columns_mapping", values)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *MoveItemToBoardRequest) Select(fields ...ItemField) *MoveItemToBoardRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *MoveItemToBoardRequest) selection() []ItemField {
+	if len(r.fields) == 0 {
+		return defaultItemFields()
+	}
+	return r.fields
+}
+
+// Mutation returns the mutation, e.g. to add it to a payload.
+func (r *MoveItemToBoardRequest) Mutation() monday.Mutation {
+	return monday.NewMutation("move_item_to_board", itemFields(r.selection()), r.arguments)
+}
+
+// Do executes the mutation and returns its result.
+func (r *MoveItemToBoardRequest) Do(ctx context.Context) (*Item, error) {
+	var data struct {
+		Result *Item `json:"move_item_to_board"`
+	}
+	err := r.client.Do(ctx, monday.NewMutationPayload(r.Mutation()), &data)
+	return data.Result, err
+}
+
+// MoveItemToGroupRequest is a move_item_to_group mutation, see Client.MoveItemToGroup.
+type MoveItemToGroupRequest struct {
+	request
+	fields []ItemField
+}
+
+// Move an item to a different group.
+func (c *Client) MoveItemToGroup(groupID string) *MoveItemToGroupRequest {
+	r := MoveItemToGroupRequest{request: request{client: c.Client}}
+	r.set("group_id", groupID)
+	return &r
+}
+
+// ItemID sets the item_id argument.
+func (r *MoveItemToGroupRequest) ItemID(value int) *MoveItemToGroupRequest {
+	r.set("item_id", value)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *MoveItemToGroupRequest) Select(fields ...ItemField) *MoveItemToGroupRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *MoveItemToGroupRequest) selection() []ItemField {
+	if len(r.fields) == 0 {
+		return defaultItemFields()
+	}
+	return r.fields
+}
+
+// Mutation returns the mutation, e.g. to add it to a payload.
+func (r *MoveItemToGroupRequest) Mutation() monday.Mutation {
+	return monday.NewMutation("move_item_to_group", itemFields(r.selection()), r.arguments)
+}
+
+// Do executes the mutation and returns its result.
+func (r *MoveItemToGroupRequest) Do(ctx context.Context) (*Item, error) {
+	var data struct {
+		Result *Item `json:"move_item_to_group"`
+	}
+	err := r.client.Do(ctx, monday.NewMutationPayload(r.Mutation()), &data)
+	return data.Result, err
+}
+
+// UpdateBoardRequest is a update_board mutation, see Client.UpdateBoard.
+type UpdateBoardRequest struct {
+	request
+}
+
+// Update an attribute of a board.
+func (c *Client) UpdateBoard(boardID int, boardAttribute BoardAttributes, newValue string) *UpdateBoardRequest {
+	r := UpdateBoardRequest{request: request{client: c.Client}}
+	r.set("board_id", boardID)
+	r.set("board_attribute", boardAttribute)
+	r.set("new_value", newValue)
+	return &r
+}
+
+// Mutation returns the mutation, e.g. to add it to a payload.
+func (r *UpdateBoardRequest) Mutation() monday.Mutation {
+	return monday.NewMutation("update_board", nil, r.arguments)
+}
+
+// Do executes the mutation and returns its result.
+func (r *UpdateBoardRequest) Do(ctx context.Context) (string, error) {
+	var data struct {
+		Result string `json:"update_board"`
+	}
+	err := r.client.Do(ctx, monday.NewMutationPayload(r.Mutation()), &data)
+	return data.Result, err
+}
+
+// UpdateColumnRequest is a update_column mutation, see Client.UpdateColumn.
+type UpdateColumnRequest struct {
+	request
+	fields []ColumnField
+}
+
+// Update the type and settings of a column.
+func (c *Client) UpdateColumn(boardID int, id string, columnType ColumnType) *UpdateColumnRequest {
+	r := UpdateColumnRequest{request: request{client: c.Client}}
+	r.set("board_id", boardID)
+	r.set("id", id)
+	r.set("column_type", columnType)
+	return &r
+}
+
+// Settings sets the settings argument.
+func (r *UpdateColumnRequest) Settings(value string) *UpdateColumnRequest {
+	r.set("settings", value)
+	return r
+}
+
+// Revision sets the revision argument.
+func (r *UpdateColumnRequest) Revision(value string) *UpdateColumnRequest {
+	r.set("revision", value)
+	return r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *UpdateColumnRequest) Select(fields ...ColumnField) *UpdateColumnRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *UpdateColumnRequest) selection() []ColumnField {
+	if len(r.fields) == 0 {
+		return defaultColumnFields()
+	}
+	return r.fields
+}
+
+// Mutation returns the mutation, e.g. to add it to a payload.
+func (r *UpdateColumnRequest) Mutation() monday.Mutation {
+	return monday.NewMutation("update_column", columnFields(r.selection()), r.arguments)
+}
+
+// Do executes the mutation and returns its result.
+func (r *UpdateColumnRequest) Do(ctx context.Context) (*Column, error) {
+	var data struct {
+		Result *Column `json:"update_column"`
+	}
+	err := r.client.Do(ctx, monday.NewMutationPayload(r.Mutation()), &data)
+	return data.Result, err
+}
+
+// UpdateGroupRequest is a update_group mutation, see Client.UpdateGroup.
+type UpdateGroupRequest struct {
+	request
+	fields []GroupField
+}
+
+// Update an attribute of a group.
+func (c *Client) UpdateGroup(boardID int, groupID string, groupAttribute GroupAttributes, newValue string) *UpdateGroupRequest {
+	r := UpdateGroupRequest{request: request{client: c.Client}}
+	r.set("board_id", boardID)
+	r.set("group_id", groupID)
+	r.set("group_attribute", groupAttribute)
+	r.set("new_value", newValue)
+	return &r
+}
+
+// Select adds fields to the selection, by default the fields without arguments are selected.
+func (r *UpdateGroupRequest) Select(fields ...GroupField) *UpdateGroupRequest {
+	r.fields = append(r.fields, fields...)
+	return r
+}
+
+func (r *UpdateGroupRequest) selection() []GroupField {
+	if len(r.fields) == 0 {
+		return defaultGroupFields()
+	}
+	return r.fields
+}
+
+// Mutation returns the mutation, e.g. to add it to a payload.
+func (r *UpdateGroupRequest) Mutation() monday.Mutation {
+	return monday.NewMutation("update_group", groupFields(r.selection()), r.arguments)
+}
+
+// Do executes the mutation and returns its result.
+func (r *UpdateGroupRequest) Do(ctx context.Context) (*Group, error) {
+	var data struct {
+		Result *Group `json:"update_group"`
+	}
+	err := r.client.Do(ctx, monday.NewMutationPayload(r.Mutation()), &data)
+	return data.Result, err
+}
+
+func defaultAccountFields() []AccountField {
+	return []AccountField{
+		AccountFirstDayOfTheWeekField(),
+		AccountIDField(),
+		AccountLogoField(),
+		AccountNameField(),
+		AccountShowTimelineWeekendsField(),
+		AccountSlugField(),
+	}
+}
+
+func defaultBoardFields() []BoardField {
+	return []BoardField{
+		BoardBoardFolderIDField(),
+		BoardBoardKindField(),
+		BoardDescriptionField(),
+		BoardIDField(),
+		BoardNameField(),
+		BoardPermissionsField(),
+		BoardPosField(),
+		BoardStateField(),
+		BoardWorkspaceIDField(),
+	}
+}
+
+func defaultColumnFields() []ColumnField {
+	return []ColumnField{
+		ColumnArchivedField(),
+		ColumnDescriptionField(),
+		ColumnIDField(),
+		ColumnSettingsStrField(),
+		ColumnTitleField(),
+		ColumnTypeField(),
+		ColumnWidthField(),
+	}
+}
+
+func defaultComplexityFields() []ComplexityField {
+	return []ComplexityField{
+		ComplexityAfterField(),
+		ComplexityBeforeField(),
+		ComplexityQueryField(),
+	}
+}
+
+func defaultDocumentFields() []DocumentField {
+	return []DocumentField{
+		DocumentCreatedAtField(),
+		DocumentDocKindField(),
+		DocumentIDField(),
+		DocumentNameField(),
+		DocumentObjectIDField(),
+		DocumentURLField(),
+		DocumentWorkspaceIDField(),
+	}
+}
+
+func defaultGroupFields() []GroupField {
+	return []GroupField{
+		GroupArchivedField(),
+		GroupColorField(),
+		GroupDeletedField(),
+		GroupIDField(),
+		GroupPositionField(),
+		GroupTitleField(),
+	}
+}
+
+func defaultItemFields() []ItemField {
+	return []ItemField{
+		ItemCreatedAtField(),
+		ItemCreatorIDField(),
+		ItemIDField(),
+		ItemNameField(),
+		ItemStateField(),
+		ItemUpdatedAtField(),
+	}
+}
+
+func defaultNotificationFields() []NotificationField {
+	return []NotificationField{
+		NotificationIDField(),
+		NotificationTextField(),
+	}
+}
+
+func defaultTagFields() []TagField {
+	return []TagField{
+		TagColorField(),
+		TagIDField(),
+		TagNameField(),
+	}
+}
+
+func defaultTeamFields() []TeamField {
+	return []TeamField{
+		TeamIDField(),
+		TeamNameField(),
+		TeamPictureURLField(),
+	}
+}
+
+func defaultUpdateFields() []UpdateField {
+	return []UpdateField{
+		UpdateBodyField(),
+		UpdateCreatedAtField(),
+		UpdateCreatorIDField(),
+		UpdateIDField(),
+		UpdateItemIDField(),
+		UpdateTextBodyField(),
+		UpdateUpdatedAtField(),
+	}
+}
+
+func defaultUserFields() []UserField {
+	return []UserField{
+		UserBirthdayField(),
+		UserCountryCodeField(),
+		UserCreatedAtField(),
+		UserEmailField(),
+		UserEnabledField(),
+		UserIDField(),
+		UserIsAdminField(),
+		UserIsGuestField(),
+		UserIsPendingField(),
+		UserJoinDateField(),
+		UserLocationField(),
+		UserMobilePhoneField(),
+		UserNameField(),
+		UserPhoneField(),
+		UserPhotoOriginalField(),
+		UserPhotoSmallField(),
+		UserPhotoThumbField(),
+		UserPhotoThumbSmallField(),
+		UserPhotoTinyField(),
+		UserTimeZoneIdentifierField(),
+		UserTitleField(),
+		UserURLField(),
+		UserUTCHoursDiffField(),
+	}
+}
+
+func defaultWebhookFields() []WebhookField {
+	return []WebhookField{
+		WebhookBoardIDField(),
+		WebhookConfigField(),
+		WebhookEventField(),
+		WebhookIDField(),
+	}
+}
+
+func defaultWorkspaceFields() []WorkspaceField {
+	return []WorkspaceField{
+		WorkspaceCreatedAtField(),
+		WorkspaceDescriptionField(),
+		WorkspaceIDField(),
+		WorkspaceKindField(),
+		WorkspaceNameField(),
+		WorkspaceStateField(),
+	}
+}
+
+// schemaJSON is the introspection result the package is generated from.
+const schemaJSON = "{\"__schema\":{\"queryType\":{\"name\":\"Query\"},\"mutationType\":{\"name\":\"Mutation\"},\"subscriptionType\":null,\"types\":[{\"kind\":\"SCALAR\",\"name\":\"ID\",\"description\":\"The `ID` scalar type represents a unique identifier.\",\"fields\":null,\"inputFields\":null,\"interfaces\":null,\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"SCALAR\",\"name\":\"Int\",\"description\":\"Represents non-fractional signed whole numeric values.\",\"fields\":null,\"inputFields\":null,\"interfaces\":null,\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"SCALAR\",\"name\":\"Float\",\"description\":\"Represents signed double-precision fractional values.\",\"fields\":null,\"inputFields\":null,\"interfaces\":null,\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"SCALAR\",\"name\":\"String\",\"description\":\"Represents textual data as UTF-8 character sequences.\",\"fields\":null,\"inputFields\":null,\"interfaces\":null,\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"SCALAR\",\"name\":\"Boolean\",\"description\":\"Represents `true` or `false` values.\",\"fields\":null,\"inputFields\":null,\"interfaces\":null,\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"SCALAR\",\"name\":\"JSON\",\"description\":\"A JSON formatted string.\",\"fields\":null,\"inputFields\":null,\"interfaces\":null,\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"SCALAR\",\"name\":\"ISO8601DateTime\",\"description\":\"An ISO 8601-encoded datetime.\",\"fields\":null,\"inputFields\":null,\"interfaces\":null,\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"SCALAR\",\"name\":\"Date\",\"description\":\"A date.\",\"fields\":null,\"inputFields\":null,\"interfaces\":null,\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"OBJECT\",\"name\":\"Query\",\"description\":\"Root query type.\",\"fields\":[{\"name\":\"account\",\"description\":\"Get the connected account's information.\",\"args\":[],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Account\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"boards\",\"description\":\"Get a collection of boards.\",\"args\":[{\"name\":\"ids\",\"description\":\"\",\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"limit\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"page\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"board_kind\",\"description\":\"\",\"type\":{\"kind\":\"ENUM\",\"name\":\"BoardKind\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"state\",\"description\":\"\",\"type\":{\"kind\":\"ENUM\",\"name\":\"State\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"newest_first\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Boolean\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"workspace_ids\",\"description\":\"\",\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null}],\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"Board\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"complexity\",\"description\":\"Get the complexity data of your queries.\",\"args\":[],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Complexity\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"docs\",\"description\":\"Get a collection of docs.\",\"args\":[{\"name\":\"ids\",\"description\":\"\",\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"object_ids\",\"description\":\"\",\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"workspace_ids\",\"description\":\"\",\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"limit\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"page\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null}],\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"Document\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"items\",\"description\":\"Get a collection of items.\",\"args\":[{\"name\":\"ids\",\"description\":\"\",\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"limit\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"page\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"newest_first\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Boolean\",\"ofType\":null},\"defaultValue\":null}],\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"Item\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"items_by_column_values\",\"description\":\"Search items by the value of a column.\",\"args\":[{\"name\":\"board_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"column_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"column_value\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"column_type\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"state\",\"description\":\"\",\"type\":{\"kind\":\"ENUM\",\"name\":\"State\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"limit\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"page\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null}],\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"Item\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"me\",\"description\":\"Get the connected user's information.\",\"args\":[],\"type\":{\"kind\":\"OBJECT\",\"name\":\"User\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"tags\",\"description\":\"Get a collection of tags.\",\"args\":[{\"name\":\"ids\",\"description\":\"\",\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null}],\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"Tag\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"teams\",\"description\":\"Get a collection of teams.\",\"args\":[{\"name\":\"ids\",\"description\":\"\",\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null}],\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"Team\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"updates\",\"description\":\"Get a collection of updates.\",\"args\":[{\"name\":\"limit\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"page\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null}],\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"Update\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"users\",\"description\":\"Get a collection of users.\",\"args\":[{\"name\":\"ids\",\"description\":\"\",\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"kind\",\"description\":\"\",\"type\":{\"kind\":\"ENUM\",\"name\":\"UserKind\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"newest_first\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Boolean\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"limit\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null}],\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"User\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"webhooks\",\"description\":\"Get the webhooks of a board.\",\"args\":[{\"name\":\"board_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"app_webhooks_only\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Boolean\",\"ofType\":null},\"defaultValue\":null}],\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"Webhook\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"workspaces\",\"description\":\"Get a collection of workspaces.\",\"args\":[{\"name\":\"ids\",\"description\":\"\",\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"limit\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"page\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"kind\",\"description\":\"\",\"type\":{\"kind\":\"ENUM\",\"name\":\"WorkspaceKind\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"state\",\"description\":\"\",\"type\":{\"kind\":\"ENUM\",\"name\":\"State\",\"ofType\":null},\"defaultValue\":null}],\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"Workspace\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"inputFields\":null,\"interfaces\":[],\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"OBJECT\",\"name\":\"Mutation\",\"description\":\"Root mutation type.\",\"fields\":[{\"name\":\"add_subscribers_to_board\",\"description\":\"Add subscribers to a board.\",\"args\":[{\"name\":\"board_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"user_ids\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}}},\"defaultValue\":null},{\"name\":\"kind\",\"description\":\"\",\"type\":{\"kind\":\"ENUM\",\"name\":\"SubscriberKind\",\"ofType\":null},\"defaultValue\":null}],\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"User\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"archive_board\",\"description\":\"Archive a board.\",\"args\":[{\"name\":\"board_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null}],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Board\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"archive_group\",\"description\":\"Archive a group.\",\"args\":[{\"name\":\"board_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"group_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"defaultValue\":null}],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Group\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"archive_item\",\"description\":\"Archive an item.\",\"args\":[{\"name\":\"item_id\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null}],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Item\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"change_column_metadata\",\"description\":\"Change the title or description of a column.\",\"args\":[{\"name\":\"board_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"column_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"column_property\",\"description\":\"\",\"type\":{\"kind\":\"ENUM\",\"name\":\"ColumnProperty\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"value\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"defaultValue\":null}],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Column\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"change_column_value\",\"description\":\"Change the value of a column of an item.\",\"args\":[{\"name\":\"item_id\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"column_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"board_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"value\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"JSON\",\"ofType\":null}},\"defaultValue\":null}],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Item\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"change_multiple_column_values\",\"description\":\"Change multiple column values of an item.\",\"args\":[{\"name\":\"item_id\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"board_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"column_values\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"JSON\",\"ofType\":null}},\"defaultValue\":null}],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Item\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"create_board\",\"description\":\"Create a new board.\",\"args\":[{\"name\":\"board_name\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"board_kind\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"ENUM\",\"name\":\"BoardKind\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"template_id\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"workspace_id\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null}],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Board\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"create_column\",\"description\":\"Create a new column in a board.\",\"args\":[{\"name\":\"board_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"title\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"column_type\",\"description\":\"\",\"type\":{\"kind\":\"ENUM\",\"name\":\"ColumnType\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"defaults\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"JSON\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"description\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"defaultValue\":null}],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Column\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"create_group\",\"description\":\"Create a new group.\",\"args\":[{\"name\":\"board_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"group_name\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"defaultValue\":null}],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Group\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"create_item\",\"description\":\"Create a new item.\",\"args\":[{\"name\":\"board_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"item_name\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"group_id\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"column_values\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"JSON\",\"ofType\":null},\"defaultValue\":null}],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Item\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"create_notification\",\"description\":\"Create a new notification.\",\"args\":[{\"name\":\"text\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"user_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"target_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"target_type\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"ENUM\",\"name\":\"NotificationTargetType\",\"ofType\":null}},\"defaultValue\":null}],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Notification\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"create_or_get_tag\",\"description\":\"Create a new tag or get it if it already exists.\",\"args\":[{\"name\":\"tag_name\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"board_id\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null}],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Tag\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"create_subitem\",\"description\":\"Create a subitem.\",\"args\":[{\"name\":\"parent_item_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"item_name\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"column_values\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"JSON\",\"ofType\":null},\"defaultValue\":null}],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Item\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"create_update\",\"description\":\"Create a new update or a reply on an update.\",\"args\":[{\"name\":\"item_id\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"body\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"parent_id\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null}],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Update\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"create_webhook\",\"description\":\"Create a new webhook.\",\"args\":[{\"name\":\"board_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"url\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"event\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"ENUM\",\"name\":\"WebhookEventType\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"config\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"JSON\",\"ofType\":null},\"defaultValue\":null}],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Webhook\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"create_workspace\",\"description\":\"Create a new workspace.\",\"args\":[{\"name\":\"name\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"kind\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"ENUM\",\"name\":\"WorkspaceKind\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"description\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"defaultValue\":null}],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Workspace\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"delete_column\",\"description\":\"Delete a column.\",\"args\":[{\"name\":\"board_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"column_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"defaultValue\":null}],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Column\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"delete_group\",\"description\":\"Delete a group.\",\"args\":[{\"name\":\"board_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"group_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"defaultValue\":null}],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Group\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"delete_item\",\"description\":\"Delete an item.\",\"args\":[{\"name\":\"item_id\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null}],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Item\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"delete_webhook\",\"description\":\"Delete a webhook.\",\"args\":[{\"name\":\"id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null}],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Webhook\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"duplicate_group\",\"description\":\"Duplicate a group.\",\"args\":[{\"name\":\"board_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"group_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"add_to_top\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Boolean\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"group_title\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"defaultValue\":null}],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Group\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"move_item_to_board\",\"description\":\"Move an item to a different board.\",\"args\":[{\"name\":\"board_id\",\"description\":\"The unique identifier of the board to move the item to.\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"group_id\",\"description\":\"The unique identifier of the group to move the item to.\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"item_id\",\"description\":\"The unique identifier of the item to move.\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"columns_mapping\",\"description\":\"The mapping of the columns of the item to the columns of the target board.\",\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"INPUT_OBJECT\",\"name\":\"ColumnMappingInput\",\"ofType\":null}}},\"defaultValue\":null},{\"name\":\"subitems_columns_mapping\",\"description\":\"The mapping of the columns of the subitems to the columns of the target subitems board.\",\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"INPUT_OBJECT\",\"name\":\"ColumnMappingInput\",\"ofType\":null}}},\"defaultValue\":null}],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Item\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"move_item_to_group\",\"description\":\"Move an item to a different group.\",\"args\":[{\"name\":\"item_id\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"group_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"defaultValue\":null}],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Item\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"update_board\",\"description\":\"Update an attribute of a board.\",\"args\":[{\"name\":\"board_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"board_attribute\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"ENUM\",\"name\":\"BoardAttributes\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"new_value\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"defaultValue\":null}],\"type\":{\"kind\":\"SCALAR\",\"name\":\"JSON\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"update_column\",\"description\":\"Update the type and settings of a column.\",\"args\":[{\"name\":\"board_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"column_type\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"ENUM\",\"name\":\"ColumnType\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"settings\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"JSON\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"revision\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"defaultValue\":null}],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Column\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"update_group\",\"description\":\"Update an attribute of a group.\",\"args\":[{\"name\":\"board_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"group_id\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"group_attribute\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"ENUM\",\"name\":\"GroupAttributes\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"new_value\",\"description\":\"\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"defaultValue\":null}],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Group\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"inputFields\":null,\"interfaces\":[],\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"OBJECT\",\"name\":\"Account\",\"description\":\"Your monday.com account.\",\"fields\":[{\"name\":\"first_day_of_the_week\",\"description\":\"The first day of the week for the account.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"ENUM\",\"name\":\"FirstDayOfTheWeek\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"id\",\"description\":\"The account's unique identifier.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"logo\",\"description\":\"The account's logo.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"name\",\"description\":\"The account's name.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"plan\",\"description\":\"The account's payment plan.\",\"args\":[],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Plan\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"show_timeline_weekends\",\"description\":\"Show weekends in the timeline.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Boolean\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"slug\",\"description\":\"The account's slug.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"inputFields\":null,\"interfaces\":[],\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"OBJECT\",\"name\":\"Plan\",\"description\":\"A payment plan.\",\"fields\":[{\"name\":\"max_users\",\"description\":\"The maximum users allowed in the plan.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"period\",\"description\":\"The plan's time period.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"tier\",\"description\":\"The plan's tier.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"version\",\"description\":\"The plan's version.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"inputFields\":null,\"interfaces\":[],\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"OBJECT\",\"name\":\"Board\",\"description\":\"A monday.com board.\",\"fields\":[{\"name\":\"board_folder_id\",\"description\":\"The board's folder unique identifier.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"board_kind\",\"description\":\"The board's kind (public / private / share).\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"ENUM\",\"name\":\"BoardKind\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"columns\",\"description\":\"The board's visible columns.\",\"args\":[{\"name\":\"ids\",\"description\":\"\",\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"defaultValue\":null}],\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"Column\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"description\",\"description\":\"The board's description.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"groups\",\"description\":\"The board's visible groups.\",\"args\":[{\"name\":\"ids\",\"description\":\"\",\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"defaultValue\":null}],\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"Group\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"id\",\"description\":\"The unique identifier of the board.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"ID\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"items\",\"description\":\"The board's items (rows).\",\"args\":[{\"name\":\"ids\",\"description\":\"\",\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"limit\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"page\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"newest_first\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Boolean\",\"ofType\":null},\"defaultValue\":null}],\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"Item\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"name\",\"description\":\"The board's name.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"owner\",\"description\":\"The owner of the board.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"User\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"permissions\",\"description\":\"The board's permissions.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"pos\",\"description\":\"The board's position.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"state\",\"description\":\"The board's state (all / active / archived / deleted).\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"ENUM\",\"name\":\"State\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"subscribers\",\"description\":\"The board's subscribers.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"User\",\"ofType\":null}}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"tags\",\"description\":\"The board's specific tags.\",\"args\":[],\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"Tag\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"updates\",\"description\":\"The board's updates.\",\"args\":[{\"name\":\"limit\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"page\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null}],\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"Update\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"workspace\",\"description\":\"The workspace that contains this board.\",\"args\":[],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Workspace\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"workspace_id\",\"description\":\"The board's workspace unique identifier.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"inputFields\":null,\"interfaces\":[],\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"OBJECT\",\"name\":\"Column\",\"description\":\"A column of a board.\",\"fields\":[{\"name\":\"archived\",\"description\":\"Is the column archived or not.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Boolean\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"description\",\"description\":\"The column's description.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"id\",\"description\":\"The column's unique identifier.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"ID\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"settings_str\",\"description\":\"The column's settings in a string form.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"title\",\"description\":\"The column's title.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"type\",\"description\":\"The column's type.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"width\",\"description\":\"The column's width.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"inputFields\":null,\"interfaces\":[],\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"OBJECT\",\"name\":\"ColumnValue\",\"description\":\"The value of an item's column.\",\"fields\":[{\"name\":\"additional_info\",\"description\":\"The column value's additional information.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"JSON\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"id\",\"description\":\"The column's unique identifier.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"ID\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"text\",\"description\":\"The column's textual value in string form.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"title\",\"description\":\"The column's title.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"type\",\"description\":\"The column's type.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"value\",\"description\":\"The column's raw value in JSON format.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"JSON\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"inputFields\":null,\"interfaces\":[],\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"OBJECT\",\"name\":\"Complexity\",\"description\":\"The complexity data of the query.\",\"fields\":[{\"name\":\"after\",\"description\":\"The remainder of complexity after the query's execution.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"before\",\"description\":\"The remainder of complexity before the query's execution.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"query\",\"description\":\"The specific query's complexity.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"inputFields\":null,\"interfaces\":[],\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"OBJECT\",\"name\":\"Document\",\"description\":\"A monday.com doc.\",\"fields\":[{\"name\":\"blocks\",\"description\":\"The document's content blocks.\",\"args\":[{\"name\":\"limit\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"page\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null}],\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"DocumentBlock\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"created_at\",\"description\":\"The document's creation date.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"ISO8601DateTime\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"created_by\",\"description\":\"The document's creator.\",\"args\":[],\"type\":{\"kind\":\"OBJECT\",\"name\":\"User\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"doc_kind\",\"description\":\"The document's kind (public / private / share).\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"ENUM\",\"name\":\"BoardKind\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"id\",\"description\":\"The document's unique identifier.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"ID\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"name\",\"description\":\"The document's name.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"object_id\",\"description\":\"The associated board or object's unique identifier.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"ID\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"url\",\"description\":\"The document's url.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"workspace_id\",\"description\":\"The workspace's unique identifier.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"inputFields\":null,\"interfaces\":[],\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"OBJECT\",\"name\":\"DocumentBlock\",\"description\":\"A content block of a doc.\",\"fields\":[{\"name\":\"content\",\"description\":\"The block's content.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"JSON\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"created_at\",\"description\":\"The block's creation date.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"ISO8601DateTime\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"id\",\"description\":\"The block's unique identifier.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"parent_block_id\",\"description\":\"The block's parent block unique identifier.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"type\",\"description\":\"The block's content type.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"inputFields\":null,\"interfaces\":[],\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"OBJECT\",\"name\":\"Group\",\"description\":\"A group of items in a board.\",\"fields\":[{\"name\":\"archived\",\"description\":\"Is the group archived or not.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"Boolean\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"color\",\"description\":\"The group's color.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"deleted\",\"description\":\"Is the group deleted or not.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"Boolean\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"id\",\"description\":\"The group's unique identifier.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"ID\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"items\",\"description\":\"The items in the group.\",\"args\":[{\"name\":\"ids\",\"description\":\"\",\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"limit\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"page\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"newest_first\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Boolean\",\"ofType\":null},\"defaultValue\":null}],\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"Item\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"position\",\"description\":\"The group's position in the board.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"title\",\"description\":\"The group's title.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"inputFields\":null,\"interfaces\":[],\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"OBJECT\",\"name\":\"Item\",\"description\":\"An item (row) of a board.\",\"fields\":[{\"name\":\"board\",\"description\":\"The board that contains this item.\",\"args\":[],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Board\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"column_values\",\"description\":\"The item's column values.\",\"args\":[{\"name\":\"ids\",\"description\":\"\",\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"defaultValue\":null}],\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"ColumnValue\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"created_at\",\"description\":\"The item's creation date.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"ISO8601DateTime\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"creator\",\"description\":\"The item's creator.\",\"args\":[],\"type\":{\"kind\":\"OBJECT\",\"name\":\"User\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"creator_id\",\"description\":\"The unique identifier of the item creator.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"group\",\"description\":\"The group that contains this item.\",\"args\":[],\"type\":{\"kind\":\"OBJECT\",\"name\":\"Group\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"id\",\"description\":\"The item's unique identifier.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"ID\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"name\",\"description\":\"The item's name.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"state\",\"description\":\"The board's state (all / active / archived / deleted).\",\"args\":[],\"type\":{\"kind\":\"ENUM\",\"name\":\"State\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"subitems\",\"description\":\"The item's subitems.\",\"args\":[],\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"Item\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"subscribers\",\"description\":\"The pulses's subscribers.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"User\",\"ofType\":null}}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"updated_at\",\"description\":\"The item's last update date.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"ISO8601DateTime\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"updates\",\"description\":\"The item's updates.\",\"args\":[{\"name\":\"limit\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"page\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null}],\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"Update\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"inputFields\":null,\"interfaces\":[],\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"OBJECT\",\"name\":\"Notification\",\"description\":\"A notification.\",\"fields\":[{\"name\":\"id\",\"description\":\"The notification's unique identifier.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"ID\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"text\",\"description\":\"The notification text.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"inputFields\":null,\"interfaces\":[],\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"OBJECT\",\"name\":\"Reply\",\"description\":\"A reply for an update.\",\"fields\":[{\"name\":\"body\",\"description\":\"The reply's html formatted body.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"created_at\",\"description\":\"The reply's creation date.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"ISO8601DateTime\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"creator\",\"description\":\"The reply's creator.\",\"args\":[],\"type\":{\"kind\":\"OBJECT\",\"name\":\"User\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"creator_id\",\"description\":\"The unique identifier of the reply creator.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"id\",\"description\":\"The reply's unique identifier.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"ID\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"text_body\",\"description\":\"The reply's text body.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"updated_at\",\"description\":\"The reply's last edit date.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"ISO8601DateTime\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"inputFields\":null,\"interfaces\":[],\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"OBJECT\",\"name\":\"Tag\",\"description\":\"A tag.\",\"fields\":[{\"name\":\"color\",\"description\":\"The tag's color.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"id\",\"description\":\"The tag's unique identifier.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"name\",\"description\":\"The tag's name.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"inputFields\":null,\"interfaces\":[],\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"OBJECT\",\"name\":\"Team\",\"description\":\"A team of users.\",\"fields\":[{\"name\":\"id\",\"description\":\"The team's unique identifier.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"name\",\"description\":\"The team's name.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"picture_url\",\"description\":\"The team's picture url.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"users\",\"description\":\"The users in the team.\",\"args\":[{\"name\":\"ids\",\"description\":\"\",\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"kind\",\"description\":\"\",\"type\":{\"kind\":\"ENUM\",\"name\":\"UserKind\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"newest_first\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Boolean\",\"ofType\":null},\"defaultValue\":null},{\"name\":\"limit\",\"description\":\"\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"defaultValue\":null}],\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"User\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"inputFields\":null,\"interfaces\":[],\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"OBJECT\",\"name\":\"Update\",\"description\":\"An update.\",\"fields\":[{\"name\":\"body\",\"description\":\"The update's html formatted body.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"created_at\",\"description\":\"The update's creation date.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"ISO8601DateTime\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"creator\",\"description\":\"The update's creator.\",\"args\":[],\"type\":{\"kind\":\"OBJECT\",\"name\":\"User\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"creator_id\",\"description\":\"The unique identifier of the update creator.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"id\",\"description\":\"The update's unique identifier.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"ID\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"item_id\",\"description\":\"The update's item ID.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"replies\",\"description\":\"The update's replies.\",\"args\":[],\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"Reply\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"text_body\",\"description\":\"The update's text body.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"updated_at\",\"description\":\"The update's last edit date.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"ISO8601DateTime\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"inputFields\":null,\"interfaces\":[],\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"OBJECT\",\"name\":\"User\",\"description\":\"A monday.com user.\",\"fields\":[{\"name\":\"account\",\"description\":\"The user's account.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"Account\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"birthday\",\"description\":\"The user's birthday.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"Date\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"country_code\",\"description\":\"The user's country code.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"created_at\",\"description\":\"The user's creation date.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"Date\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"email\",\"description\":\"The user's email.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"enabled\",\"description\":\"Is the user enabled or not.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Boolean\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"id\",\"description\":\"The user's unique identifier.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"is_admin\",\"description\":\"Is the user an account admin.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"Boolean\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"is_guest\",\"description\":\"Is the user a guest or not.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"Boolean\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"is_pending\",\"description\":\"Is the user a pending user.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"Boolean\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"join_date\",\"description\":\"The user's join date.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"Date\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"location\",\"description\":\"The user's location.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"mobile_phone\",\"description\":\"The user's mobile phone number.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"name\",\"description\":\"The user's name.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"phone\",\"description\":\"The user's phone number.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"photo_original\",\"description\":\"The user's photo in the original size.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"photo_small\",\"description\":\"The user's photo in small size (150x150).\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"photo_thumb\",\"description\":\"The user's photo in thumbnail size (100x100).\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"photo_thumb_small\",\"description\":\"The user's photo in small thumbnail size (50x50).\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"photo_tiny\",\"description\":\"The user's photo in tiny size (30x30).\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"teams\",\"description\":\"The teams the user is a member in.\",\"args\":[{\"name\":\"ids\",\"description\":\"\",\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null}},\"defaultValue\":null}],\"type\":{\"kind\":\"LIST\",\"name\":\"\",\"ofType\":{\"kind\":\"OBJECT\",\"name\":\"Team\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"time_zone_identifier\",\"description\":\"The user's timezone identifier.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"title\",\"description\":\"The user's title.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"url\",\"description\":\"The user's profile url.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"utc_hours_diff\",\"description\":\"The user's UTC hours difference.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"inputFields\":null,\"interfaces\":[],\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"OBJECT\",\"name\":\"Webhook\",\"description\":\"Monday webhooks.\",\"fields\":[{\"name\":\"board_id\",\"description\":\"The webhook's board id.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"ID\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"config\",\"description\":\"The webhooks's config.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"event\",\"description\":\"The event webhook will listen to.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"id\",\"description\":\"The webhook's unique identifier.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"ID\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"inputFields\":null,\"interfaces\":[],\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"OBJECT\",\"name\":\"Workspace\",\"description\":\"A workspace.\",\"fields\":[{\"name\":\"created_at\",\"description\":\"The workspace's creation date.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"ISO8601DateTime\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"description\",\"description\":\"The workspace's description.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"id\",\"description\":\"The workspace's unique identifier.\",\"args\":[],\"type\":{\"kind\":\"SCALAR\",\"name\":\"Int\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"kind\",\"description\":\"The workspace's kind (open / closed).\",\"args\":[],\"type\":{\"kind\":\"ENUM\",\"name\":\"WorkspaceKind\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"name\",\"description\":\"The workspace's name.\",\"args\":[],\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"String\",\"ofType\":null}},\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"state\",\"description\":\"The workspace's state (all / active / archived / deleted).\",\"args\":[],\"type\":{\"kind\":\"ENUM\",\"name\":\"State\",\"ofType\":null},\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"inputFields\":null,\"interfaces\":[],\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"INPUT_OBJECT\",\"name\":\"ColumnMappingInput\",\"description\":\"The mapping of a column of the source board to a column of the target board.\",\"fields\":null,\"inputFields\":[{\"name\":\"source\",\"description\":\"The unique identifier of the column on the source board.\",\"type\":{\"kind\":\"NON_NULL\",\"name\":\"\",\"ofType\":{\"kind\":\"SCALAR\",\"name\":\"ID\",\"ofType\":null}},\"defaultValue\":null},{\"name\":\"target\",\"description\":\"The unique identifier of the column on the target board, its values are dropped if empty.\",\"type\":{\"kind\":\"SCALAR\",\"name\":\"ID\",\"ofType\":null},\"defaultValue\":null}],\"interfaces\":null,\"enumValues\":null,\"possibleTypes\":null},{\"kind\":\"ENUM\",\"name\":\"BoardAttributes\",\"description\":\"The board attributes available.\",\"fields\":null,\"inputFields\":null,\"interfaces\":null,\"enumValues\":[{\"name\":\"communication\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"description\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"name\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"possibleTypes\":null},{\"kind\":\"ENUM\",\"name\":\"BoardKind\",\"description\":\"The board access level.\",\"fields\":null,\"inputFields\":null,\"interfaces\":null,\"enumValues\":[{\"name\":\"private\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"public\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"share\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"possibleTypes\":null},{\"kind\":\"ENUM\",\"name\":\"ColumnProperty\",\"description\":\"The columns properties available.\",\"fields\":null,\"inputFields\":null,\"interfaces\":null,\"enumValues\":[{\"name\":\"description\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"title\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"possibleTypes\":null},{\"kind\":\"ENUM\",\"name\":\"ColumnType\",\"description\":\"The columns types available.\",\"fields\":null,\"inputFields\":null,\"interfaces\":null,\"enumValues\":[{\"name\":\"auto_number\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"checkbox\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"color_picker\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"country\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"creation_log\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"date\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"dropdown\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"email\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"hour\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"item_id\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"last_updated\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"link\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"location\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"long_text\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"numbers\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"people\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"phone\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"progress\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"rating\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"status\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"tags\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"team\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"text\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"time_tracking\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"timeline\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"vote\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"week\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"world_clock\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"possibleTypes\":null},{\"kind\":\"ENUM\",\"name\":\"FirstDayOfTheWeek\",\"description\":\"The first day of work week.\",\"fields\":null,\"inputFields\":null,\"interfaces\":null,\"enumValues\":[{\"name\":\"monday\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"sunday\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"possibleTypes\":null},{\"kind\":\"ENUM\",\"name\":\"GroupAttributes\",\"description\":\"The group attributes available.\",\"fields\":null,\"inputFields\":null,\"interfaces\":null,\"enumValues\":[{\"name\":\"color\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"position\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"relative_position_after\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"relative_position_before\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"title\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"possibleTypes\":null},{\"kind\":\"ENUM\",\"name\":\"NotificationTargetType\",\"description\":\"The notification's target type.\",\"fields\":null,\"inputFields\":null,\"interfaces\":null,\"enumValues\":[{\"name\":\"post\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"project\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"possibleTypes\":null},{\"kind\":\"ENUM\",\"name\":\"State\",\"description\":\"The state of a board, item, group or workspace.\",\"fields\":null,\"inputFields\":null,\"interfaces\":null,\"enumValues\":[{\"name\":\"active\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"all\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"archived\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"deleted\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"possibleTypes\":null},{\"kind\":\"ENUM\",\"name\":\"SubscriberKind\",\"description\":\"The subscriber's kind (owner / subscriber).\",\"fields\":null,\"inputFields\":null,\"interfaces\":null,\"enumValues\":[{\"name\":\"owner\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"subscriber\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"possibleTypes\":null},{\"kind\":\"ENUM\",\"name\":\"UserKind\",\"description\":\"The kind of users to return.\",\"fields\":null,\"inputFields\":null,\"interfaces\":null,\"enumValues\":[{\"name\":\"all\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"guests\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"non_guests\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"non_pending\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"possibleTypes\":null},{\"kind\":\"ENUM\",\"name\":\"WebhookEventType\",\"description\":\"The webhook's target type.\",\"fields\":null,\"inputFields\":null,\"interfaces\":null,\"enumValues\":[{\"name\":\"change_column_value\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"change_name\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"change_specific_column_value\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"change_status_column_value\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"change_subitem_column_value\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"change_subitem_name\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"create_column\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"create_item\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"create_subitem\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"create_subitem_update\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"create_update\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"item_archived\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"item_deleted\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"item_moved_to_any_group\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"item_restored\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"move_item_to_group\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"subitem_archived\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"subitem_deleted\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"possibleTypes\":null},{\"kind\":\"ENUM\",\"name\":\"WorkspaceKind\",\"description\":\"The workspace's kind.\",\"fields\":null,\"inputFields\":null,\"interfaces\":null,\"enumValues\":[{\"name\":\"closed\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"},{\"name\":\"open\",\"description\":\"\",\"isDeprecated\":false,\"deprecationReason\":\"\"}],\"possibleTypes\":null}]}}"
+
+var (
+	schemaOnce sync.Once
+	schema     *introspection.Schema
+)
+
+// Schema returns the schema of the api the package is generated from, e.g. to validate payloads:
+//
+//	err := payload.Validate(api.Schema())
+func Schema() *introspection.Schema {
+	schemaOnce.Do(func() {
+		var err error
+		if schema, err = introspection.Parse(strings.NewReader(schemaJSON)); err != nil {
+			panic(err)
+		}
+	})
+	return schema
+}
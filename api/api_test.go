@@ -0,0 +1,182 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/di-wu/monday"
+	"github.com/di-wu/monday/mondaytest"
+	"github.com/di-wu/monday/pdq"
+)
+
+func TestGenerated(t *testing.T) {
+	s := mondaytest.NewServer()
+	defer s.Close()
+	c := pdq.NewSimpleClientWithHTTPClient("mondaytest", s.Client())
+	ctx := context.Background()
+
+	var created struct {
+		Board Board `json:"create_board"`
+	}
+	if err := c.Do(ctx, monday.NewMutationPayload(
+		NewCreateBoardMutation("api", BoardKindPublic(), []BoardField{BoardIDField()}),
+	), &created); err != nil {
+		t.Fatal(err)
+	}
+	var item struct {
+		Item Item `json:"create_item"`
+	}
+	if err := c.Do(ctx, monday.NewMutationPayload(
+		NewCreateItemMutation(mustAtoi(t, created.Board.ID), []ItemField{ItemIDField()},
+			NewMutationCreateItemItemNameArgument("first"),
+		),
+	), &item); err != nil {
+		t.Fatal(err)
+	}
+
+	var data struct {
+		Boards []Board
+	}
+	if err := c.Do(ctx, monday.NewQueryPayload(
+		NewBoardsQuery(
+			[]BoardField{
+				BoardNameField(),
+				BoardBoardKindField(),
+				NewBoardItemsField([]ItemField{ItemIDField(), ItemNameField()}, NewBoardItemsLimitArgument(10)),
+				NewBoardGroupsField([]GroupField{GroupTitleField()}),
+			},
+			NewQueryBoardsIDsArgument([]int{mustAtoi(t, created.Board.ID)}),
+		),
+	), &data); err != nil {
+		t.Fatal(err)
+	}
+	if len(data.Boards) != 1 {
+		t.Fatalf("got %d boards, expected 1", len(data.Boards))
+	}
+	b := data.Boards[0]
+	if b.Name != "api" || b.BoardKind != BoardKindPublic() {
+		t.Errorf("unexpected board %+v", b)
+	}
+	if len(b.Items) != 1 || b.Items[0].ID != item.Item.ID || b.Items[0].Name != "first" {
+		t.Errorf("unexpected items %+v", b.Items)
+	}
+	if len(b.Groups) != 1 || b.Groups[0].Title == "" {
+		t.Errorf("unexpected groups %+v", b.Groups)
+	}
+}
+
+func TestClient(t *testing.T) {
+	s := mondaytest.NewServer()
+	defer s.Close()
+	c := NewClient(s.NewClient())
+	ctx := context.Background()
+
+	board, err := c.CreateBoard("fluent", BoardKindPublic()).Do(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if board.ID == "" || board.Name != "fluent" || board.BoardKind != BoardKindPublic() {
+		t.Errorf("unexpected board %+v", board)
+	}
+	id := mustAtoi(t, board.ID)
+	if _, err := c.CreateItem(id).ItemName("first").Select(ItemIDField()).Do(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	boards, err := c.Boards().IDs(id).Limit(1).Limit(5).Select(
+		BoardNameField(),
+		NewBoardItemsField([]ItemField{ItemNameField()}),
+	).Do(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(boards) != 1 || boards[0].Name != "fluent" || boards[0].ID != "" {
+		t.Fatalf("unexpected boards %+v", boards)
+	}
+	if len(boards[0].Items) != 1 || boards[0].Items[0].Name != "first" {
+		t.Errorf("unexpected items %+v", boards[0].Items)
+	}
+
+	// the requests can be added to the payloads of the monday package.
+	payload := monday.NewQueryPayload(c.Boards().IDs(id).Query(), c.Me().Select(UserIDField()).Query())
+	if err := payload.Validate(Schema()); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestFragments(t *testing.T) {
+	s := mondaytest.NewServer()
+	defer s.Close()
+	c := NewClient(s.NewClient())
+	ctx := context.Background()
+
+	board, err := c.CreateBoard("fragments", BoardKindPublic()).Do(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := mustAtoi(t, board.ID)
+	if _, err := c.CreateItem(id).ItemName("first").Select(ItemIDField()).Do(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	item := NewItemFragment("item", []ItemField{ItemIDField(), ItemNameField()})
+	boards, err := c.Boards().IDs(id).Select(
+		NewBoardItemsField([]ItemField{ItemFragmentField(item)}),
+		NewBoardGroupsField([]GroupField{NewGroupItemsField([]ItemField{ItemFragmentField(item)})}),
+	).Do(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(boards) != 1 || len(boards[0].Items) != 1 || boards[0].Items[0].Name != "first" {
+		t.Fatalf("unexpected boards %+v", boards)
+	}
+	if groups := boards[0].Groups; len(groups) != 1 || len(groups[0].Items) != 1 || groups[0].Items[0].ID != boards[0].Items[0].ID {
+		t.Errorf("unexpected groups %+v", groups)
+	}
+}
+
+func TestSchema(t *testing.T) {
+	payload := monday.NewQueryPayload(NewWorkspacesQuery(
+		[]WorkspaceField{WorkspaceIDField(), WorkspaceNameField()},
+		NewQueryWorkspacesKindArgument(WorkspaceKindOpen()),
+	))
+	if err := payload.Validate(Schema()); err != nil {
+		t.Error(err)
+	}
+	payload = monday.NewMutationPayload(NewUpdateBoardMutation(1, BoardAttributesName(), "name"))
+	if err := payload.Validate(Schema()); err != nil {
+		t.Error(err)
+	}
+	mapping := NewColumnMappingInput(1)
+	mapping.Target = 2
+	payload = monday.NewMutationPayload(NewMoveItemToBoardMutation(1, "topics", 10, []ItemField{ItemIDField()},
+		NewMutationMoveItemToBoardColumnsMappingArgument([]ColumnMappingInput{mapping, NewColumnMappingInput(3)}),
+	))
+	if err := payload.Validate(Schema()); err != nil {
+		t.Error(err)
+	}
+	// input objects are encoded with the names of the json tags of their fields.
+	var documents []string
+	c := monday.NewClient("token", nil)
+	c.Use(func(ctx context.Context, call *monday.Call, next monday.Handler) (*http.Response, error) {
+		documents = call.Documents
+		return nil, errors.New("not sent")
+	})
+	_, _ = c.Exec(context.Background(), payload)
+	if len(documents) != 1 || !strings.Contains(documents[0], `columns_mapping:[{source:1,target:2},{source:3}]`) {
+		t.Errorf("unexpected documents %v", documents)
+	}
+}
+
+func mustAtoi(t *testing.T, id string) int {
+	t.Helper()
+	n, err := strconv.Atoi(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
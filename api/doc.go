@@ -0,0 +1,27 @@
+// Package api contains the field selectors, argument constructors, enums and result structs of the monday api,
+// generated from the introspection result in schema.json.
+//
+// It complements the hand-written services of the monday package and covers parts of the api they do not,
+// like workspaces and docs. To catch up with changes of the api, replace schema.json with the result of
+// the introspection query (see the introspection package) and run go generate.
+//
+//	payload := monday.NewQueryPayload(api.NewWorkspacesQuery(
+//		[]api.WorkspaceField{api.WorkspaceIDField(), api.WorkspaceNameField()},
+//		api.NewQueryWorkspacesKindArgument(api.WorkspaceKindOpen()),
+//	))
+//
+// The results decode into the generated structs:
+//
+//	var data struct {
+//		Workspaces []api.Workspace
+//	}
+//
+// The Client binds the queries and mutations to a client as fluent requests that return the decoded results:
+//
+//	boards, err := api.NewClient(client).Boards().IDs(boardID).Select(
+//		api.BoardNameField(),
+//		api.NewBoardItemsField([]api.ItemField{api.ItemNameField()}),
+//	).Do(ctx)
+package api
+
+//go:generate go run github.com/di-wu/monday/cmd/monday-apigen -schema schema.json -package api -o api.go
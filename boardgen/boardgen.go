@@ -0,0 +1,434 @@
+// Package boardgen generates typed Go code for the items of a monday board.
+//
+// For a board it generates a struct with a field per column, tagged for the codec package, a string type with
+// constants for the labels of every status and dropdown column, and helpers to create, update and get items:
+//
+//	const TicketBoardID = 123
+//
+//	type TicketStatus string
+//
+//	const (
+//		TicketStatusWorkingOnIt TicketStatus = "Working on it"
+//		TicketStatusDone        TicketStatus = "Done"
+//	)
+//
+//	type Ticket struct {
+//		ID     int          `monday:"id"`
+//		Name   string       `monday:"name"`
+//		Status TicketStatus `monday:"status,label"`
+//	}
+//
+//	func CreateTicket(ctx context.Context, c *pdq.SimpleClient, groupID string, item Ticket) (int, error)
+//	func UpdateTicket(ctx context.Context, c *pdq.SimpleClient, item Ticket) error
+//	func GetTicket(ctx context.Context, c *pdq.SimpleClient, id int) (Ticket, error)
+//
+// The schema of the board is either fetched from the api or read from the json output of pdq's GetColumns.
+package boardgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/di-wu/monday/pdq"
+)
+
+// Board is the schema of a board.
+type Board struct {
+	ID      int
+	Name    string
+	Columns []pdq.Column
+}
+
+// Fetch returns the schema of the board with the given identifier.
+func Fetch(c *pdq.SimpleClient, boardID int) (Board, error) {
+	board, err := c.GetBoardWithID(boardID)
+	if err != nil {
+		return Board{}, err
+	}
+	columns, err := c.GetColumns(boardID)
+	if err != nil {
+		return Board{}, err
+	}
+	return Board{ID: boardID, Name: board.Name, Columns: columns}, nil
+}
+
+// ReadColumns decodes the columns of a board, as encoded by marshaling the result of GetColumns.
+// The object {"columns": [...]} is accepted as well.
+func ReadColumns(r io.Reader) ([]pdq.Column, error) {
+	raw, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var columns []pdq.Column
+	if trimmed := bytes.TrimSpace(raw); len(trimmed) != 0 && trimmed[0] == '{' {
+		var board struct {
+			Columns []pdq.Column
+		}
+		err = json.Unmarshal(raw, &board)
+		columns = board.Columns
+	} else {
+		err = json.Unmarshal(raw, &columns)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid columns: %v", err)
+	}
+	return columns, nil
+}
+
+// Options configure the generated code.
+type Options struct {
+	// Package is the name of the package of the generated file.
+	Package string
+	// Type is the name of the generated struct, it defaults to the name of the board.
+	Type string
+}
+
+// Generate writes the gofmt-ed source of the board to w.
+func Generate(w io.Writer, board Board, opts Options) error {
+	if opts.Package == "" {
+		return fmt.Errorf("no package name")
+	}
+	typeName := opts.Type
+	if typeName == "" {
+		typeName = identifier(board.Name)
+	}
+	if typeName == "" || !isIdentifier(typeName) {
+		return fmt.Errorf("invalid type name %q, use the Type option", typeName)
+	}
+	file, err := newFile(board, opts.Package, typeName)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := fileTemplate.Execute(&buf, file); err != nil {
+		return err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("invalid generated code: %v", err)
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+type file struct {
+	Package string
+	Type    string
+	Board   Board
+	Imports []string
+	Fields  []structField
+	Labels  []labelType
+}
+
+type structField struct {
+	Name, Type, Tag, Comment string
+}
+
+type labelType struct {
+	Name, Column string
+	Labels       []label
+}
+
+type label struct {
+	Name, Value string
+}
+
+// kind describes how a column type maps onto a field.
+type kind struct {
+	typ, option string
+	labels      bool
+}
+
+// kinds maps column types onto fields, both the names used to create columns and the names returned by the api.
+var kinds = map[string]kind{
+	"text":            {typ: "string"},
+	"phone":           {typ: "string"},
+	"long_text":       {typ: "string", option: "long_text"},
+	"long-text":       {typ: "string", option: "long_text"},
+	"email":           {typ: "string", option: "email"},
+	"link":            {typ: "string", option: "link"},
+	"world_clock":     {typ: "string", option: "world_clock"},
+	"timezone":        {typ: "string", option: "world_clock"},
+	"numbers":         {typ: "float64"},
+	"numeric":         {typ: "float64"},
+	"status":          {option: "label", labels: true},
+	"color":           {option: "label", labels: true},
+	"dropdown":        {typ: "[]", labels: true},
+	"date":            {typ: "time.Time"},
+	"hour":            {typ: "time.Time", option: "hour"},
+	"checkbox":        {typ: "bool"},
+	"boolean":         {typ: "bool"},
+	"rating":          {typ: "int", option: "rating"},
+	"team":            {typ: "int", option: "team"},
+	"people":          {typ: "[]int", option: "people"},
+	"multiple-person": {typ: "[]int", option: "people"},
+	"tags":            {typ: "[]int"},
+	"tag":             {typ: "[]int"},
+}
+
+func newFile(board Board, pkg, typeName string) (file, error) {
+	f := file{
+		Package: pkg,
+		Type:    typeName,
+		Board:   board,
+		Imports: []string{"context", "encoding/json", "fmt", "strconv"},
+		Fields: []structField{
+			{Name: "ID", Type: "int", Tag: "id"},
+			{Name: "Name", Type: "string", Tag: "name"},
+		},
+	}
+	names := map[string]bool{"ID": true, "Name": true}
+	types := map[string]bool{typeName: true}
+	for _, c := range board.Columns {
+		if c.Id == "name" {
+			continue
+		}
+		name := unique(names, identifier(c.Title), "Column"+identifier(c.Id))
+		k, ok := kinds[c.Type]
+		if !ok {
+			k = kind{typ: "string", option: "readonly"}
+		}
+		field := structField{
+			Name:    name,
+			Type:    k.typ,
+			Tag:     c.Id,
+			Comment: fmt.Sprintf("%s is the %q column (%s).", name, c.Title, c.Type),
+		}
+		if k.option != "" {
+			field.Tag += "," + k.option
+		}
+		if k.labels {
+			labels, err := columnLabels(c)
+			if err != nil {
+				return file{}, fmt.Errorf("column %s: %v", c.Id, err)
+			}
+			lt := labelType{Name: unique(types, typeName+name, typeName+name+"Label"), Column: c.Title}
+			consts := make(map[string]bool)
+			for _, l := range labels {
+				if l == "" {
+					continue
+				}
+				lt.Labels = append(lt.Labels, label{Name: unique(consts, lt.Name+identifier(l), lt.Name+"Label"), Value: l})
+			}
+			f.Labels = append(f.Labels, lt)
+			field.Type += lt.Name
+		}
+		if field.Type == "time.Time" {
+			f.Imports = appendImport(f.Imports, "time")
+		}
+		f.Fields = append(f.Fields, field)
+	}
+	sort.Strings(f.Imports)
+	return f, nil
+}
+
+func appendImport(imports []string, path string) []string {
+	for _, i := range imports {
+		if i == path {
+			return imports
+		}
+	}
+	return append(imports, path)
+}
+
+// columnLabels returns the labels of a status or dropdown column, ordered by their index.
+func columnLabels(c pdq.Column) ([]string, error) {
+	if c.SettingsStr == "" {
+		return nil, nil
+	}
+	var settings struct {
+		Labels json.RawMessage
+	}
+	if err := json.Unmarshal([]byte(c.SettingsStr), &settings); err != nil {
+		return nil, err
+	}
+	if len(settings.Labels) == 0 {
+		return nil, nil
+	}
+	type indexed struct {
+		index int
+		name  string
+	}
+	var all []indexed
+	if settings.Labels[0] == '[' {
+		var labels []struct {
+			ID   int
+			Name string
+		}
+		if err := json.Unmarshal(settings.Labels, &labels); err != nil {
+			return nil, err
+		}
+		for _, l := range labels {
+			all = append(all, indexed{l.ID, l.Name})
+		}
+	} else {
+		var labels map[string]string
+		if err := json.Unmarshal(settings.Labels, &labels); err != nil {
+			return nil, err
+		}
+		for k, v := range labels {
+			i, err := strconv.Atoi(k)
+			if err != nil {
+				return nil, err
+			}
+			all = append(all, indexed{i, v})
+		}
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].index < all[j].index })
+	labels := make([]string, len(all))
+	for i, l := range all {
+		labels[i] = l.name
+	}
+	return labels, nil
+}
+
+// identifier returns an exported Go identifier for the text, or an empty string if it has no letters or digits.
+func identifier(s string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	id := b.String()
+	if id != "" && !unicode.IsLetter([]rune(id)[0]) {
+		id = "X" + id
+	}
+	return id
+}
+
+func isIdentifier(s string) bool {
+	for i, r := range s {
+		if !unicode.IsLetter(r) && r != '_' && (i == 0 || !unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// unique returns the name, or the fallback if the name is empty, with a numeric suffix if it is already taken.
+func unique(taken map[string]bool, name, fallback string) string {
+	if name == "" {
+		name = fallback
+	}
+	candidate := name
+	for n := 2; taken[candidate]; n++ {
+		candidate = name + strconv.Itoa(n)
+	}
+	taken[candidate] = true
+	return candidate
+}
+
+var fileTemplate = template.Must(template.New("file").Parse(`// Code generated by monday-boardgen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{- range .Imports}}
+	"{{.}}"
+{{- end}}
+
+	"github.com/di-wu/monday"
+	"github.com/di-wu/monday/codec"
+	"github.com/di-wu/monday/pdq"
+)
+
+// {{.Type}}BoardID is the identifier of the {{printf "%q" .Board.Name}} board.
+const {{.Type}}BoardID = {{.Board.ID}}
+{{range $t := .Labels}}
+// {{$t.Name}} is a label of the {{printf "%q" $t.Column}} column.
+type {{$t.Name}} string
+{{if $t.Labels}}
+// Labels of the {{printf "%q" $t.Column}} column.
+const (
+{{- range $t.Labels}}
+	{{.Name}} {{$t.Name}} = {{printf "%q" .Value}}
+{{- end}}
+)
+{{end}}
+{{- end}}
+// {{.Type}} is an item of the {{printf "%q" .Board.Name}} board.
+type {{.Type}} struct {
+{{- range .Fields}}
+{{- if .Comment}}
+	// {{.Comment}}
+{{- end}}
+	{{.Name}} {{.Type}} ` + "`" + `monday:"{{.Tag}}"` + "`" + `
+{{- end}}
+}
+
+// Create{{.Type}} creates the item in the given group and returns its identifier.
+func Create{{.Type}}(ctx context.Context, c *pdq.SimpleClient, groupID string, item {{.Type}}) (int, error) {
+	name, values, err := codec.MarshalItem(item)
+	if err != nil {
+		return 0, err
+	}
+	var data struct {
+		Item struct {
+			ID string
+		} ` + "`" + `json:"create_item"` + "`" + `
+	}
+	if err := c.Do(ctx, monday.NewMutationPayload(
+		monday.Items.Create({{.Type}}BoardID, groupID, name, values, nil),
+	), &data); err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(data.Item.ID)
+}
+
+// Update{{.Type}} overwrites the name and the column values of the item with the identifier of the given item.
+func Update{{.Type}}(ctx context.Context, c *pdq.SimpleClient, item {{.Type}}) error {
+	values, err := codec.MarshalValues(item)
+	if err != nil {
+		return err
+	}
+	return c.Do(ctx, monday.NewMutationPayload(
+		monday.Columns.ChangeMultipleValues(item.ID, {{.Type}}BoardID, values, []monday.ItemsField{monday.ItemsIDField()}),
+	), nil)
+}
+
+// Get{{.Type}} returns the item with the given identifier.
+func Get{{.Type}}(ctx context.Context, c *pdq.SimpleClient, id int) ({{.Type}}, error) {
+	var data struct {
+		Items []json.RawMessage
+	}
+	if err := c.Do(ctx, monday.NewQueryPayload(
+		monday.Items.List(
+			[]monday.ItemsField{
+				monday.ItemsIDField(),
+				monday.ItemsNameField(),
+				monday.NewItemsColumnValuesField([]monday.ColumnValuesField{
+					monday.ColumnValuesIDField(),
+					monday.ColumnValuesTextField(),
+					monday.ColumnValuesValueField(),
+				}, nil),
+			},
+			monday.NewItemsIDsArgument([]int{id}),
+		),
+	), &data); err != nil {
+		return {{.Type}}{}, err
+	}
+	if len(data.Items) == 0 {
+		return {{.Type}}{}, fmt.Errorf("no item returned for id %d", id)
+	}
+	var item {{.Type}}
+	err := codec.Unmarshal(data.Items[0], &item)
+	return item, err
+}
+`))
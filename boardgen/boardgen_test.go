@@ -0,0 +1,90 @@
+package boardgen
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/di-wu/monday"
+	"github.com/di-wu/monday/mondaytest"
+	"github.com/di-wu/monday/pdq"
+)
+
+func TestGenerate(t *testing.T) {
+	s := mondaytest.NewServer()
+	defer s.Close()
+	c := pdq.NewSimpleClientWithHTTPClient("mondaytest", s.Client())
+
+	b, err := c.CreateBoard("Support tickets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.CreateStatusColumn(b.ID(), "Status", []string{"Working on it", "Done", "Done!"}); err != nil {
+		t.Fatal(err)
+	}
+	for title, typ := range map[string]monday.ColumnsType{
+		"Due date":  monday.ColumnsTypeDate(),
+		"Owner":     monday.ColumnsTypePeople(),
+		"Estimate":  monday.ColumnsTypeNumbers(),
+		"Reference": monday.ColumnsTypeItemID(),
+	} {
+		if _, err := c.CreateColumn(b.ID(), title, typ); err != nil {
+			t.Fatal(err)
+		}
+	}
+	board, err := Fetch(c, b.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := Generate(&buf, board, Options{Package: "tickets", Type: "Ticket"}); err != nil {
+		t.Fatal(err)
+	}
+	src := buf.String()
+	for _, want := range []string{
+		"package tickets",
+		"const TicketBoardID = " + b.Id,
+		"type TicketStatus string",
+		`TicketStatusWorkingOnIt TicketStatus = "Working on it"`,
+		`TicketStatusDone2       TicketStatus = "Done!"`,
+		"Status TicketStatus `monday:\"status,label\"`",
+		"DueDate time.Time `monday:\"date\"`",
+		"Owner []int `monday:\"people,people\"`",
+		"Estimate float64 `monday:\"numbers\"`",
+		"Reference string `monday:\"item_id,readonly\"`",
+		"func CreateTicket(ctx context.Context, c *pdq.SimpleClient, groupID string, item Ticket) (int, error)",
+		"func UpdateTicket(ctx context.Context, c *pdq.SimpleClient, item Ticket) error",
+		"func GetTicket(ctx context.Context, c *pdq.SimpleClient, id int) (Ticket, error)",
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, src)
+		}
+	}
+}
+
+func TestReadColumns(t *testing.T) {
+	columns, err := ReadColumns(strings.NewReader(`{"columns": [
+		{"id": "dropdown", "title": "Tags", "type": "dropdown", "settings_str": "{\"labels\":[{\"id\":2,\"name\":\"b\"},{\"id\":1,\"name\":\"a\"}]}"}
+	]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := Generate(&buf, Board{Name: "my board", Columns: columns}, Options{Package: "board"}); err != nil {
+		t.Fatal(err)
+	}
+	src := buf.String()
+	for _, want := range []string{
+		"type MyBoard struct",
+		"Tags []MyBoardTags `monday:\"dropdown\"`",
+		"MyBoardTagsA MyBoardTags = \"a\"\n\tMyBoardTagsB MyBoardTags = \"b\"",
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, src)
+		}
+	}
+	if err := Generate(&buf, Board{Name: "!!!"}, Options{Package: "board"}); err == nil {
+		t.Error("expected an error for a board name without letters or digits")
+	}
+}
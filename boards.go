@@ -66,6 +66,49 @@ func (*BoardsService) Archive(id int, boardsFields []BoardsField) Mutation {
 	}
 }
 
+// AddSubscribers returns a mutation that allows you to add users as subscribers to a board.
+// - id: the board's unique identifier.
+// - userIDs: the unique identifiers of the users to subscribe.
+//
+// DOCS: https://monday.com/developers/v2#mutations-section-boards-add-subscribers
+func (*BoardsService) AddSubscribers(id int, userIDs []int, usersFields []UsersField) Mutation {
+	if len(usersFields) == 0 {
+		usersFields = append(usersFields, usersIDField)
+	}
+
+	var fields []field
+	for _, uf := range usersFields {
+		fields = append(fields, uf.field)
+	}
+	return Mutation{
+		name:   "add_subscribers_to_board",
+		fields: fields,
+		args: []argument{
+			{"board_id", id},
+			{"user_ids", userIDs},
+		},
+	}
+}
+
+// Update returns a mutation that allows you to update the name, description or communication of a board.
+// The mutation returns a JSON scalar, so it has no fields.
+// - id: the board's unique identifier.
+// - attribute: the board's attribute to update.
+// - value: the new value of the attribute.
+//
+// DOCS: https://monday.com/developers/v2#mutations-section-boards-update
+func (*BoardsService) Update(id int, attribute BoardsAttribute, value string) Mutation {
+	return Mutation{
+		name: "update_board",
+		args: []argument{
+			{"board_id", id},
+			{"board_attribute", attribute},
+			{"new_value", value},
+		},
+		scalar: true,
+	}
+}
+
 // List returns a query that gets one board or a collection of boards.
 //
 // DOCS: https://monday.com/developers/v2#queries-section-boards
@@ -204,6 +247,11 @@ type BoardsKind struct {
 	kind string
 }
 
+// EnumValue returns the value as it appears in a query.
+func (k BoardsKind) EnumValue() string {
+	return k.kind
+}
+
 var (
 	boardsKindPublic  = BoardsKind{"public"}
 	boardsKindPrivate = BoardsKind{"private"}
@@ -225,6 +273,42 @@ func BoardsKindShare() BoardsKind {
 	return boardsKindShare
 }
 
+// String returns the name of the board kind, e.g. public.
+func (k BoardsKind) String() string {
+	return k.kind
+}
+
+// The board's attribute that can be updated.
+type BoardsAttribute struct {
+	attribute string
+}
+
+// EnumValue returns the value as it appears in a query.
+func (a BoardsAttribute) EnumValue() string {
+	return a.attribute
+}
+
+var (
+	boardsAttributeName          = BoardsAttribute{"name"}
+	boardsAttributeDescription   = BoardsAttribute{"description"}
+	boardsAttributeCommunication = BoardsAttribute{"communication"}
+)
+
+// The board's name.
+func BoardsAttributeName() BoardsAttribute {
+	return boardsAttributeName
+}
+
+// The board's description.
+func BoardsAttributeDescription() BoardsAttribute {
+	return boardsAttributeDescription
+}
+
+// The board's communication value (e.g. a zoom link).
+func BoardsAttributeCommunication() BoardsAttribute {
+	return boardsAttributeCommunication
+}
+
 // Number of items to get, the default is 25.
 func NewBoardsLimitArgument(value int) BoardsArgument {
 	return BoardsArgument{argument{"limit", value}}
@@ -247,10 +331,29 @@ func NewBoardsKindArgument(kind BoardsKind) BoardsArgument {
 
 // The state of the boards (all / active / archived / deleted), the default is active.
 func NewBoardsStateArgument(state State) BoardsArgument {
-	return BoardsArgument{argument{"state", state.state}}
+	return BoardsArgument{argument{"state", state}}
 }
 
 // Get the recently created boards at the top of the list.
 func NewBoardsNewestFirstArgument(first bool) BoardsArgument {
 	return BoardsArgument{argument{"newest_first", first}}
 }
+
+// The board's graphql fragment, a named selection of board's fields that is defined once per payload.
+type BoardsFragment struct {
+	fragment Fragment
+}
+
+// NewBoardsFragment returns the fragment with the given name of the board's fields.
+func NewBoardsFragment(name string, boardsFields []BoardsField) BoardsFragment {
+	var fields []field
+	for _, f := range boardsFields {
+		fields = append(fields, f.field)
+	}
+	return BoardsFragment{Fragment{name: name, on: "Board", fields: fields}}
+}
+
+// Spreads the fragment of the board's fields.
+func BoardsFragmentField(fragment BoardsFragment) BoardsField {
+	return BoardsField{fragment.fragment.spread()}
+}
@@ -0,0 +1,465 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/di-wu/monday/introspection"
+)
+
+// generator writes the source of the package generated from the schema.
+type generator struct {
+	schema *introspection.Schema
+	buf    bytes.Buffer
+	// roots are the names of the constructors of the queries and mutations.
+	roots map[string]bool
+	// skipped describes the fields that are left out of the generated code.
+	skipped []string
+}
+
+// generate writes the package generated from the schema, it returns the fields that are left out.
+func generate(w io.Writer, schema *introspection.Schema, pkg string) ([]string, error) {
+	if schema.Query() == nil {
+		return nil, fmt.Errorf("schema has no query type")
+	}
+	g := generator{schema: schema, roots: make(map[string]bool)}
+	for _, f := range schema.Query().Fields {
+		g.roots["New"+exported(f.Name)+"Query"] = true
+	}
+	if mutation := schema.Mutation(); mutation != nil {
+		for _, f := range mutation.Fields {
+			g.roots["New"+exported(f.Name)+"Mutation"] = true
+		}
+	}
+	g.printf("// Code generated by monday-apigen. DO NOT EDIT.\n\n")
+	g.printf("package %s\n\n", pkg)
+	g.printf("import (\n\"context\"\n\"encoding/json\"\n\"strings\"\n\"sync\"\n\n")
+	g.printf("\"github.com/di-wu/monday\"\n\"github.com/di-wu/monday/introspection\"\n)\n\n")
+
+	types := make([]introspection.Type, len(schema.Types))
+	copy(types, schema.Types)
+	sort.Slice(types, func(i, j int) bool { return types[i].Name < types[j].Name })
+	for _, t := range types {
+		if strings.HasPrefix(t.Name, "__") {
+			continue
+		}
+		switch t.Kind {
+		case introspection.KindEnum:
+			g.enum(t)
+		case introspection.KindObject:
+			g.result(t)
+			if !g.root(t.Name) {
+				g.selection(t)
+			}
+		case introspection.KindInputObject:
+			g.input(t)
+		}
+	}
+	g.rootFields(schema.Query(), "Query")
+	if mutation := schema.Mutation(); mutation != nil {
+		g.rootFields(mutation, "Mutation")
+	}
+	g.client()
+	defaults := make(map[string]bool)
+	g.requests(schema.Query(), "Query", defaults)
+	if mutation := schema.Mutation(); mutation != nil {
+		g.requests(mutation, "Mutation", defaults)
+	}
+	var objects []string
+	for typ := range defaults {
+		objects = append(objects, typ)
+	}
+	sort.Strings(objects)
+	for _, typ := range objects {
+		g.defaultFields(typ)
+	}
+	if err := g.bundle(); err != nil {
+		return nil, err
+	}
+
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("invalid generated code: %v", err)
+	}
+	_, err = w.Write(src)
+	return g.skipped, err
+}
+
+// skip records that the field of the type is left out of the generated code.
+func (g *generator) skip(typ, field, reason string) {
+	skipped := fmt.Sprintf("%s.%s: %s", typ, field, reason)
+	for _, s := range g.skipped {
+		if s == skipped {
+			return
+		}
+	}
+	g.skipped = append(g.skipped, skipped)
+}
+
+// bundle writes the schema into the package, so payloads can be validated against it.
+func (g *generator) bundle() error {
+	raw, err := json.Marshal(map[string]*introspection.Schema{"__schema": g.schema})
+	if err != nil {
+		return err
+	}
+	g.printf("// schemaJSON is the introspection result the package is generated from.\n")
+	g.printf("const schemaJSON = %s\n\n", strconv.Quote(string(raw)))
+	g.printf("var (\nschemaOnce sync.Once\nschema *introspection.Schema\n)\n\n")
+	g.printf("// Schema returns the schema of the api the package is generated from, e.g. to validate payloads:\n")
+	g.printf("//\n//\terr := payload.Validate(api.Schema())\n")
+	g.printf("func Schema() *introspection.Schema {\nschemaOnce.Do(func() {\nvar err error\n")
+	g.printf("if schema, err = introspection.Parse(strings.NewReader(schemaJSON)); err != nil {\npanic(err)\n}\n})\n")
+	g.printf("return schema\n}\n")
+	return nil
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// comment writes the description as a doc comment, or the fallback if the schema has no description.
+func (g *generator) comment(description, fallback string) {
+	if description == "" {
+		description = fallback
+	}
+	if description == "" {
+		return
+	}
+	for _, line := range strings.Split(strings.TrimSpace(description), "\n") {
+		g.printf("// %s\n", strings.TrimSpace(line))
+	}
+}
+
+func (g *generator) root(name string) bool {
+	s := g.schema
+	return (s.QueryType != nil && s.QueryType.Name == name) || (s.MutationType != nil && s.MutationType.Name == name)
+}
+
+func (g *generator) enum(t introspection.Type) {
+	name := exported(t.Name)
+	g.comment(t.Description, fmt.Sprintf("%s is the %s enum.", name, t.Name))
+	g.printf("type %s struct {\nvalue string\n}\n\n", name)
+	for _, v := range t.EnumValues {
+		g.comment(v.Description, fmt.Sprintf("%s value of %s.", v.Name, t.Name))
+		g.printf("func %s%s() %s {\nreturn %s{%q}\n}\n\n", name, exported(v.Name), name, name, v.Name)
+	}
+	g.printf("// String returns the value as it appears in a query.\n")
+	g.printf("func (v %s) String() string {\nreturn v.value\n}\n\n", name)
+	g.printf("// EnumValue returns the value as it appears in a query.\n")
+	g.printf("func (v %s) EnumValue() string {\nreturn v.value\n}\n\n", name)
+	g.printf("// MarshalJSON encodes the value as a json string.\n")
+	g.printf("func (v %s) MarshalJSON() ([]byte, error) {\nreturn json.Marshal(v.value)\n}\n\n", name)
+	g.printf("// UnmarshalJSON decodes the value from a json string.\n")
+	g.printf("func (v *%s) UnmarshalJSON(data []byte) error {\nreturn json.Unmarshal(data, &v.value)\n}\n\n", name)
+}
+
+// result writes the struct that a selection of the object decodes into.
+func (g *generator) result(t introspection.Type) {
+	name := exported(t.Name)
+	g.comment(t.Description, fmt.Sprintf("%s is the %s object.", name, t.Name))
+	g.printf("type %s struct {\n", name)
+	for _, f := range t.Fields {
+		typ, ok := g.resultType(f.Type)
+		if !ok {
+			g.skip(t.Name, f.Name, fmt.Sprintf("results of type %s are not supported", f.Type))
+			continue
+		}
+		g.comment(f.Description, "")
+		g.printf("%s %s `json:%q`\n", exported(f.Name), typ, f.Name+",omitempty")
+	}
+	g.printf("}\n\n")
+}
+
+func (g *generator) resultType(t introspection.TypeRef) (string, bool) {
+	switch t.Kind {
+	case introspection.KindNonNull:
+		return g.resultType(t.Elem())
+	case introspection.KindList:
+		elem, ok := g.resultType(t.Elem())
+		return "[]" + strings.TrimPrefix(elem, "*"), ok
+	case introspection.KindObject:
+		return "*" + exported(t.Name), true
+	case introspection.KindEnum:
+		return exported(t.Name), true
+	case introspection.KindScalar:
+		return scalarType(t.Name, false), true
+	}
+	return "", false
+}
+
+// scalarType returns the Go type of a scalar, identifiers are integers as arguments but strings in results.
+func scalarType(name string, input bool) string {
+	switch name {
+	case "Int":
+		return "int"
+	case "Float":
+		return "float64"
+	case "Boolean":
+		return "bool"
+	case "ID":
+		if input {
+			return "int"
+		}
+	}
+	return "string"
+}
+
+// inputType returns the Go type of an argument or a field of an input object.
+func (g *generator) inputType(t introspection.TypeRef) (string, bool) {
+	switch t.Kind {
+	case introspection.KindNonNull:
+		return g.inputType(t.Elem())
+	case introspection.KindList:
+		elem, ok := g.inputType(t.Elem())
+		return "[]" + elem, ok
+	case introspection.KindEnum, introspection.KindInputObject:
+		return exported(t.Name), true
+	case introspection.KindScalar:
+		return scalarType(t.Name, true), true
+	}
+	return "", false
+}
+
+// input writes the struct of the input object and its constructor, which takes the required fields.
+// The struct is encoded with the names of its json tags, optional fields are left out if they are empty.
+func (g *generator) input(t introspection.Type) {
+	name := exported(t.Name)
+	g.comment(t.Description, fmt.Sprintf("%s is the %s input object.", name, t.Name))
+	g.printf("type %s struct {\n", name)
+	var params, values []string
+	for _, f := range t.InputFields {
+		typ, ok := g.inputType(f.Type)
+		if !ok {
+			g.skip(t.Name, f.Name, fmt.Sprintf("inputs of type %s are not supported", f.Type))
+			continue
+		}
+		tag := f.Name
+		if f.Type.NonNull() && f.DefaultValue == nil {
+			params = append(params, fmt.Sprintf("%s %s", parameter(f.Name), typ))
+			values = append(values, fmt.Sprintf("%s: %s", exported(f.Name), parameter(f.Name)))
+		} else {
+			tag += ",omitempty"
+		}
+		g.comment(f.Description, "")
+		g.printf("%s %s `json:%q`\n", exported(f.Name), typ, tag)
+	}
+	g.printf("}\n\n")
+
+	constructor := "New" + name
+	if g.roots[constructor] {
+		constructor += "Input"
+	}
+	g.printf("// %s returns the %s input object with the required fields, optional fields can be set on the result.\n",
+		constructor, t.Name)
+	g.printf("func %s(%s) %s {\nreturn %s{%s}\n}\n\n",
+		constructor, strings.Join(params, ", "), name, name, strings.Join(values, ", "))
+}
+
+// selection writes the field and argument constructors of the object.
+func (g *generator) selection(t introspection.Type) {
+	name := exported(t.Name)
+	g.printf("// The %s's graphql field(s).\n", name)
+	g.printf("type %sField struct {\nfield monday.Field\n}\n\n", name)
+	g.printf("func %sFields(fields []%sField) []monday.Field {\n", unexported(name), name)
+	g.printf("var selection []monday.Field\nfor _, f := range fields {\nselection = append(selection, f.field)\n}\nreturn selection\n}\n\n")
+	g.printf("// The %s's graphql fragment, a named selection of its fields that is defined once per payload.\n", name)
+	g.printf("type %sFragment struct {\nfragment monday.Fragment\n}\n\n", name)
+	g.printf("// New%sFragment returns the fragment with the given name of the %s's fields.\n", name, name)
+	g.printf("func New%sFragment(name string, fields []%sField) %sFragment {\nreturn %sFragment{monday.NewFragment(name, %q, %sFields(fields))}\n}\n\n",
+		name, name, name, name, t.Name, unexported(name))
+	g.printf("// %sFragmentField spreads the fragment of the %s's fields.\n", name, name)
+	g.printf("func %sFragmentField(fragment %sFragment) %sField {\nreturn %sField{fragment.fragment.Spread()}\n}\n\n",
+		name, name, name, name)
+	for _, f := range t.Fields {
+		g.field(t.Name, f)
+	}
+}
+
+func (g *generator) field(typ string, f introspection.Field) {
+	object := exported(typ)
+	named := f.Type.Named()
+	fieldName := exported(f.Name)
+	required, optional, ok := g.args(typ, f)
+	if !ok {
+		return
+	}
+	argType := object + fieldName + "Argument"
+	var params []string
+	for _, a := range required {
+		params = append(params, a.param)
+	}
+	scalar := named.Kind == introspection.KindScalar || named.Kind == introspection.KindEnum
+	switch {
+	case scalar && len(required) == 0 && len(optional) == 0:
+		g.comment(f.Description, "")
+		g.printf("func %s%sField() %sField {\nreturn %sField{monday.NewField(%q, nil)}\n}\n\n",
+			object, fieldName, object, object, f.Name)
+		return
+	case named.Kind != introspection.KindObject && !scalar:
+		g.skip(typ, f.Name, fmt.Sprintf("fields of type %s are not supported", f.Type))
+		return
+	case !scalar:
+		params = append(params, fmt.Sprintf("fields []%sField", exported(named.Name)))
+	}
+	if len(optional) != 0 {
+		params = append(params, fmt.Sprintf("args ...%s", argType))
+	}
+	g.comment(f.Description, "")
+	g.printf("func New%s%sField(%s) %sField {\n", object, fieldName, strings.Join(params, ", "), object)
+	g.arguments(required, optional, argType)
+	if scalar {
+		g.printf("return %sField{monday.NewField(%q, arguments)}\n}\n\n", object, f.Name)
+	} else {
+		g.printf("return %sField{monday.NewObjectField(%q, %sFields(fields), arguments)}\n}\n\n",
+			object, f.Name, unexported(exported(named.Name)))
+	}
+	g.optional(optional, argType, object+fieldName, fmt.Sprintf("%s's %s field", object, f.Name))
+}
+
+// rootFields writes the constructors of the queries or mutations.
+func (g *generator) rootFields(t *introspection.Type, kind string) {
+	for _, f := range t.Fields {
+		named := f.Type.Named()
+		required, optional, ok := g.args(t.Name, f)
+		if !ok {
+			continue
+		}
+		fieldName := exported(f.Name)
+		argType := kind + fieldName + "Argument"
+		var params []string
+		for _, a := range required {
+			params = append(params, a.param)
+		}
+		object := named.Kind == introspection.KindObject
+		if object {
+			params = append(params, fmt.Sprintf("fields []%sField", exported(named.Name)))
+		} else if kind == "Query" {
+			// a query without a selection set is not supported by monday.Query.
+			g.skip(t.Name, f.Name, "queries without a selection set are not supported")
+			continue
+		}
+		if len(optional) != 0 {
+			params = append(params, fmt.Sprintf("args ...%s", argType))
+		}
+		g.comment(f.Description, "")
+		g.printf("func New%s%s(%s) monday.%s {\n", fieldName, kind, strings.Join(params, ", "), kind)
+		g.arguments(required, optional, argType)
+		fields := "nil"
+		if object {
+			fields = unexported(exported(named.Name)) + "Fields(fields)"
+		}
+		g.printf("return monday.New%s(%q, %s, arguments)\n}\n\n", kind, f.Name, fields)
+		g.optional(optional, argType, kind+fieldName, fmt.Sprintf("%s %s", f.Name, strings.ToLower(kind)))
+	}
+}
+
+type arg struct {
+	name, param, typ, description string
+}
+
+// args splits the arguments of a field of the type in required and optional ones.
+// Fields with a required argument of an unsupported type are skipped, optional ones are left out.
+func (g *generator) args(object string, f introspection.Field) ([]arg, []arg, bool) {
+	var required, optional []arg
+	for _, a := range f.Args {
+		typ, ok := g.inputType(a.Type)
+		if !ok {
+			if a.Type.NonNull() {
+				g.skip(object, f.Name, fmt.Sprintf("argument %s of type %s is not supported", a.Name, a.Type))
+				return nil, nil, false
+			}
+			g.skip(object, f.Name+"("+a.Name+")", fmt.Sprintf("arguments of type %s are not supported", a.Type))
+			continue
+		}
+		v := arg{name: a.Name, typ: typ, description: a.Description}
+		if a.Type.NonNull() && a.DefaultValue == nil {
+			v.param = fmt.Sprintf("%s %s", parameter(a.Name), typ)
+			required = append(required, v)
+			continue
+		}
+		optional = append(optional, v)
+	}
+	return required, optional, true
+}
+
+// arguments writes the collection of the arguments of a constructor.
+func (g *generator) arguments(required, optional []arg, argType string) {
+	g.printf("arguments := []monday.Argument{\n")
+	for _, a := range required {
+		g.printf("monday.NewArgument(%q, %s),\n", a.name, parameter(a.name))
+	}
+	g.printf("}\n")
+	if len(optional) != 0 {
+		g.printf("for _, a := range args {\narguments = append(arguments, a.argument)\n}\n")
+	}
+}
+
+// optional writes the argument type and constructors of the optional arguments.
+func (g *generator) optional(optional []arg, argType, prefix, of string) {
+	if len(optional) == 0 {
+		return
+	}
+	g.printf("// The %s's graphql argument(s).\n", of)
+	g.printf("type %s struct {\nargument monday.Argument\n}\n\n", argType)
+	for _, a := range optional {
+		g.comment(a.description, fmt.Sprintf("The %s argument.", a.name))
+		g.printf("func New%s%sArgument(value %s) %s {\nreturn %s{monday.NewArgument(%q, value)}\n}\n\n",
+			prefix, exported(a.name), a.typ, argType, argType, a.name)
+	}
+}
+
+// initialisms are written in upper case in identifiers.
+var initialisms = map[string]string{
+	"id":   "ID",
+	"ids":  "IDs",
+	"url":  "URL",
+	"utc":  "UTC",
+	"json": "JSON",
+	"html": "HTML",
+}
+
+// exported returns the exported Go identifier of a GraphQL name, e.g. board_folder_id becomes BoardFolderID.
+func exported(name string) string {
+	var b strings.Builder
+	for _, word := range strings.FieldsFunc(name, func(r rune) bool { return r == '_' || r == '-' }) {
+		if initialism, ok := initialisms[strings.ToLower(word)]; ok {
+			b.WriteString(initialism)
+			continue
+		}
+		r := []rune(word)
+		r[0] = unicode.ToUpper(r[0])
+		b.WriteString(string(r))
+	}
+	return b.String()
+}
+
+// unexported returns the unexported Go identifier of an exported identifier.
+func unexported(name string) string {
+	r := []rune(name)
+	for i := 0; i < len(r) && unicode.IsUpper(r[i]); i++ {
+		if i != 0 && i+1 < len(r) && unicode.IsLower(r[i+1]) {
+			break
+		}
+		r[i] = unicode.ToLower(r[i])
+	}
+	return string(r)
+}
+
+// parameter returns the name of the parameter of an argument, avoiding Go keywords.
+func parameter(name string) string {
+	p := unexported(exported(name))
+	switch p {
+	case "type", "func", "var", "range", "map", "chan", "go", "select", "case", "default", "interface", "package",
+		"import", "return", "struct", "switch", "const", "continue", "break", "defer", "else", "fallthrough", "for",
+		"goto", "if", "fields", "args", "arguments":
+		return p + "Value"
+	}
+	return p
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/di-wu/monday/introspection"
+)
+
+const schema = `{"data": {"__schema": {
+	"queryType": {"name": "Query"},
+	"mutationType": {"name": "Mutation"},
+	"types": [
+		{"kind": "OBJECT", "name": "Query", "fields": [
+			{"name": "folders", "description": "Get folders.", "args": [
+				{"name": "workspace_ids", "type": {"kind": "LIST", "ofType": {"kind": "SCALAR", "name": "ID"}}},
+				{"name": "filter", "type": {"kind": "INPUT_OBJECT", "name": "FolderFilter"}}
+			], "type": {"kind": "LIST", "ofType": {"kind": "OBJECT", "name": "Folder"}}},
+			{"name": "version", "args": [], "type": {"kind": "SCALAR", "name": "String"}}
+		]},
+		{"kind": "OBJECT", "name": "Mutation", "fields": [
+			{"name": "delete_folder", "args": [
+				{"name": "folder_id", "type": {"kind": "NON_NULL", "ofType": {"kind": "SCALAR", "name": "ID"}}}
+			], "type": {"kind": "SCALAR", "name": "JSON"}},
+			{"name": "move_folders", "args": [
+				{"name": "moves", "type": {"kind": "NON_NULL", "ofType": {"kind": "LIST", "ofType": {"kind": "INPUT_OBJECT", "name": "FolderMove"}}}}
+			], "type": {"kind": "SCALAR", "name": "JSON"}}
+		]},
+		{"kind": "OBJECT", "name": "Folder", "description": "A folder.", "fields": [
+			{"name": "id", "args": [], "type": {"kind": "NON_NULL", "ofType": {"kind": "SCALAR", "name": "ID"}}},
+			{"name": "color", "args": [], "type": {"kind": "ENUM", "name": "FolderColor"}},
+			{"name": "owner", "args": [], "type": {"kind": "UNION", "name": "Owner"}},
+			{"name": "children", "args": [
+				{"name": "limit", "type": {"kind": "SCALAR", "name": "Int"}}
+			], "type": {"kind": "LIST", "ofType": {"kind": "OBJECT", "name": "Folder"}}}
+		]},
+		{"kind": "ENUM", "name": "FolderColor", "enumValues": [{"name": "DONE_GREEN"}, {"name": "bright-blue"}]},
+		{"kind": "INPUT_OBJECT", "name": "FolderFilter", "description": "Filters folders.", "inputFields": [
+			{"name": "color", "type": {"kind": "ENUM", "name": "FolderColor"}},
+			{"name": "name", "type": {"kind": "NON_NULL", "ofType": {"kind": "SCALAR", "name": "String"}}}
+		]},
+		{"kind": "INPUT_OBJECT", "name": "FolderMove", "inputFields": [
+			{"name": "folder_id", "type": {"kind": "NON_NULL", "ofType": {"kind": "SCALAR", "name": "ID"}}},
+			{"name": "filter", "type": {"kind": "INPUT_OBJECT", "name": "FolderFilter"}}
+		]},
+		{"kind": "INPUT_OBJECT", "name": "FoldersQuery", "inputFields": []},
+		{"kind": "OBJECT", "name": "__Type", "fields": []}
+	]
+}}}`
+
+func TestGenerate(t *testing.T) {
+	s, err := introspection.Parse(strings.NewReader(schema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	skipped, err := generate(&buf, s, "folders")
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := buf.String()
+	for _, want := range []string{
+		"package folders",
+		"// A folder.\ntype Folder struct {",
+		"ID       string      `json:\"id,omitempty\"`",
+		"Color    FolderColor `json:\"color,omitempty\"`",
+		"Children []Folder    `json:\"children,omitempty\"`",
+		"func FolderColorDONEGREEN() FolderColor",
+		"func FolderColorBrightBlue() FolderColor",
+		"func FolderIDField() FolderField",
+		"func NewFolderFragment(name string, fields []FolderField) FolderFragment",
+		"func FolderFragmentField(fragment FolderFragment) FolderField",
+		"func NewFolderChildrenField(fields []FolderField, args ...FolderChildrenArgument) FolderField",
+		"func NewFolderChildrenLimitArgument(value int) FolderChildrenArgument",
+		"// Get folders.\nfunc NewFoldersQuery(fields []FolderField, args ...QueryFoldersArgument) monday.Query",
+		"func NewQueryFoldersWorkspaceIDsArgument(value []int) QueryFoldersArgument",
+		"func NewQueryFoldersFilterArgument(value FolderFilter) QueryFoldersArgument",
+		"// Filters folders.\ntype FolderFilter struct {\n\tColor FolderColor `json:\"color,omitempty\"`\n\tName  string      `json:\"name\"`\n}",
+		"func NewFolderFilter(name string) FolderFilter {\n\treturn FolderFilter{Name: name}\n}",
+		"func NewFolderMove(folderID int) FolderMove",
+		"func NewFoldersQueryInput() FoldersQuery",
+		"func NewMoveFoldersMutation(moves []FolderMove) monday.Mutation",
+		"func (c *Client) MoveFolders(moves []FolderMove) *MoveFoldersRequest",
+		"func NewDeleteFolderMutation(folderID int) monday.Mutation",
+		"func (c *Client) Folders() *FoldersRequest",
+		"func (r *FoldersRequest) WorkspaceIDs(values ...int) *FoldersRequest",
+		"func (r *FoldersRequest) Do(ctx context.Context) ([]Folder, error)",
+		"func (c *Client) DeleteFolder(folderID int) *DeleteFolderRequest",
+		"func (r *DeleteFolderRequest) Do(ctx context.Context) (string, error)",
+		"func defaultFolderFields() []FolderField {\n\treturn []FolderField{\n\t\tFolderIDField(),\n\t\tFolderColorField(),\n\t}\n}",
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("generated code does not contain %q", want)
+		}
+	}
+	if got := strings.Join(skipped, "\n"); got != "Folder.owner: results of type Owner are not supported\n"+
+		"Folder.owner: fields of type Owner are not supported\n"+
+		"Query.version: queries without a selection set are not supported" {
+		t.Errorf("unexpected skipped fields:\n%s", got)
+	}
+	code := src[:strings.Index(src, "const schemaJSON")]
+	for _, unwanted := range []string{"__Type", "QueryField"} {
+		if strings.Contains(code, unwanted) {
+			t.Errorf("generated code contains %q", unwanted)
+		}
+	}
+}
+
+func TestExported(t *testing.T) {
+	for name, want := range map[string]string{
+		"board_folder_id": "BoardFolderID",
+		"workspace_ids":   "WorkspaceIDs",
+		"picture_url":     "PictureURL",
+		"Board":           "Board",
+	} {
+		if got := exported(name); got != want {
+			t.Errorf("exported(%q) = %q, expected %q", name, got, want)
+		}
+	}
+	if got := parameter("type"); got != "typeValue" {
+		t.Errorf("parameter(type) = %q", got)
+	}
+}
@@ -0,0 +1,70 @@
+// Command monday-apigen generates field selectors, argument constructors, enums and result structs
+// from the introspection result of the schema of the monday api.
+//
+// Usage:
+//
+//	monday-apigen -schema schema.json -package api [-o file]
+//
+// The schema file contains the json result of the introspection query, see the introspection package.
+// For every object type it generates a struct to decode results into, a field type with a constructor per field
+// and an argument type with a constructor per optional argument. Required arguments are parameters of the
+// constructors. Queries and mutations are generated as constructors of monday.Query and monday.Mutation values.
+// Input objects are generated as structs, with a constructor that takes their required fields.
+// Fields of types that are not supported, like unions and interfaces, are left out and reported on stderr.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/di-wu/monday/introspection"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout, os.Stderr); err != nil {
+		fmt.Fprintln(os.Stderr, "monday-apigen:", err)
+		os.Exit(1)
+	}
+}
+
+// run generates the package, the fields that are left out are reported on stderr.
+func run(args []string, w, stderr io.Writer) error {
+	fs := flag.NewFlagSet("monday-apigen", flag.ContinueOnError)
+	schemaPath := fs.String("schema", "", "json file with the introspection result")
+	pkg := fs.String("package", "api", "package name of the generated file")
+	output := fs.String("o", "", "output file (default: stdout)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *schemaPath == "" {
+		return errors.New("-schema is required")
+	}
+	f, err := os.Open(*schemaPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	schema, err := introspection.Parse(f)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	skipped, err := generate(&buf, schema, *pkg)
+	if err != nil {
+		return err
+	}
+	for _, s := range skipped {
+		fmt.Fprintln(stderr, "monday-apigen: skipped", s)
+	}
+	if *output == "" {
+		_, err := w.Write(buf.Bytes())
+		return err
+	}
+	return ioutil.WriteFile(*output, buf.Bytes(), 0644)
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/di-wu/monday/introspection"
+)
+
+// client writes the client that binds the requests of the root fields.
+func (g *generator) client() {
+	g.printf("// Client executes the queries and mutations of the package as fluent requests, e.g.\n")
+	g.printf("//\n//\tboards, err := api.NewClient(client).Boards().IDs(1).Select(api.BoardNameField()).Do(ctx)\n")
+	g.printf("//\n// The requests coexist with the payloads of the monday package, see the Query and Mutation methods.\n")
+	g.printf("type Client struct {\n*monday.Client\n}\n\n")
+	g.printf("// NewClient returns a client that executes the requests with the given client.\n")
+	g.printf("func NewClient(client *monday.Client) *Client {\nreturn &Client{client}\n}\n\n")
+	g.printf("// request holds the client and the arguments of a request.\ntype request struct {\n")
+	g.printf("client *monday.Client\nnames []string\narguments []monday.Argument\n}\n\n")
+	g.printf("// set sets the argument with the given name, replacing an earlier value.\n")
+	g.printf("func (r *request) set(name string, value interface{}) {\n")
+	g.printf("argument := monday.NewArgument(name, value)\nfor i, n := range r.names {\nif n == name {\n")
+	g.printf("r.arguments[i] = argument\nreturn\n}\n}\n")
+	g.printf("r.names = append(r.names, name)\nr.arguments = append(r.arguments, argument)\n}\n\n")
+}
+
+// requests writes the fluent requests of the queries or mutations.
+func (g *generator) requests(t *introspection.Type, kind string, defaults map[string]bool) {
+	for _, f := range t.Fields {
+		named := f.Type.Named()
+		required, optional, ok := g.args(t.Name, f)
+		if !ok {
+			continue
+		}
+		object := named.Kind == introspection.KindObject
+		if !object && kind == "Query" {
+			continue
+		}
+		result, ok := g.resultType(f.Type)
+		if !ok {
+			g.skip(t.Name, f.Name, fmt.Sprintf("requests with results of type %s are not supported", f.Type))
+			continue
+		}
+		fieldName := exported(f.Name)
+		name := fieldName + "Request"
+		g.printf("// %s is a %s %s, see Client.%s.\n", name, f.Name, strings.ToLower(kind), fieldName)
+		g.printf("type %s struct {\nrequest\n", name)
+		if object {
+			g.printf("fields []%sField\n", exported(named.Name))
+		}
+		g.printf("}\n\n")
+
+		var params []string
+		for _, a := range required {
+			params = append(params, a.param)
+		}
+		g.comment(f.Description, "")
+		g.printf("func (c *Client) %s(%s) *%s {\n", fieldName, strings.Join(params, ", "), name)
+		g.printf("r := %s{request: request{client: c.Client}}\n", name)
+		for _, a := range required {
+			g.printf("r.set(%q, %s)\n", a.name, parameter(a.name))
+		}
+		g.printf("return &r\n}\n\n")
+
+		for _, a := range optional {
+			g.comment(a.description, fmt.Sprintf("%s sets the %s argument.", exported(a.name), a.name))
+			if strings.HasPrefix(a.typ, "[]") {
+				g.printf("func (r *%s) %s(values ...%s) *%s {\nr.set(%q, values)\nreturn r\n}\n\n",
+					name, exported(a.name), strings.TrimPrefix(a.typ, "[]"), name, a.name)
+				continue
+			}
+			g.printf("func (r *%s) %s(value %s) *%s {\nr.set(%q, value)\nreturn r\n}\n\n",
+				name, exported(a.name), a.typ, name, a.name)
+		}
+
+		fields := "nil"
+		if object {
+			typ := exported(named.Name)
+			defaults[typ] = true
+			g.printf("// Select adds fields to the selection, by default the fields without arguments are selected.\n")
+			g.printf("func (r *%s) Select(fields ...%sField) *%s {\nr.fields = append(r.fields, fields...)\nreturn r\n}\n\n",
+				name, typ, name)
+			g.printf("func (r *%s) selection() []%sField {\nif len(r.fields) == 0 {\nreturn default%sFields()\n}\nreturn r.fields\n}\n\n",
+				name, typ, typ)
+			fields = unexported(typ) + "Fields(r.selection())"
+		}
+		g.printf("// %s returns the %s, e.g. to add it to a payload.\n", kind, strings.ToLower(kind))
+		g.printf("func (r *%s) %s() monday.%s {\nreturn monday.New%s(%q, %s, r.arguments)\n}\n\n",
+			name, kind, kind, kind, f.Name, fields)
+		g.printf("// Do executes the %s and returns its result.\n", strings.ToLower(kind))
+		g.printf("func (r *%s) Do(ctx context.Context) (%s, error) {\n", name, result)
+		g.printf("var data struct {\nResult %s `json:%q`\n}\n", result, f.Name)
+		g.printf("err := r.client.Do(ctx, monday.New%sPayload(r.%s()), &data)\nreturn data.Result, err\n}\n\n", kind, kind)
+	}
+}
+
+// defaultFields writes the default selections of the requests, the fields of the object without arguments.
+func (g *generator) defaultFields(typ string) {
+	var object *introspection.Type
+	for i := range g.schema.Types {
+		if exported(g.schema.Types[i].Name) == typ {
+			object = &g.schema.Types[i]
+		}
+	}
+	g.printf("func default%sFields() []%sField {\nreturn []%sField{\n", typ, typ, typ)
+	for _, f := range object.Fields {
+		switch f.Type.Named().Kind {
+		case introspection.KindScalar, introspection.KindEnum:
+			if len(f.Args) == 0 {
+				g.printf("%s%sField(),\n", typ, exported(f.Name))
+			}
+		}
+	}
+	g.printf("}\n}\n\n")
+}
@@ -0,0 +1,83 @@
+// Command monday-boardgen generates typed Go code for the items of a monday board.
+//
+// Usage:
+//
+//	monday-boardgen -package name [-type Name] [-o file] -board ID
+//	monday-boardgen -package name [-type Name] [-o file] [-board ID] [-name NAME] -schema columns.json
+//
+// Without -schema the columns are fetched from the api with the token of the MONDAY_API_TOKEN environment variable.
+// The schema file contains the json encoded columns, as returned by pdq's GetColumns.
+//
+// It is meant to be used with go generate:
+//
+//	//go:generate go run github.com/di-wu/monday/cmd/monday-boardgen -package tickets -type Ticket -board 123 -o ticket.go
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/di-wu/monday/boardgen"
+	"github.com/di-wu/monday/pdq"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "monday-boardgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, w io.Writer) error {
+	fs := flag.NewFlagSet("monday-boardgen", flag.ContinueOnError)
+	boardID := fs.Int("board", 0, "identifier of the board")
+	name := fs.String("name", "", "name of the board, when reading a schema file")
+	schema := fs.String("schema", "", "json file with the columns of the board")
+	pkg := fs.String("package", "", "package name of the generated file")
+	typ := fs.String("type", "", "name of the generated struct (default: the name of the board)")
+	output := fs.String("o", "", "output file (default: stdout)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	var board boardgen.Board
+	switch {
+	case *schema != "":
+		f, err := os.Open(*schema)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		columns, err := boardgen.ReadColumns(f)
+		if err != nil {
+			return err
+		}
+		board = boardgen.Board{ID: *boardID, Name: *name, Columns: columns}
+	case *boardID != 0:
+		token, ok := os.LookupEnv("MONDAY_API_TOKEN")
+		if !ok {
+			return errors.New("no access token, set MONDAY_API_TOKEN or use -schema")
+		}
+		var err error
+		if board, err = boardgen.Fetch(pdq.NewSimpleClient(token), *boardID); err != nil {
+			return err
+		}
+	default:
+		return errors.New("either -board or -schema is required")
+	}
+
+	var buf bytes.Buffer
+	if err := boardgen.Generate(&buf, board, boardgen.Options{Package: *pkg, Type: *typ}); err != nil {
+		return err
+	}
+	if *output == "" {
+		_, err := w.Write(buf.Bytes())
+		return err
+	}
+	return ioutil.WriteFile(*output, buf.Bytes(), 0644)
+}
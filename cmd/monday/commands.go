@@ -0,0 +1,394 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/di-wu/monday"
+	"github.com/di-wu/monday/pdq"
+)
+
+type command struct {
+	name   string
+	client *pdq.SimpleClient
+	out    io.Writer
+	json   bool
+}
+
+var commands = map[string]func(*command, []string) error{
+	"boards":       listBoards,
+	"groups":       listGroups,
+	"columns":      listColumns,
+	"items":        listItems,
+	"create-item":  createItem,
+	"archive-item": archiveItem,
+	"set-value":    setValue,
+	"post-update":  postUpdate,
+	"exec":         execFile,
+}
+
+func (c *command) flags() *flag.FlagSet {
+	return flag.NewFlagSet(c.name, flag.ContinueOnError)
+}
+
+// write prints the value as json, or the rows as a table.
+func (c *command) write(v interface{}, header []string, rows [][]string) error {
+	if c.json {
+		enc := json.NewEncoder(c.out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+	w := tabwriter.NewWriter(c.out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	return w.Flush()
+}
+
+// exec executes the payload and decodes the data of the response into v.
+func (c *command) exec(payload monday.Payload, v interface{}) error {
+	return c.client.Do(context.Background(), payload, v)
+}
+
+// requireInt returns an error and prints the usage of the command if the flag with the given name is not set.
+func requireInt(fs *flag.FlagSet, name string, value int) error {
+	if value == 0 {
+		fs.Usage()
+		return fmt.Errorf("%s: missing required flag -%s", fs.Name(), name)
+	}
+	return nil
+}
+
+// requireString returns an error and prints the usage of the command if the flag with the given name is not set.
+func requireString(fs *flag.FlagSet, name string, value string) error {
+	if value == "" {
+		fs.Usage()
+		return fmt.Errorf("%s: missing required flag -%s", fs.Name(), name)
+	}
+	return nil
+}
+
+func listBoards(c *command, args []string) error {
+	if err := c.flags().Parse(args); err != nil {
+		return err
+	}
+	boards, err := c.client.GetBoards()
+	if err != nil {
+		return err
+	}
+	var rows [][]string
+	for _, b := range boards {
+		rows = append(rows, []string{b.Id, b.Name})
+	}
+	return c.write(boards, []string{"ID", "NAME"}, rows)
+}
+
+func listGroups(c *command, args []string) error {
+	fs := c.flags()
+	boardID := fs.Int("board", 0, "the board's unique identifier")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := requireInt(fs, "board", *boardID); err != nil {
+		return err
+	}
+	groups, err := c.client.GetGroups(*boardID)
+	if err != nil {
+		return err
+	}
+	var rows [][]string
+	for _, g := range groups {
+		rows = append(rows, []string{g.Id, g.Title})
+	}
+	return c.write(groups, []string{"ID", "TITLE"}, rows)
+}
+
+func listColumns(c *command, args []string) error {
+	fs := c.flags()
+	boardID := fs.Int("board", 0, "the board's unique identifier")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := requireInt(fs, "board", *boardID); err != nil {
+		return err
+	}
+	columns, err := c.client.GetColumns(*boardID)
+	if err != nil {
+		return err
+	}
+	var rows [][]string
+	for _, col := range columns {
+		rows = append(rows, []string{col.Id, col.Title, col.Type})
+	}
+	return c.write(columns, []string{"ID", "TITLE", "TYPE"}, rows)
+}
+
+type item struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Group  string `json:"group"`
+	Values []struct {
+		ID    string `json:"id"`
+		Title string `json:"title"`
+		Text  string `json:"text"`
+	} `json:"column_values"`
+}
+
+func listItems(c *command, args []string) error {
+	fs := c.flags()
+	boardID := fs.Int("board", 0, "the board's unique identifier")
+	groupID := fs.String("group", "", "only list the items of this group")
+	limit := fs.Int("limit", 0, "the number of items to list (default: all)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := requireInt(fs, "board", *boardID); err != nil {
+		return err
+	}
+	var groupsArgs []monday.GroupsArgument
+	if *groupID != "" {
+		groupsArgs = append(groupsArgs, monday.NewGroupsIDsArgument([]string{*groupID}))
+	}
+	var data struct {
+		Boards []struct {
+			Groups []struct {
+				ID    string `json:"id"`
+				Items []item
+			}
+		}
+	}
+	if err := c.exec(monday.NewQueryPayload(
+		monday.Boards.List(
+			[]monday.BoardsField{
+				monday.NewBoardsGroupsFields(
+					[]monday.GroupsField{
+						monday.GroupsIDField(),
+						monday.NewGroupsItemsField(
+							[]monday.ItemsField{
+								monday.ItemsIDField(),
+								monday.ItemsNameField(),
+								monday.NewItemsColumnValuesField(
+									[]monday.ColumnValuesField{
+										monday.ColumnValuesIDField(),
+										monday.ColumnValuesTitleField(),
+										monday.ColumnValuesTextField(),
+									},
+									nil,
+								),
+							},
+							nil,
+						),
+					},
+					groupsArgs,
+				),
+			},
+			monday.NewBoardsIDsArgument([]int{*boardID}),
+		),
+	), &data); err != nil {
+		return err
+	}
+	if len(data.Boards) != 1 {
+		return fmt.Errorf("no board found for id %d", *boardID)
+	}
+
+	var items []item
+	for _, g := range data.Boards[0].Groups {
+		for _, i := range g.Items {
+			i.Group = g.ID
+			items = append(items, i)
+		}
+	}
+	if *limit > 0 && *limit < len(items) {
+		items = items[:*limit]
+	}
+
+	header := []string{"ID", "GROUP", "NAME"}
+	var rows [][]string
+	for n, i := range items {
+		row := []string{i.ID, i.Group, i.Name}
+		for _, v := range i.Values {
+			if n == 0 {
+				header = append(header, strings.ToUpper(v.Title))
+			}
+			row = append(row, v.Text)
+		}
+		rows = append(rows, row)
+	}
+	return c.write(items, header, rows)
+}
+
+func createItem(c *command, args []string) error {
+	fs := c.flags()
+	boardID := fs.Int("board", 0, "the board's unique identifier")
+	groupID := fs.String("group", "", "the group's unique identifier")
+	name := fs.String("name", "", "the new item's name")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := requireInt(fs, "board", *boardID); err != nil {
+		return err
+	}
+	if err := requireString(fs, "group", *groupID); err != nil {
+		return err
+	}
+	if err := requireString(fs, "name", *name); err != nil {
+		return err
+	}
+	var data struct {
+		Item pdq.Item `json:"create_item"`
+	}
+	if err := c.exec(monday.NewMutationPayload(
+		monday.Items.Create(*boardID, *groupID, *name, nil, []monday.ItemsField{
+			monday.ItemsIDField(),
+			monday.ItemsNameField(),
+		}),
+	), &data); err != nil {
+		return err
+	}
+	return c.write(data.Item, []string{"ID", "NAME"}, [][]string{{data.Item.Id, data.Item.Name}})
+}
+
+func archiveItem(c *command, args []string) error {
+	fs := c.flags()
+	itemID := fs.Int("item", 0, "the item's unique identifier")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := requireInt(fs, "item", *itemID); err != nil {
+		return err
+	}
+	var data struct {
+		Item pdq.Item `json:"archive_item"`
+	}
+	if err := c.exec(monday.NewMutationPayload(
+		monday.Items.Archive(*itemID, []monday.ItemsField{
+			monday.ItemsIDField(),
+			monday.ItemsNameField(),
+		}),
+	), &data); err != nil {
+		return err
+	}
+	return c.write(data.Item, []string{"ID", "NAME"}, [][]string{{data.Item.Id, data.Item.Name}})
+}
+
+func setValue(c *command, args []string) error {
+	fs := c.flags()
+	boardID := fs.Int("board", 0, "the board's unique identifier")
+	itemID := fs.Int("item", 0, "the item's unique identifier")
+	title := fs.String("column", "", "the column's title (or unique identifier)")
+	value := fs.String("value", "", "the new value, an empty value removes the current value")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := requireInt(fs, "board", *boardID); err != nil {
+		return err
+	}
+	if err := requireInt(fs, "item", *itemID); err != nil {
+		return err
+	}
+	if err := requireString(fs, "column", *title); err != nil {
+		return err
+	}
+	columns, err := c.client.GetColumns(*boardID)
+	if err != nil {
+		return err
+	}
+	var column *pdq.Column
+	for i, col := range columns {
+		if col.Title == *title {
+			column = &columns[i]
+			break
+		}
+	}
+	if column == nil {
+		for i, col := range columns {
+			if col.Id == *title {
+				column = &columns[i]
+				break
+			}
+		}
+	}
+	if column == nil {
+		return fmt.Errorf("no column found with title %q on board %d", *title, *boardID)
+	}
+	v, err := pdq.ParseColumnValue(*column, *value)
+	if err != nil {
+		return err
+	}
+	var data struct {
+		Item pdq.Item `json:"change_column_value"`
+	}
+	if err := c.exec(monday.NewMutationPayload(
+		monday.Columns.ChangeValue(*itemID, column.Id, *boardID, v, []monday.ItemsField{
+			monday.ItemsIDField(),
+			monday.ItemsNameField(),
+		}),
+	), &data); err != nil {
+		return err
+	}
+	return c.write(data.Item, []string{"ID", "NAME"}, [][]string{{data.Item.Id, data.Item.Name}})
+}
+
+func postUpdate(c *command, args []string) error {
+	fs := c.flags()
+	itemID := fs.Int("item", 0, "the item's unique identifier")
+	body := fs.String("body", "", "the update's text")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := requireInt(fs, "item", *itemID); err != nil {
+		return err
+	}
+	if err := requireString(fs, "body", *body); err != nil {
+		return err
+	}
+	var data struct {
+		Update struct {
+			ID   string `json:"id"`
+			Body string `json:"body"`
+		} `json:"create_update"`
+	}
+	if err := c.exec(monday.NewMutationPayload(
+		monday.Updates.Create(*itemID, *body, []monday.UpdatesField{
+			monday.UpdatesIDField(),
+			monday.UpdatesBodyField(),
+		}),
+	), &data); err != nil {
+		return err
+	}
+	return c.write(data.Update, []string{"ID", "BODY"}, [][]string{{data.Update.ID, data.Update.Body}})
+}
+
+// execFile sends a raw graphql document and prints the data of the response as json.
+func execFile(c *command, args []string) error {
+	fs := c.flags()
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New("exec: expected exactly one graphql file")
+	}
+	raw, err := ioutil.ReadFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	var data json.RawMessage
+	if err := c.exec(monday.NewRawPayload(string(raw)), &data); err != nil {
+		return err
+	}
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	enc := json.NewEncoder(c.out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
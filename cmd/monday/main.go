@@ -0,0 +1,116 @@
+// Command monday is a command-line interface to the Monday API.
+//
+// Usage:
+//
+//	monday [-config file] [-o table|json] <command> [flags]
+//
+// Commands:
+//
+//	boards                                        list the boards
+//	groups  -board ID                             list the groups of a board
+//	columns -board ID                             list the columns of a board
+//	items   -board ID [-group ID]                 list the items of a board
+//	create-item  -board ID -group ID -name NAME   create an item
+//	archive-item -item ID                         archive an item
+//	set-value -board ID -item ID -column TITLE -value VALUE
+//	                                              set a column value by column title
+//	post-update -item ID -body BODY               post an update on an item
+//	exec FILE                                     run a raw graphql file
+//
+// The access token is read from the MONDAY_API_TOKEN environment variable,
+// or from the "token" key of the json config file (default: $HOME/.config/monday/config.json).
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/di-wu/monday/pdq"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout, nil); err != nil {
+		fmt.Fprintln(os.Stderr, "monday:", err)
+		os.Exit(1)
+	}
+}
+
+type config struct {
+	Token string `json:"token"`
+}
+
+// run executes the command line, the http client is used to reach the api and defaults to http.DefaultClient.
+func run(args []string, w io.Writer, client *http.Client) error {
+	fs := flag.NewFlagSet("monday", flag.ContinueOnError)
+	configPath := fs.String("config", defaultConfigPath(), "path to the json config file")
+	output := fs.String("o", "table", "output format (table / json)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *output != "table" && *output != "json" {
+		return fmt.Errorf("unknown output format %q", *output)
+	}
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return errors.New("no command given")
+	}
+
+	token, err := readToken(*configPath)
+	if err != nil {
+		return err
+	}
+	cmd, ok := commands[fs.Arg(0)]
+	if !ok {
+		return fmt.Errorf("unknown command %q", fs.Arg(0))
+	}
+	c := &command{
+		name:   fs.Arg(0),
+		client: pdq.NewSimpleClient(token),
+		out:    w,
+		json:   *output == "json",
+	}
+	if client != nil {
+		c.client = pdq.NewSimpleClientWithHTTPClient(token, client)
+	}
+	return cmd(c, fs.Args()[1:])
+}
+
+func defaultConfigPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "monday", "config.json")
+}
+
+// readToken returns the access token from the environment, falling back to the config file.
+func readToken(path string) (string, error) {
+	if token, ok := os.LookupEnv("MONDAY_API_TOKEN"); ok && token != "" {
+		return token, nil
+	}
+	if path == "" {
+		return "", errors.New("no access token: set MONDAY_API_TOKEN or use a config file")
+	}
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", fmt.Errorf("no access token: set MONDAY_API_TOKEN or create %s", path)
+		}
+		return "", err
+	}
+	var cfg config
+	if err := json.Unmarshal(raw, &cfg); err != nil {
+		return "", fmt.Errorf("invalid config file %s: %v", path, err)
+	}
+	if cfg.Token == "" {
+		return "", fmt.Errorf("no token in config file %s", path)
+	}
+	return cfg.Token, nil
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/di-wu/monday"
+	"github.com/di-wu/monday/mondaytest"
+	"github.com/di-wu/monday/pdq"
+)
+
+func TestRun(t *testing.T) {
+	s := mondaytest.NewServer()
+	defer s.Close()
+	os.Setenv("MONDAY_API_TOKEN", "mondaytest")
+	defer os.Unsetenv("MONDAY_API_TOKEN")
+
+	c := pdq.NewSimpleClientWithHTTPClient("mondaytest", s.Client())
+	board, _ := c.CreateBoard("Board")
+	if _, err := c.CreateColumn(board.ID(), "Notes", monday.ColumnsTypeText()); err != nil {
+		t.Fatal(err)
+	}
+
+	cli := func(args ...string) string {
+		t.Helper()
+		var out bytes.Buffer
+		if err := run(args, &out, s.Client()); err != nil {
+			t.Fatalf("%v: %v", args, err)
+		}
+		return out.String()
+	}
+	boardID := strconv.Itoa(board.ID())
+
+	var item pdq.Item
+	if err := json.Unmarshal([]byte(cli("-o", "json", "create-item", "-board", boardID, "-group", "topics", "-name", "Task")), &item); err != nil {
+		t.Fatal(err)
+	}
+	cli("set-value", "-board", boardID, "-item", item.Id, "-column", "Notes", "-value", "hello")
+	if out := cli("items", "-board", boardID); !strings.Contains(out, "NOTES") || !strings.Contains(out, "hello") {
+		t.Errorf("unexpected items output:\n%s", out)
+	}
+	if out := cli("post-update", "-item", item.Id, "-body", "done"); !strings.Contains(out, "done") {
+		t.Errorf("unexpected update output:\n%s", out)
+	}
+
+	path := filepath.Join(t.TempDir(), "query.graphql")
+	if err := ioutil.WriteFile(path, []byte("query {\n  boards { name }\n}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if out := cli("exec", path); !strings.Contains(out, `"Board"`) {
+		t.Errorf("unexpected exec output:\n%s", out)
+	}
+
+	cli("archive-item", "-item", item.Id)
+	if out := cli("items", "-board", boardID); strings.Contains(out, "Task") {
+		t.Errorf("expected the item to be archived:\n%s", out)
+	}
+
+	var out bytes.Buffer
+	if err := run([]string{"archive-item", "-item", "1"}, &out, s.Client()); err == nil {
+		t.Error("expected an error for an unknown item")
+	}
+	if err := run([]string{"create-item", "-board", boardID, "-name", "Task"}, &out, s.Client()); err == nil ||
+		!strings.Contains(err.Error(), "missing required flag -group") {
+		t.Errorf("expected an error for the missing group, got %v", err)
+	}
+}
+
+func TestReadToken(t *testing.T) {
+	os.Unsetenv("MONDAY_API_TOKEN")
+	path := filepath.Join(t.TempDir(), "config.json")
+	if _, err := readToken(path); err == nil {
+		t.Error("expected an error for a missing config file")
+	}
+	if err := ioutil.WriteFile(path, []byte(`{"token":"secret"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if token, err := readToken(path); err != nil || token != "secret" {
+		t.Errorf("got %q (%v), expected secret", token, err)
+	}
+}
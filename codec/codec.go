@@ -0,0 +1,624 @@
+// Package codec maps Go structs to column values of monday items and back, in the spirit of encoding/json.
+//
+// Fields are mapped with the "monday" struct tag, which contains the column id and optionally a list of options:
+//
+//	type Ticket struct {
+//		ID     int       `monday:"id"`
+//		Name   string    `monday:"name"`
+//		Status string    `monday:"status,label"`
+//		Due    time.Time `monday:"date4,omitempty"`
+//		Tags   []int     `monday:"tags,tags"`
+//	}
+//
+// The special ids "name" and "id" map to the name and the identifier of the item, the identifier is never marshaled.
+// Fields without a tag, or with the tag "-", are ignored.
+//
+// The type of the field and its options decide which column value is used:
+//
+//	string     text (default), label (status), long_text, email, link, world_clock
+//	int        numbers (default), index (status), rating, person, team
+//	float      numbers
+//	bool       checkbox
+//	time.Time  date (default), hour
+//	[]string   label (dropdown)
+//	[]int      tags (default), dropdown, people
+//	[]People   people
+//
+// The omitempty option skips zero values when marshaling, nil pointers are always skipped.
+// The readonly option never marshals the field, it is meant for columns that can not be written like formulas
+// or the creation log; such fields are decoded from the text of the column.
+// Types can implement Marshaler and Unmarshaler to take care of their own encoding.
+package codec
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/di-wu/monday"
+)
+
+// Marshaler is implemented by types that convert themselves into a column value.
+type Marshaler interface {
+	MarshalColumnValue(id string) (monday.ColumnValue, error)
+}
+
+// Unmarshaler is implemented by types that decode themselves from a column value.
+// The text is the textual representation of the column, the value its json value (empty if the column is empty).
+type Unmarshaler interface {
+	UnmarshalColumnValue(text string, value []byte) error
+}
+
+var (
+	marshalerType   = reflect.TypeOf((*Marshaler)(nil)).Elem()
+	unmarshalerType = reflect.TypeOf((*Unmarshaler)(nil)).Elem()
+	timeType        = reflect.TypeOf(time.Time{})
+	peopleType      = reflect.TypeOf(monday.People{})
+)
+
+const (
+	dateFormat = "2006-01-02"
+	timeFormat = "15:04:05"
+)
+
+// field is a struct field that is mapped to a column.
+type field struct {
+	id        string
+	index     []int
+	options   map[string]bool
+	omitEmpty bool
+	name      string
+}
+
+func (f field) option(name string) bool {
+	return f.options[name]
+}
+
+// fields returns the mapped fields of the struct type.
+func fields(t reflect.Type) []field {
+	var fs []field
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag, ok := sf.Tag.Lookup("monday")
+		if !ok {
+			if sf.Anonymous && sf.Type.Kind() == reflect.Struct {
+				for _, f := range fields(sf.Type) {
+					f.index = append([]int{i}, f.index...)
+					fs = append(fs, f)
+				}
+			}
+			continue
+		}
+		if tag == "-" || sf.PkgPath != "" {
+			continue
+		}
+		parts := strings.Split(tag, ",")
+		f := field{
+			id:      parts[0],
+			index:   []int{i},
+			options: make(map[string]bool),
+			name:    sf.Name,
+		}
+		for _, option := range parts[1:] {
+			if option == "omitempty" {
+				f.omitEmpty = true
+				continue
+			}
+			f.options[option] = true
+		}
+		fs = append(fs, f)
+	}
+	return fs
+}
+
+func structValue(v interface{}) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return reflect.Value{}, errors.New("codec: nil value")
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("codec: expected a struct, got %s", rv.Type())
+	}
+	return rv, nil
+}
+
+// Marshal returns the column values of the tagged fields of the struct, including the name of the item.
+func Marshal(v interface{}) ([]monday.ColumnValue, error) {
+	rv, err := structValue(v)
+	if err != nil {
+		return nil, err
+	}
+	var values []monday.ColumnValue
+	for _, f := range fields(rv.Type()) {
+		if f.id == "id" || f.option("readonly") {
+			continue
+		}
+		fv := rv.FieldByIndex(f.index)
+		if f.omitEmpty && isZero(fv) {
+			continue
+		}
+		value, ok, err := marshal(f, fv)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			values = append(values, value)
+		}
+	}
+	return values, nil
+}
+
+// MarshalValues returns the column values of the struct as the json object expected by Columns.ChangeMultipleValues.
+func MarshalValues(v interface{}) (string, error) {
+	values, err := Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return monday.EncodeColumnValues(values), nil
+}
+
+// MarshalItem returns the name of the item separately from the other column values, as Items.Create expects.
+func MarshalItem(v interface{}) (string, []monday.ColumnValue, error) {
+	values, err := Marshal(v)
+	if err != nil {
+		return "", nil, err
+	}
+	var name string
+	var rest []monday.ColumnValue
+	for _, value := range values {
+		if value.ID() == "name" {
+			if err := json.Unmarshal([]byte(value.Value()), &name); err != nil {
+				return "", nil, err
+			}
+			continue
+		}
+		rest = append(rest, value)
+	}
+	return name, rest, nil
+}
+
+func isZero(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	}
+	if v.Type() == timeType {
+		return v.Interface().(time.Time).IsZero()
+	}
+	return reflect.DeepEqual(v.Interface(), reflect.Zero(v.Type()).Interface())
+}
+
+func marshal(f field, v reflect.Value) (monday.ColumnValue, bool, error) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return monday.ColumnValue{}, false, nil
+		}
+		if v.Type().Implements(marshalerType) {
+			value, err := v.Interface().(Marshaler).MarshalColumnValue(f.id)
+			return value, err == nil, err
+		}
+		v = v.Elem()
+	}
+	if v.Type().Implements(marshalerType) {
+		value, err := v.Interface().(Marshaler).MarshalColumnValue(f.id)
+		return value, err == nil, err
+	}
+	if v.CanAddr() && v.Addr().Type().Implements(marshalerType) {
+		value, err := v.Addr().Interface().(Marshaler).MarshalColumnValue(f.id)
+		return value, err == nil, err
+	}
+
+	id := f.id
+	switch v.Kind() {
+	case reflect.String:
+		s := v.String()
+		switch {
+		case id == "name":
+			return quoted(id, s), true, nil
+		case f.option("label"):
+			return monday.NewStatusLabelValue(id, s), true, nil
+		case f.option("long_text"):
+			return monday.NewLongTextValue(id, s), true, nil
+		case f.option("email"):
+			return monday.NewEmailValue(id, s, s), true, nil
+		case f.option("link"):
+			return monday.NewURLValue(id, s, s), true, nil
+		case f.option("world_clock"):
+			return monday.NewWorldClockValue(id, s), true, nil
+		}
+		return quoted(id, s), true, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n := int(v.Int())
+		switch {
+		case f.option("index"):
+			return monday.NewStatusIndexValue(id, n), true, nil
+		case f.option("rating"):
+			return monday.NewRatingValue(id, n), true, nil
+		case f.option("person"):
+			return monday.NewPersonValue(id, n), true, nil
+		case f.option("team"):
+			return monday.NewTeamValue(id, n), true, nil
+		}
+		return monday.NewNumberValue(id, n), true, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return quoted(id, strconv.FormatUint(v.Uint(), 10)), true, nil
+	case reflect.Float32, reflect.Float64:
+		return quoted(id, strconv.FormatFloat(v.Float(), 'f', -1, v.Type().Bits())), true, nil
+	case reflect.Bool:
+		return monday.NewCheckboxValue(id, v.Bool()), true, nil
+	case reflect.Struct:
+		if v.Type() == timeType {
+			t := v.Interface().(time.Time)
+			switch {
+			case t.IsZero():
+				return monday.NewJSONValue(id, "{}"), true, nil
+			case f.option("hour"):
+				return monday.NewHourValue(id, t), true, nil
+			}
+			return monday.NewDateValue(id, t), true, nil
+		}
+	case reflect.Slice:
+		switch elem := v.Type().Elem(); {
+		case elem.Kind() == reflect.String:
+			labels := make([]string, v.Len())
+			for i := range labels {
+				labels[i] = v.Index(i).String()
+			}
+			return monday.NewDropdownLabelValue(id, labels), true, nil
+		case elem.Kind() == reflect.Int:
+			ids := make([]int, v.Len())
+			for i := range ids {
+				ids[i] = int(v.Index(i).Int())
+			}
+			switch {
+			case f.option("dropdown"):
+				return monday.NewDropdownIndexValue(id, ids), true, nil
+			case f.option("people"):
+				people := make([]monday.People, len(ids))
+				for i, n := range ids {
+					people[i] = monday.People{ID: n, Kind: monday.PeopleKindPerson()}
+				}
+				return monday.NewPeopleValue(id, people), true, nil
+			}
+			return monday.NewTagsValue(id, ids), true, nil
+		case elem == peopleType:
+			return monday.NewPeopleValue(id, v.Interface().([]monday.People)), true, nil
+		}
+	}
+	return monday.ColumnValue{}, false, fmt.Errorf("codec: unsupported type %s for field %s", v.Type(), f.name)
+}
+
+// dropdownLabels returns the labels of a dropdown column from its json value, which holds either the names of the
+// labels (as they are marshaled) or their ids. The api only returns the names joined in the text, so the number of
+// ids decides how the text is split, labels that contain ", " are only kept if they can be told apart.
+func dropdownLabels(text string, raw []byte) ([]string, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	var value struct {
+		Labels []string
+		IDs    []int
+	}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, err
+	}
+	if value.Labels != nil {
+		return value.Labels, nil
+	}
+	switch len(value.IDs) {
+	case 0:
+		return nil, nil
+	case 1:
+		return []string{text}, nil
+	}
+	labels := strings.Split(text, ", ")
+	if len(labels) != len(value.IDs) {
+		return nil, fmt.Errorf("can not split %q into %d labels", text, len(value.IDs))
+	}
+	return labels, nil
+}
+
+// quoted returns a column value containing a json string.
+func quoted(id, s string) monday.ColumnValue {
+	raw, _ := json.Marshal(s)
+	return monday.NewJSONValue(id, string(raw))
+}
+
+// Value is a queried column value, as returned by the id, text and value fields of column_values.
+type Value struct {
+	ID   string `json:"id"`
+	Text string `json:"text"`
+	// Value is the json value of the column, encoded as a string by the api (nil if the column is empty).
+	Value *string `json:"value"`
+}
+
+// Unmarshal decodes a queried item into the struct pointed to by v.
+// The data is either a json item with (some of) the fields id, name and column_values, or only its column_values.
+func Unmarshal(data []byte, v interface{}) error {
+	var item struct {
+		ID           json.Number `json:"id"`
+		Name         *string     `json:"name"`
+		ColumnValues []Value     `json:"column_values"`
+	}
+	if trimmed := strings.TrimSpace(string(data)); strings.HasPrefix(trimmed, "[") {
+		if err := json.Unmarshal(data, &item.ColumnValues); err != nil {
+			return err
+		}
+	} else if err := json.Unmarshal(data, &item); err != nil {
+		return err
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return errors.New("codec: Unmarshal expects a non-nil pointer")
+	}
+	rv, err := structValue(v)
+	if err != nil {
+		return err
+	}
+	values := make(map[string]Value)
+	for _, value := range item.ColumnValues {
+		values[value.ID] = value
+	}
+	for _, f := range fields(rv.Type()) {
+		fv := rv.FieldByIndex(f.index)
+		switch {
+		case f.id == "id" && item.ID != "":
+			if err := unmarshal(f, fv, string(item.ID), nil); err != nil {
+				return err
+			}
+		case f.id == "name" && item.Name != nil:
+			raw, _ := json.Marshal(*item.Name)
+			if err := unmarshal(f, fv, *item.Name, raw); err != nil {
+				return err
+			}
+		default:
+			value, ok := values[f.id]
+			if !ok {
+				continue
+			}
+			var raw []byte
+			if value.Value != nil && *value.Value != "" && *value.Value != "null" {
+				raw = []byte(*value.Value)
+			}
+			if err := unmarshal(f, fv, value.Text, raw); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func unmarshal(f field, v reflect.Value, text string, raw []byte) error {
+	if v.Kind() == reflect.Ptr {
+		if raw == nil && text == "" {
+			v.Set(reflect.Zero(v.Type()))
+			return nil
+		}
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		if v.Type().Implements(unmarshalerType) {
+			return v.Interface().(Unmarshaler).UnmarshalColumnValue(text, raw)
+		}
+		v = v.Elem()
+	}
+	if v.CanAddr() && v.Addr().Type().Implements(unmarshalerType) {
+		return v.Addr().Interface().(Unmarshaler).UnmarshalColumnValue(text, raw)
+	}
+	invalid := func(err error) error {
+		return fmt.Errorf("codec: can not decode column %s into field %s: %v", f.id, f.name, err)
+	}
+	// object decodes the json value of the column, if any.
+	object := func(dst interface{}) (bool, error) {
+		if raw == nil {
+			return false, nil
+		}
+		if err := json.Unmarshal(raw, dst); err != nil {
+			return false, invalid(err)
+		}
+		return true, nil
+	}
+
+	if f.option("readonly") && v.Kind() == reflect.String {
+		v.SetString(text)
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		switch {
+		case f.option("email"):
+			var value struct{ Email string }
+			if ok, err := object(&value); ok || err != nil {
+				v.SetString(value.Email)
+				return err
+			}
+		case f.option("link"):
+			var value struct{ URL string }
+			if ok, err := object(&value); ok || err != nil {
+				v.SetString(value.URL)
+				return err
+			}
+		case f.option("world_clock"):
+			var value struct{ Timezone string }
+			if ok, err := object(&value); ok || err != nil {
+				v.SetString(value.Timezone)
+				return err
+			}
+		}
+		v.SetString(text)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var n int64
+		switch {
+		case f.option("index"):
+			var value struct{ Index int64 }
+			if _, err := object(&value); err != nil {
+				return err
+			}
+			n = value.Index
+		case f.option("rating"):
+			var value struct{ Rating int64 }
+			if _, err := object(&value); err != nil {
+				return err
+			}
+			n = value.Rating
+		case f.option("person"):
+			var value struct {
+				ID              int64
+				PersonsAndTeams []struct{ ID int64 }
+			}
+			if _, err := object(&value); err != nil {
+				return err
+			}
+			n = value.ID
+			if len(value.PersonsAndTeams) != 0 {
+				n = value.PersonsAndTeams[0].ID
+			}
+		case f.option("team"):
+			var value struct {
+				TeamID int64 `json:"team_id"`
+			}
+			if _, err := object(&value); err != nil {
+				return err
+			}
+			n = value.TeamID
+		default:
+			if text == "" {
+				break
+			}
+			number, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return invalid(err)
+			}
+			n = int64(number)
+		}
+		v.SetInt(n)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var n uint64
+		if text != "" {
+			var err error
+			if n, err = strconv.ParseUint(text, 10, 64); err != nil {
+				return invalid(err)
+			}
+		}
+		v.SetUint(n)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		var n float64
+		if text != "" {
+			var err error
+			if n, err = strconv.ParseFloat(text, v.Type().Bits()); err != nil {
+				return invalid(err)
+			}
+		}
+		v.SetFloat(n)
+		return nil
+	case reflect.Bool:
+		var value struct {
+			Checked interface{}
+		}
+		if _, err := object(&value); err != nil {
+			return err
+		}
+		v.SetBool(value.Checked == true || value.Checked == "true")
+		return nil
+	case reflect.Struct:
+		if v.Type() != timeType {
+			break
+		}
+		var t time.Time
+		if f.option("hour") {
+			var value struct{ Hour, Minute int }
+			if ok, err := object(&value); err != nil {
+				return err
+			} else if ok {
+				t = time.Date(0, 1, 1, value.Hour, value.Minute, 0, 0, time.UTC)
+			}
+		} else {
+			var value struct{ Date, Time string }
+			if ok, err := object(&value); err != nil {
+				return err
+			} else if ok && value.Date != "" {
+				var err error
+				if value.Time != "" {
+					t, err = time.Parse(dateFormat+" "+timeFormat, value.Date+" "+value.Time)
+				} else {
+					t, err = time.Parse(dateFormat, value.Date)
+				}
+				if err != nil {
+					return invalid(err)
+				}
+			}
+		}
+		v.Set(reflect.ValueOf(t))
+		return nil
+	case reflect.Slice:
+		switch elem := v.Type().Elem(); {
+		case elem.Kind() == reflect.String:
+			labels, err := dropdownLabels(text, raw)
+			if err != nil {
+				return invalid(err)
+			}
+			v.Set(reflect.Zero(v.Type()))
+			for _, label := range labels {
+				v.Set(reflect.Append(v, reflect.ValueOf(label).Convert(elem)))
+			}
+			return nil
+		case elem.Kind() == reflect.Int:
+			var value struct {
+				TagIDs          []int `json:"tag_ids"`
+				IDs             []int
+				PersonsAndTeams []struct{ ID int }
+			}
+			if _, err := object(&value); err != nil {
+				return err
+			}
+			ids := value.TagIDs
+			if len(ids) == 0 {
+				ids = value.IDs
+			}
+			if len(ids) == 0 {
+				for _, p := range value.PersonsAndTeams {
+					ids = append(ids, p.ID)
+				}
+			}
+			v.Set(reflect.Zero(v.Type()))
+			for _, id := range ids {
+				v.Set(reflect.Append(v, reflect.ValueOf(id).Convert(elem)))
+			}
+			return nil
+		case elem == peopleType:
+			var value struct {
+				PersonsAndTeams []struct {
+					ID   int
+					Kind string
+				}
+			}
+			if _, err := object(&value); err != nil {
+				return err
+			}
+			var people []monday.People
+			for _, p := range value.PersonsAndTeams {
+				kind := monday.PeopleKindPerson()
+				if p.Kind == "team" {
+					kind = monday.PeopleKindTeam()
+				}
+				people = append(people, monday.People{ID: p.ID, Kind: kind})
+			}
+			v.Set(reflect.ValueOf(people))
+			return nil
+		}
+	}
+	return fmt.Errorf("codec: unsupported type %s for field %s", v.Type(), f.name)
+}
@@ -0,0 +1,188 @@
+package codec
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/di-wu/monday"
+	"github.com/di-wu/monday/mondaytest"
+)
+
+type ticket struct {
+	ID       int       `monday:"id"`
+	Name     string    `monday:"name"`
+	Status   string    `monday:"status,label"`
+	Priority int       `monday:"status1,index"`
+	Notes    string    `monday:"text"`
+	Estimate float64   `monday:"numbers"`
+	Done     bool      `monday:"checkbox"`
+	Due      time.Time `monday:"date,omitempty"`
+	Labels   []string  `monday:"dropdown"`
+	Owner    *int      `monday:"people,person"`
+	Ignored  string
+}
+
+func TestMarshal(t *testing.T) {
+	values, err := Marshal(ticket{
+		Name:     `say "hi"`,
+		Status:   "Done",
+		Priority: 2,
+		Estimate: 1.5,
+		Labels:   []string{"a", "b"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make(map[string]string)
+	for _, v := range values {
+		got[v.ID()] = v.Value()
+	}
+	expected := map[string]string{
+		"name":     `"say \"hi\""`,
+		"status":   `{"label":"Done"}`,
+		"status1":  `{"index":2}`,
+		"text":     `""`,
+		"numbers":  `"1.5"`,
+		"checkbox": `{"checked":"false"}`,
+		"dropdown": `{"labels":["a","b"]}`,
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+
+	if values, err := Marshal(struct {
+		Ratio float32 `monday:"numbers"`
+	}{0.1}); err != nil || len(values) != 1 || values[0].Value() != `"0.1"` {
+		t.Errorf("unexpected values %v: %v", values, err)
+	}
+
+	if _, err := Marshal(struct {
+		Value map[string]string `monday:"text"`
+	}{}); err == nil {
+		t.Error("expected an error for an unsupported type")
+	}
+}
+
+func TestUnmarshalLabels(t *testing.T) {
+	for _, test := range []struct {
+		text, value string
+		labels      []string
+	}{
+		{"", "null", nil},
+		{"a, b", `{"ids":[1]}`, []string{"a, b"}},
+		{"a, b", `{"ids":[1,2]}`, []string{"a", "b"}},
+		{"", `{"labels":["a, b","c"]}`, []string{"a, b", "c"}},
+	} {
+		var v struct {
+			Labels []string `monday:"dropdown"`
+		}
+		data, _ := json.Marshal([]Value{{ID: "dropdown", Text: test.text, Value: &test.value}})
+		if err := Unmarshal(data, &v); err != nil || !reflect.DeepEqual(v.Labels, test.labels) {
+			t.Errorf("%s: got %q, %v, expected %q", test.value, v.Labels, err, test.labels)
+		}
+	}
+
+	var v struct {
+		Labels []string `monday:"dropdown"`
+	}
+	value := `{"ids":[1,2]}`
+	data, _ := json.Marshal([]Value{{ID: "dropdown", Text: "a, b, c", Value: &value}})
+	if err := Unmarshal(data, &v); err == nil {
+		t.Errorf("expected an error for ambiguous labels, got %q", v.Labels)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	s := mondaytest.NewServer()
+	defer s.Close()
+	c := s.NewClient()
+	ctx := context.Background()
+
+	exec := func(payload monday.Payload, v interface{}) {
+		t.Helper()
+		resp, err := c.Exec(ctx, payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		raw, _ := ioutil.ReadAll(resp.Body)
+		var body struct {
+			Data json.RawMessage
+		}
+		if err := json.Unmarshal(raw, &body); err != nil || body.Data == nil {
+			t.Fatalf("unexpected response %s", raw)
+		}
+		if err := json.Unmarshal(body.Data, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var board struct {
+		Board struct{ ID string } `json:"create_board"`
+	}
+	exec(monday.NewMutationPayload(monday.Boards.Create("Tickets", monday.BoardsKindPublic(), nil)), &board)
+	boardID, _ := json.Number(board.Board.ID).Int64()
+	for _, col := range []struct {
+		typ      monday.ColumnsType
+		defaults string
+	}{
+		{monday.ColumnsTypeStatus(), `{"labels":["Open","Done"]}`},
+		{monday.ColumnsTypeStatus(), ""},
+		{monday.ColumnsTypeText(), ""},
+		{monday.ColumnsTypeNumbers(), ""},
+		{monday.ColumnsTypeCheckBox(), ""},
+		{monday.ColumnsTypeDate(), ""},
+		{monday.ColumnsTypeDropdown(), `{"labels":["a","b","c"]}`},
+		{monday.ColumnsTypePeople(), ""},
+	} {
+		var v interface{}
+		exec(monday.NewMutationPayload(monday.Columns.CreateWithDefaults(int(boardID), col.typ.String(), col.typ, col.defaults, nil)), &v)
+	}
+
+	owner := 1001
+	in := ticket{
+		Name:     "Broken build",
+		Status:   "Done",
+		Priority: 2,
+		Notes:    "see logs",
+		Estimate: 1.5,
+		Done:     true,
+		Due:      time.Date(2020, 5, 4, 12, 30, 0, 0, time.UTC),
+		Labels:   []string{"a", "c"},
+		Owner:    &owner,
+	}
+	name, values, err := MarshalItem(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var created struct {
+		Item struct{ ID string } `json:"create_item"`
+	}
+	exec(monday.NewMutationPayload(monday.Items.Create(int(boardID), "topics", name, values, nil)), &created)
+	id, _ := json.Number(created.Item.ID).Int64()
+
+	var data struct {
+		Items []json.RawMessage
+	}
+	exec(monday.NewQueryPayload(monday.Items.List([]monday.ItemsField{
+		monday.ItemsIDField(),
+		monday.ItemsNameField(),
+		monday.NewItemsColumnValuesField([]monday.ColumnValuesField{
+			monday.ColumnValuesIDField(),
+			monday.ColumnValuesTextField(),
+			monday.ColumnValuesValueField(),
+		}, nil),
+	}, monday.NewItemsIDsArgument([]int{int(id)}))), &data)
+
+	var out ticket
+	if err := Unmarshal(data.Items[0], &out); err != nil {
+		t.Fatal(err)
+	}
+	in.ID = int(id)
+	if !reflect.DeepEqual(in, out) {
+		t.Errorf("got %+v, expected %+v", out, in)
+	}
+}
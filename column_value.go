@@ -23,6 +23,16 @@ func (v ColumnValue) Value() string {
 	return v.value
 }
 
+// EncodeColumnValues encodes the column values as a single json object keyed by column id, as expected by the
+// column_values arguments of Items.Create and Columns.ChangeMultipleValues.
+func EncodeColumnValues(values []ColumnValue) string {
+	var columnValues []string
+	for _, v := range values {
+		columnValues = append(columnValues, fmt.Sprintf(`%q:%s`, v.id, v.value))
+	}
+	return fmt.Sprintf(`{%s}`, strings.Join(columnValues, ","))
+}
+
 func addQuotes(id string, value interface{}) ColumnValue {
 	return ColumnValue{id, fmt.Sprintf(`"%v"`, value)}
 }
@@ -35,6 +45,11 @@ func newLabel(id, value string) ColumnValue {
 	return ColumnValue{id, fmt.Sprintf(`{"label":%q}`, value)}
 }
 
+// To update any column, send its json value as returned by the value field of its column value.
+func NewJSONValue(id, value string) ColumnValue {
+	return ColumnValue{id, value}
+}
+
 // To update the item's name, send a string of 1 to 255 characters.
 func NewItemNameValue(id, value string) ColumnValue {
 	return addQuotes(id, value)
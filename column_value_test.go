@@ -118,6 +118,16 @@ func TestColumnValue(t *testing.T) {
 	}
 }
 
+func TestEncodeColumnValues(t *testing.T) {
+	if str := EncodeColumnValues(nil); str != `{}` {
+		t.Errorf("got: %s, expected: {}", str)
+	}
+	str := EncodeColumnValues([]ColumnValue{NewTextValue("text", "a"), NewStatusIndexValue("status", 1)})
+	if expected := `{"text":"a","status":{"index":1}}`; str != expected {
+		t.Errorf("got: %s, expected: %s", str, expected)
+	}
+}
+
 func mustParse(layout, value string) time.Time {
 	t, _ := time.Parse(layout, value)
 	return t
@@ -68,3 +68,22 @@ type ColumnValuesArgument struct {
 func NewColumnValuesIDsArgument(ids []string) ColumnValuesArgument {
 	return ColumnValuesArgument{argument{"ids", ids}}
 }
+
+// The column value's graphql fragment, a named selection of column value's fields that is defined once per payload.
+type ColumnValuesFragment struct {
+	fragment Fragment
+}
+
+// NewColumnValuesFragment returns the fragment with the given name of the column value's fields.
+func NewColumnValuesFragment(name string, valuesFields []ColumnValuesField) ColumnValuesFragment {
+	var fields []field
+	for _, f := range valuesFields {
+		fields = append(fields, f.field)
+	}
+	return ColumnValuesFragment{Fragment{name: name, on: "ColumnValue", fields: fields}}
+}
+
+// Spreads the fragment of the column value's fields.
+func ColumnValuesFragmentField(fragment ColumnValuesFragment) ColumnValuesField {
+	return ColumnValuesField{fragment.fragment.spread()}
+}
@@ -23,7 +23,7 @@ func (*ColumnsService) Create(id int, title string, columnType ColumnsType, colu
 		args: []argument{
 			{"board_id", id},
 			{"title", title},
-			{"column_type", columnType.typ},
+			{"column_type", columnType},
 		},
 	}
 }
@@ -107,6 +107,88 @@ func (*ColumnsService) ChangeMultipleValues(itemID int, boardID int, values stri
 	}
 }
 
+// ChangeMetadata returns a mutation that allows you to change the title or description of a column.
+// - boardID: the board's unique identifier.
+// - columnID: the column's unique identifier.
+// - property: the column's property to change.
+// - value: the new value of the property.
+//
+// DOCS: https://monday.com/developers/v2#mutations-section-columns-change-column-metadata
+func (*ColumnsService) ChangeMetadata(boardID int, columnID string, property ColumnsProperty, value string,
+	columnsFields []ColumnsField) Mutation {
+	if len(columnsFields) == 0 {
+		columnsFields = append(columnsFields, columnsIDField)
+	}
+
+	var fields []field
+	for _, cf := range columnsFields {
+		fields = append(fields, cf.field)
+	}
+	return Mutation{
+		name:   "change_column_metadata",
+		fields: fields,
+		args: []argument{
+			{"board_id", boardID},
+			{"column_id", columnID},
+			{"column_property", property},
+			{"value", value},
+		},
+	}
+}
+
+// Update returns a mutation that replaces the settings of a column, e.g. the labels of a status or dropdown column.
+// - boardID: the board's unique identifier.
+// - columnID: the column's unique identifier.
+// - columnType: the column's type.
+// - settings: the column's new settings in a string form.
+//
+// DOCS: https://developer.monday.com/api-reference/reference/columns#update-a-column
+func (*ColumnsService) Update(boardID int, columnID string, columnType ColumnsType, settings string,
+	columnsFields []ColumnsField) Mutation {
+	if len(columnsFields) == 0 {
+		columnsFields = append(columnsFields, columnsIDField)
+	}
+
+	var fields []field
+	for _, cf := range columnsFields {
+		fields = append(fields, cf.field)
+	}
+	return Mutation{
+		name:   "update_column",
+		fields: fields,
+		args: []argument{
+			{"board_id", boardID},
+			{"id", columnID},
+			{"column_type", columnType},
+			{"settings", settings},
+		},
+	}
+}
+
+// Delete returns a mutation that allows you to delete a column from a board.
+// - boardID: the board's unique identifier.
+// - columnID: the column's unique identifier.
+//
+// DOCS: https://monday.com/developers/v2#mutations-section-columns-delete
+func (*ColumnsService) Delete(boardID int, columnID string, columnsFields []ColumnsField) Mutation {
+	if len(columnsFields) == 0 {
+		columnsFields = append(columnsFields, columnsIDField)
+	}
+
+	var fields []field
+	for _, cf := range columnsFields {
+		fields = append(fields, cf.field)
+	}
+	return Mutation{
+		name:   "delete_column",
+		fields: fields,
+		args: []argument{
+			{"board_id", boardID},
+			{"column_id", columnID},
+		},
+	}
+}
+
 // List returns a query that searches for items based on their column values and returns data about those specific items.
 //
 // DOCS: https://monday.com/developers/v2#queries-section-items-by-column-values
@@ -137,6 +219,7 @@ type ColumnsField struct {
 
 var (
 	columnsArchivedField    = ColumnsField{field{"archived", nil}}
+	columnsDescriptionField = ColumnsField{field{"description", nil}}
 	columnsIDField          = ColumnsField{field{"id", nil}}
 	columnsSettingsStrField = ColumnsField{field{"settings_str", nil}}
 	columnsTitleField       = ColumnsField{field{"title", nil}}
@@ -149,6 +232,11 @@ func ColumnsArchivedField() ColumnsField {
 	return columnsArchivedField
 }
 
+// The column's description.
+func ColumnsDescriptionField() ColumnsField {
+	return columnsDescriptionField
+}
+
 // The column's unique identifier.
 func ColumnsIDField() ColumnsField {
 	return columnsIDField
@@ -179,6 +267,11 @@ type ColumnsType struct {
 	typ string
 }
 
+// EnumValue returns the value as it appears in a query.
+func (t ColumnsType) EnumValue() string {
+	return t.typ
+}
+
 var (
 	columnsTypeAutoNumber   = ColumnsType{"auto_number"}
 	columnsTypeCheckbox     = ColumnsType{"checkbox"}
@@ -210,6 +303,11 @@ var (
 	columnsTypeWorldClock   = ColumnsType{"world_clock"}
 )
 
+// String returns the name of the column type, e.g. status.
+func (t ColumnsType) String() string {
+	return t.typ
+}
+
 // Number items according to their order in the group/board.
 func ColumnsTypeAutoNumber() ColumnsType {
 	return columnsTypeAutoNumber
@@ -349,3 +447,47 @@ func ColumnsTypeWeek() ColumnsType {
 func ColumnsTypeWorldClock() ColumnsType {
 	return columnsTypeWorldClock
 }
+
+// The column's property that can be changed.
+type ColumnsProperty struct {
+	property string
+}
+
+// EnumValue returns the value as it appears in a query.
+func (p ColumnsProperty) EnumValue() string {
+	return p.property
+}
+
+var (
+	columnsPropertyTitle       = ColumnsProperty{"title"}
+	columnsPropertyDescription = ColumnsProperty{"description"}
+)
+
+// The column's title.
+func ColumnsPropertyTitle() ColumnsProperty {
+	return columnsPropertyTitle
+}
+
+// The column's description.
+func ColumnsPropertyDescription() ColumnsProperty {
+	return columnsPropertyDescription
+}
+
+// The column's graphql fragment, a named selection of column's fields that is defined once per payload.
+type ColumnsFragment struct {
+	fragment Fragment
+}
+
+// NewColumnsFragment returns the fragment with the given name of the column's fields.
+func NewColumnsFragment(name string, columnsFields []ColumnsField) ColumnsFragment {
+	var fields []field
+	for _, f := range columnsFields {
+		fields = append(fields, f.field)
+	}
+	return ColumnsFragment{Fragment{name: name, on: "Column", fields: fields}}
+}
+
+// Spreads the fragment of the column's fields.
+func ColumnsFragmentField(fragment ColumnsFragment) ColumnsField {
+	return ColumnsField{fragment.fragment.spread()}
+}
@@ -0,0 +1,129 @@
+package monday
+
+import (
+	"context"
+	"fmt"
+)
+
+// maxMerges is the number of times ChangeValueIf merges conflicting values before it gives up.
+const maxMerges = 3
+
+// ColumnVersion is the version of the value of a column of an item, see Client.ChangeValueIf.
+type ColumnVersion struct {
+	// UpdatedAt is the time the item was last updated, as returned by the api.
+	UpdatedAt string
+	// Value is the json value of the column, as returned by the value field of its column value.
+	// The value of an empty column is nil.
+	Value *string
+}
+
+// Equal returns whether the versions are the same.
+func (v ColumnVersion) Equal(other ColumnVersion) bool {
+	if v.UpdatedAt != other.UpdatedAt || (v.Value == nil) != (other.Value == nil) {
+		return false
+	}
+	return v.Value == nil || *v.Value == *other.Value
+}
+
+// ConflictError is returned by Client.ChangeValueIf if the column changed since the expected version.
+type ConflictError struct {
+	ItemID   int
+	ColumnID string
+	// Expected is the version that the change expected.
+	Expected ColumnVersion
+	// Current is the fresh version of the column.
+	Current ColumnVersion
+}
+
+func (e ConflictError) Error() string {
+	return fmt.Sprintf("monday: column %s of item %d changed at %s", e.ColumnID, e.ItemID, e.Current.UpdatedAt)
+}
+
+// MergeFunc merges the value that conflicted with the current version of its column, after a ConflictError.
+// It returns the value to set instead, or an error to give up.
+type MergeFunc func(current ColumnVersion, value ColumnValue) (ColumnValue, error)
+
+// ColumnVersion returns the current version of the column of the item.
+func (c *Client) ColumnVersion(ctx context.Context, itemID int, columnID string) (ColumnVersion, error) {
+	var data struct {
+		Items []columnVersionItem
+	}
+	if err := c.Do(ctx, NewQueryPayload(
+		Items.List(columnVersionFields(columnID), NewItemsIDsArgument([]int{itemID})),
+	), &data); err != nil {
+		return ColumnVersion{}, err
+	}
+	if len(data.Items) == 0 {
+		return ColumnVersion{}, fmt.Errorf("monday: item %d does not exist", itemID)
+	}
+	return data.Items[0].version(columnID), nil
+}
+
+// ChangeValueIf changes the value of the column of the item, like Columns.ChangeValue, but only if the column still
+// has the expected version (see Client.ColumnVersion). It returns the version of the changed column.
+//
+// If the column has another version, it returns a ConflictError with the current version. If merge is not nil,
+// it is called with the current version instead, and the merged value is changed if the column still has the
+// current version, up to 3 times.
+//
+// The api can not change values conditionally, so the version is checked right before the change. This narrows
+// the window in which changes are overwritten, but does not close it.
+func (c *Client) ChangeValueIf(ctx context.Context, itemID, boardID int, value ColumnValue, expected ColumnVersion, merge MergeFunc) (ColumnVersion, error) {
+	for merges := 0; ; merges++ {
+		current, err := c.ColumnVersion(ctx, itemID, value.id)
+		if err != nil {
+			return ColumnVersion{}, err
+		}
+		if !current.Equal(expected) {
+			conflict := ConflictError{ItemID: itemID, ColumnID: value.id, Expected: expected, Current: current}
+			if merge == nil || merges == maxMerges {
+				return ColumnVersion{}, conflict
+			}
+			if value, err = merge(current, value); err != nil {
+				return ColumnVersion{}, err
+			}
+			expected = current
+			continue
+		}
+
+		var data struct {
+			Item columnVersionItem `json:"change_column_value"`
+		}
+		if err := c.Do(ctx, NewMutationPayload(
+			Columns.ChangeValue(itemID, value.id, boardID, value, columnVersionFields(value.id)),
+		), &data); err != nil {
+			return ColumnVersion{}, err
+		}
+		return data.Item.version(value.id), nil
+	}
+}
+
+// columnVersionItem is an item with the fields of columnVersionFields.
+type columnVersionItem struct {
+	UpdatedAt    string `json:"updated_at"`
+	ColumnValues []struct {
+		ID    string
+		Value *string
+	} `json:"column_values"`
+}
+
+func (i columnVersionItem) version(columnID string) ColumnVersion {
+	version := ColumnVersion{UpdatedAt: i.UpdatedAt}
+	for _, value := range i.ColumnValues {
+		if value.ID == columnID {
+			version.Value = value.Value
+		}
+	}
+	return version
+}
+
+// columnVersionFields are the fields of an item that make up the version of the column.
+func columnVersionFields(columnID string) []ItemsField {
+	return []ItemsField{
+		ItemsUpdatedAtField(),
+		NewItemsColumnValuesField(
+			[]ColumnValuesField{ColumnValuesIDField(), ColumnValuesValueField()},
+			[]ColumnValuesArgument{NewColumnValuesIDsArgument([]string{columnID})},
+		),
+	}
+}
@@ -0,0 +1,74 @@
+package monday
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestChangeValueIf(t *testing.T) {
+	version := func(updatedAt, value string) string {
+		raw, _ := json.Marshal(value)
+		return `{"updated_at":"` + updatedAt + `","column_values":[{"id":"text","value":` + string(raw) + `}]}`
+	}
+	transport := &sequenceTransport{responses: []string{
+		`{"data":{"items":[` + version("t1", `"a"`) + `]}}`,
+		`{"data":{"items":[` + version("t1", `"a"`) + `]}}`,
+		`{"data":{"change_column_value":` + version("t2", `"b"`) + `}}`,
+	}}
+	c := NewClient("token", &http.Client{Transport: transport})
+	ctx := context.Background()
+
+	expected, err := c.ColumnVersion(ctx, 1, "text")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected.UpdatedAt != "t1" || *expected.Value != `"a"` {
+		t.Fatalf("unexpected version %+v", expected)
+	}
+	changed, err := c.ChangeValueIf(ctx, 1, 2, NewTextValue("text", "b"), expected, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed.UpdatedAt != "t2" || *changed.Value != `"b"` {
+		t.Errorf("unexpected version %+v", changed)
+	}
+
+	transport.documents = nil
+	transport.responses = []string{`{"data":{"items":[` + version("t3", `"c"`) + `]}}`}
+	_, err = c.ChangeValueIf(ctx, 1, 2, NewTextValue("text", "d"), changed, nil)
+	conflict, ok := err.(ConflictError)
+	if !ok || conflict.Current.UpdatedAt != "t3" || *conflict.Current.Value != `"c"` || !conflict.Expected.Equal(changed) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if len(transport.documents) != 1 {
+		t.Errorf("the value is changed: %v", transport.documents)
+	}
+
+	transport.documents = nil
+	transport.responses = []string{
+		`{"data":{"items":[` + version("t3", `"c"`) + `]}}`,
+		`{"data":{"items":[` + version("t3", `"c"`) + `]}}`,
+		`{"data":{"change_column_value":` + version("t4", `"c d"`) + `}}`,
+	}
+	merged, err := c.ChangeValueIf(ctx, 1, 2, NewTextValue("text", "d"), changed, func(current ColumnVersion, value ColumnValue) (ColumnValue, error) {
+		var text string
+		if err := json.Unmarshal([]byte(*current.Value), &text); err != nil {
+			return ColumnValue{}, err
+		}
+		return NewTextValue("text", text+" d"), nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if merged.UpdatedAt != "t4" || !strings.Contains(transport.documents[2], `value:"\"c d\""`) {
+		t.Errorf("unexpected version %+v: %v", merged, transport.documents)
+	}
+
+	transport.responses = []string{`{"data":{"items":[]}}`}
+	if _, err := c.ChangeValueIf(ctx, 1, 2, NewTextValue("text", "d"), changed, nil); err == nil {
+		t.Error("expected an error for an unknown item")
+	}
+}
@@ -0,0 +1,224 @@
+package monday
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DryRun records the mutations that a client plans, instead of sending them, see Client.DryRun.
+type DryRun struct {
+	mu        sync.Mutex
+	mutations []PlannedMutation
+}
+
+// PlannedMutation is a mutation that is captured by a dry run.
+type PlannedMutation struct {
+	// ID is the synthetic identifier returned by the mutation. The identifiers are negative, so they can be
+	// recognized when later mutations use them, e.g. to change the values of a planned item.
+	ID int
+	// Name is the name of the mutation, e.g. create_item.
+	Name string
+	// Arguments are the arguments of the mutation, with their values as graphql literals.
+	Arguments []PlannedArgument
+	// BoardID is the board that the mutation targets, or 0 if it does not name one.
+	BoardID int
+	// ItemID is the item that the mutation targets, or 0 if it does not name one.
+	ItemID int
+}
+
+// PlannedArgument is an argument of a planned mutation.
+type PlannedArgument struct {
+	Name  string
+	Value string
+}
+
+// String returns the mutation as a line of a plan, e.g. create_item on board 1 (-1).
+func (m PlannedMutation) String() string {
+	var targets []string
+	if m.BoardID != 0 {
+		targets = append(targets, fmt.Sprintf("board %d", m.BoardID))
+	}
+	if m.ItemID != 0 {
+		targets = append(targets, fmt.Sprintf("item %d", m.ItemID))
+	}
+	line := m.Name
+	if len(targets) != 0 {
+		line += " on " + strings.Join(targets, ", ")
+	}
+	return fmt.Sprintf("%s (%d)", line, m.ID)
+}
+
+// DryRun makes the client execute its queries, but capture its mutations instead of sending them.
+// The captured mutations return synthetic identifiers in the fields named id, their other fields are null.
+// The returned dry run lists the planned mutations, so they can be reviewed before they are executed.
+//
+// The dry run is an interceptor that is appended to the chain of the client, interceptors that are used after
+// calling DryRun do not see the mutations. Raw documents are parsed to find their mutations.
+func (c *Client) DryRun() *DryRun {
+	d := new(DryRun)
+	c.Use(d.intercept)
+	return d
+}
+
+// Plan returns the mutations that are planned so far, in the order they were executed.
+func (d *DryRun) Plan() []PlannedMutation {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]PlannedMutation(nil), d.mutations...)
+}
+
+// String returns the plan in a human-readable form, one mutation per line followed by its arguments.
+func (d *DryRun) String() string {
+	var b strings.Builder
+	for i, m := range d.Plan() {
+		fmt.Fprintf(&b, "%d. %s\n", i+1, m)
+		for _, a := range m.Arguments {
+			fmt.Fprintf(&b, "   %s: %s\n", a.Name, a.Value)
+		}
+	}
+	return b.String()
+}
+
+func (d *DryRun) intercept(ctx context.Context, call *Call, next Handler) (*http.Response, error) {
+	payload := call.Payload
+	if payload.raw != "" {
+		raw, err := ParseDocument(payload.raw, nil)
+		if err != nil {
+			return nil, fmt.Errorf("monday: raw document can not be planned: %w", err)
+		}
+		if len(raw.mutations) == 0 {
+			raw = Payload{raw: payload.raw}
+		}
+		payload = Payload{
+			queries:   append(raw.queries, payload.queries...),
+			mutations: append(raw.mutations, payload.mutations...),
+			raw:       raw.raw,
+		}
+	}
+	if len(payload.mutations) == 0 {
+		return next(ctx, call)
+	}
+
+	data := make(map[string]json.RawMessage)
+	for _, m := range payload.mutations {
+		data[m.key()] = d.plan(m)
+	}
+
+	body := map[string]json.RawMessage{}
+	resp := &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Request:    call.Request,
+	}
+	payload.mutations = nil
+	if len(payload.queries) != 0 || payload.raw != "" {
+		documents, err := payload.documents()
+		if err != nil {
+			return nil, err
+		}
+		queries := *call
+		queries.Payload, queries.Documents = payload, documents
+		queries.Request = withDocuments(call.Request, documents)
+		if resp, err = next(ctx, &queries); err != nil {
+			return nil, err
+		}
+		raw, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(raw, &body); err != nil {
+			resp.Body = ioutil.NopCloser(bytes.NewReader(raw))
+			return resp, nil
+		}
+		// the data of the queries is merged with the results of the mutations.
+		if len(body["data"]) != 0 && string(body["data"]) != "null" {
+			if err := json.Unmarshal(body["data"], &data); err != nil {
+				return nil, err
+			}
+		}
+	}
+	var err error
+	if body["data"], err = json.Marshal(data); err != nil {
+		return nil, err
+	}
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = int64(len(raw))
+	resp.Body = ioutil.NopCloser(bytes.NewReader(raw))
+	return resp, nil
+}
+
+// plan records the mutation and returns its synthetic result.
+func (d *DryRun) plan(m Mutation) json.RawMessage {
+	planned := PlannedMutation{Name: m.name}
+	planned.BoardID, _ = m.intArgument("board_id")
+	if planned.ItemID, _ = m.intArgument("item_id"); planned.ItemID == 0 {
+		planned.ItemID, _ = m.intArgument("parent_item_id")
+	}
+	for _, a := range m.args {
+		// the documents of the call are written already, so the values can be encoded.
+		value, _ := encodeValue(a.value)
+		planned.Arguments = append(planned.Arguments, PlannedArgument{a.argument, value})
+	}
+
+	d.mu.Lock()
+	planned.ID = -len(d.mutations) - 1
+	d.mutations = append(d.mutations, planned)
+	d.mu.Unlock()
+
+	if m.scalar {
+		return json.RawMessage("null")
+	}
+	result, _ := json.Marshal(syntheticResult(m.fields, strconv.Itoa(planned.ID)))
+	return result
+}
+
+// syntheticResult returns the result of the fields of a planned mutation, with the given identifier.
+func syntheticResult(fields []field, id string) map[string]interface{} {
+	result := make(map[string]interface{})
+	for _, f := range fields {
+		switch {
+		case f.value == nil && f.field == "id":
+			result[f.field] = id
+		case f.value == nil:
+			result[f.field] = nil
+		case f.value.fragment != nil:
+			for key, value := range syntheticResult(f.value.fragment.fields, id) {
+				result[key] = value
+			}
+		case f.value.alias != "" && f.value.scalar && f.value.name == "id":
+			result[f.value.alias] = id
+		case f.value.alias != "":
+			result[f.value.alias] = nil
+		default:
+			result[f.value.name] = nil
+		}
+	}
+	return result
+}
+
+// withDocuments returns a copy of the request that sends the given documents.
+func withDocuments(req *http.Request, documents []string) *http.Request {
+	body := url.Values{"query": documents}.Encode()
+	clone := req.Clone(req.Context())
+	clone.Body = ioutil.NopCloser(strings.NewReader(body))
+	clone.ContentLength = int64(len(body))
+	clone.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(strings.NewReader(body)), nil
+	}
+	return clone
+}
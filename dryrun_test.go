@@ -0,0 +1,78 @@
+package monday
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestDryRun(t *testing.T) {
+	transport := new(documentTransport)
+	c := NewClient("token", &http.Client{Transport: transport})
+	plan := c.DryRun()
+
+	var created struct {
+		Item struct {
+			ID   string
+			Name *string
+		} `json:"create_item"`
+		Me interface{}
+	}
+	if err := c.Do(context.Background(), Payload{
+		queries:   []Query{NewQuery("me", []Field{NewField("id", nil)}, nil)},
+		mutations: []Mutation{Items.Create(1, "topics", "item", nil, []ItemsField{ItemsIDField(), ItemsNameField()})},
+	}, &created); err != nil {
+		t.Fatal(err)
+	}
+	if len(transport.documents) != 1 || transport.documents[0] != "{me{id}}" {
+		t.Errorf("unexpected documents %v", transport.documents)
+	}
+	if created.Item.ID != "-1" || created.Item.Name != nil {
+		t.Errorf("unexpected item %+v", created.Item)
+	}
+
+	transport.documents = nil
+	id, _ := strconv.Atoi(created.Item.ID)
+	var changed map[string]struct{ ID string }
+	if err := c.Do(context.Background(), NewMutationPayload(
+		Columns.ChangeValue(id, "text", 1, NewTextValue("text", "value"), nil),
+		Items.Archive(id, nil).WithAlias("archived"),
+	), &changed); err != nil {
+		t.Fatal(err)
+	}
+	if transport.documents != nil {
+		t.Errorf("the mutations are sent: %v", transport.documents)
+	}
+	if changed["change_column_value"].ID != "-2" || changed["archived"].ID != "-3" {
+		t.Errorf("unexpected results %+v", changed)
+	}
+
+	if err := c.Do(context.Background(), NewRawPayload(`mutation { delete_item(item_id: 5) { id } }`), nil); err != nil {
+		t.Fatal(err)
+	}
+	if transport.documents != nil {
+		t.Errorf("the raw mutation is sent: %v", transport.documents)
+	}
+
+	if n := len(plan.Plan()); n != 4 {
+		t.Fatalf("planned %d mutations, expected 4", n)
+	}
+	expected := `1. create_item on board 1 (-1)
+   board_id: 1
+   group_id: "topics"
+   item_name: "item"
+2. change_column_value on board 1, item -1 (-2)
+   item_id: -1
+   column_id: "text"
+   board_id: 1
+   value: "\"value\""
+3. archive_item on item -1 (-3)
+   item_id: -1
+4. delete_item on item 5 (-4)
+   item_id: 5
+`
+	if s := plan.String(); s != expected {
+		t.Errorf("unexpected plan:\n%s", s)
+	}
+}
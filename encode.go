@@ -0,0 +1,245 @@
+package monday
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Enum is an enum value of the api, it is written as a name instead of as a string literal.
+// The enums of the package and of the api package implement it, other enum values can be created with NewEnum.
+type Enum interface {
+	EnumValue() string
+}
+
+// InputValue is an argument value that writes itself as a GraphQL input value.
+//
+// Values that do not implement it are encoded by their type: nil as null, strings as string literals, numbers and
+// booleans as is, enums by their value, slices as lists, and maps with string keys and structs as input objects.
+// The keys of maps must be GraphQL names, otherwise the payload can not be sent.
+// Struct fields are named by their json tag, fields tagged with omitempty are left out when empty.
+// This covers nested input objects and lists of them, so InputValue is only needed for values that can not be
+// expressed as go values, like JSON scalars, see NewJSONInputValue.
+type InputValue interface {
+	InputValue() string
+}
+
+// NewEnum returns the enum value with the given name, e.g. for enums that the package does not define.
+func NewEnum(value string) Enum {
+	return enumLiteral(value)
+}
+
+// enumLiteral is an enum value created by NewEnum or found in a parsed document.
+type enumLiteral string
+
+func (e enumLiteral) EnumValue() string {
+	return string(e)
+}
+
+// NewJSONInputValue returns the json encoding of the value as a string literal, as expected by JSON scalars
+// (e.g. the column values of a mutation).
+func NewJSONInputValue(value interface{}) (InputValue, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return inputLiteral(encodeString(string(raw))), nil
+}
+
+// inputLiteral is an input value that is already encoded.
+type inputLiteral string
+
+func (l inputLiteral) InputValue() string {
+	return string(l)
+}
+
+// encodeString returns the string as a GraphQL string literal.
+// Only the escape sequences of the GraphQL specification are used, other characters like emoji are written as is.
+// Invalid UTF-8 is replaced by the replacement character.
+func encodeString(s string) string {
+	var b strings.Builder
+	b.Grow(len(s) + 2)
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(&b, `\u%04x`, r)
+				continue
+			}
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// encodeValue returns the value as a GraphQL input value, see InputValue.
+// It returns an error for maps with keys that are not valid GraphQL names.
+func encodeValue(value interface{}) (string, error) {
+	if value == nil {
+		return "null", nil
+	}
+	return encodeReflectValue(reflect.ValueOf(value))
+}
+
+func encodeReflectValue(v reflect.Value) (string, error) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return "null", nil
+		}
+		v = v.Elem()
+	}
+	if v.CanInterface() {
+		if input, ok := v.Interface().(InputValue); ok {
+			return input.InputValue(), nil
+		}
+		if enum, ok := enumValue(v); ok {
+			if !validName(enum) {
+				return encodeString(enum), nil
+			}
+			return enum, nil
+		}
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return encodeString(v.String()), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return "null", nil
+		}
+		s := strconv.FormatFloat(f, 'g', -1, v.Type().Bits())
+		if !strings.ContainsAny(s, ".e") {
+			s += ".0"
+		}
+		return s, nil
+	case reflect.Slice, reflect.Array:
+		values := make([]string, v.Len())
+		for i := range values {
+			value, err := encodeReflectValue(v.Index(i))
+			if err != nil {
+				return "", err
+			}
+			values[i] = value
+		}
+		return "[" + strings.Join(values, ",") + "]", nil
+	case reflect.Map:
+		keys := make([]string, 0, v.Len())
+		values := make(map[string]string, v.Len())
+		for _, key := range v.MapKeys() {
+			name := fmt.Sprint(key.Interface())
+			if !validName(name) {
+				return "", fmt.Errorf("map key %q is not a valid field name", name)
+			}
+			value, err := encodeReflectValue(v.MapIndex(key))
+			if err != nil {
+				return "", err
+			}
+			keys = append(keys, name)
+			values[name] = value
+		}
+		sort.Strings(keys)
+		fields := make([]string, len(keys))
+		for i, key := range keys {
+			fields[i] = key + ":" + values[key]
+		}
+		return "{" + strings.Join(fields, ",") + "}", nil
+	case reflect.Struct:
+		var fields []string
+		for _, f := range structFields(v) {
+			value, err := encodeReflectValue(f.value)
+			if err != nil {
+				return "", err
+			}
+			fields = append(fields, f.name+":"+value)
+		}
+		return "{" + strings.Join(fields, ",") + "}", nil
+	}
+	return encodeString(fmt.Sprint(v.Interface())), nil
+}
+
+// structField is a field of a struct that is encoded as a field of an input object.
+type structField struct {
+	name  string
+	value reflect.Value
+}
+
+// structFields returns the exported fields of the struct, named by their json tags, without the fields that are
+// ignored or empty and omitted.
+func structFields(v reflect.Value) []structField {
+	var fields []structField
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" {
+			continue
+		}
+		name, omitEmpty := sf.Name, false
+		if tag, ok := sf.Tag.Lookup("json"); ok {
+			parts := strings.Split(tag, ",")
+			if parts[0] == "-" {
+				continue
+			}
+			if parts[0] != "" {
+				name = parts[0]
+			}
+			for _, option := range parts[1:] {
+				omitEmpty = omitEmpty || option == "omitempty"
+			}
+		}
+		if omitEmpty && v.Field(i).IsZero() {
+			continue
+		}
+		fields = append(fields, structField{name, v.Field(i)})
+	}
+	return fields
+}
+
+// validName reports whether the string is a valid GraphQL name, e.g. of an enum value or an input object field.
+func validName(s string) bool {
+	for i, r := range s {
+		switch {
+		case r == '_', 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z':
+		case '0' <= r && r <= '9' && i != 0:
+		default:
+			return false
+		}
+	}
+	return s != ""
+}
+
+// enumValue returns the value of an enum.
+func enumValue(value reflect.Value) (string, bool) {
+	if !value.CanInterface() {
+		return "", false
+	}
+	if e, ok := value.Interface().(Enum); ok {
+		return e.EnumValue(), true
+	}
+	return "", false
+}
@@ -0,0 +1,66 @@
+//go:build go1.18
+// +build go1.18
+
+package monday
+
+import (
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func FuzzEncodeString(f *testing.F) {
+	for _, seed := range []string{"", "text", `"quoted" \ back`, "line\nbreak\r\n", "\x00\a\b\v\x1b\x7f", "😀", "\u2028\ufeff"} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		if !utf8.ValidString(s) {
+			t.Skip()
+		}
+		mutation := Updates.Create(1, s, nil)
+		str := mutation.stringify()
+		prefix := `create_update(item_id:1,body:`
+		if !strings.HasPrefix(str, prefix) || !strings.HasSuffix(str, "){id}") {
+			t.Fatalf("unexpected mutation %s", str)
+		}
+		literal := strings.TrimSuffix(strings.TrimPrefix(str, prefix), "){id}")
+		decoded, err := decodeString(literal)
+		if err != nil {
+			t.Fatalf("invalid string literal %s: %v", literal, err)
+		}
+		if decoded != s {
+			t.Fatalf("decoded %q, expected %q", decoded, s)
+		}
+	})
+}
+
+func FuzzEncodeValue(f *testing.F) {
+	f.Add("a", "b", int64(1), 1.5, true)
+	f.Add("", "\x00\"", int64(-1), -2e-9, false)
+	f.Fuzz(func(t *testing.T, a, b string, n int64, x float64, ok bool) {
+		if !utf8.ValidString(a) || !utf8.ValidString(b) || math.IsNaN(x) || math.IsInf(x, 0) {
+			t.Skip()
+		}
+		value := map[string]interface{}{
+			"list":   []interface{}{a, b, n},
+			"nested": map[string]interface{}{"x": x, "ok": ok, "kind": BoardsKindPublic()},
+		}
+		str, err := encodeValue(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded, err := decodeValue(str)
+		if err != nil {
+			t.Fatalf("invalid value %s: %v", str, err)
+		}
+		expected := map[string]interface{}{
+			"list":   []interface{}{a, b, n},
+			"nested": map[string]interface{}{"x": x, "ok": ok, "kind": "public"},
+		}
+		if !reflect.DeepEqual(decoded, expected) {
+			t.Fatalf("decoded %#v from %s, expected %#v", decoded, str, expected)
+		}
+	})
+}
@@ -0,0 +1,261 @@
+package monday
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestEncodeString(t *testing.T) {
+	for _, test := range []struct {
+		value, str string
+	}{
+		{"", `""`},
+		{`say "hi"`, `"say \"hi\""`},
+		{`C:\path`, `"C:\\path"`},
+		{"a\nb\tc\r\b\f", `"a\nb\tc\r\b\f"`},
+		{"\x00\a\x1f\x7f", `"\u0000\u0007\u001f\u007f"`},
+		{"😀 ünïcode", `"😀 ünïcode"`},
+		{"\xff", `"` + "\ufffd" + `"`},
+	} {
+		if str := encodeString(test.value); str != test.str {
+			t.Errorf("encodeString(%q) = %s, expected %s", test.value, str, test.str)
+		}
+	}
+}
+
+type encodeInput struct {
+	Name   string `json:"name"`
+	Kind   BoardsKind
+	IDs    []int    `json:"ids,omitempty"`
+	Ignore string   `json:"-"`
+	Next   *string  `json:"next,omitempty"`
+	Tags   []string `json:"tags"`
+}
+
+func TestEncodeValue(t *testing.T) {
+	for _, test := range []struct {
+		value interface{}
+		str   string
+	}{
+		{nil, "null"},
+		{42, "42"},
+		{1.5, "1.5"},
+		{2.0, "2.0"},
+		{float32(0.1), "0.1"},
+		{true, "true"},
+		{[]int{1, 2}, "[1,2]"},
+		{[]string{`a"`, "b"}, `["a\"","b"]`},
+		{BoardsKindShare(), "share"},
+		{[]BoardsKind{BoardsKindPublic(), BoardsKindPrivate()}, "[public,private]"},
+		{map[string]interface{}{"b": 1, "a": "x"}, `{a:"x",b:1}`},
+		{encodeInput{Name: "n", Kind: BoardsKindPublic()}, `{name:"n",Kind:public,tags:[]}`},
+		{NewEnum("not_defined"), "not_defined"},
+		{State{"all"}, "all"},
+		{[]map[string]interface{}{{"column_id": "status", "compare_value": []interface{}{1, nil}}},
+			`[{column_id:"status",compare_value:[1,null]}]`},
+		{inputLiteral(`{"a":1}`), `{"a":1}`},
+	} {
+		if str, err := encodeValue(test.value); err != nil || str != test.str {
+			t.Errorf("encodeValue(%#v) = %s, %v, expected %s", test.value, str, err, test.str)
+		}
+	}
+
+	for _, value := range []interface{}{
+		map[string]int{"not a name": 1},
+		map[int]int{1: 1},
+		[]interface{}{map[string]interface{}{"a": map[string]int{"": 1}}},
+	} {
+		if str, err := encodeValue(value); err == nil {
+			t.Errorf("encodeValue(%#v) = %s, expected an error", value, str)
+		}
+	}
+	payload := NewQueryPayload(Query{name: "boards", fields: []field{{"id", nil}},
+		args: []argument{{"filter", map[string]int{"a-b": 1}}}})
+	if _, err := payload.documents(); err == nil || !strings.Contains(err.Error(), `boards: argument filter: map key "a-b"`) {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	json, err := NewJSONInputValue(map[string]string{"text": "hi"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if str, _ := encodeValue(json); str != `"{\"text\":\"hi\"}"` {
+		t.Errorf("unexpected json input value: %s", str)
+	}
+
+	query := Items.List([]ItemsField{ItemsIDField()}, NewItemsIDsArgument([]int{1}))
+	query.args = append(query.args, argument{"name", "line\nbreak \x00"})
+	if str, expected := query.stringify(), `items(ids:[1],name:"line\nbreak \u0000"){id}`; str != expected {
+		t.Errorf("got %s, expected %s", str, expected)
+	}
+}
+
+// decodeString decodes a GraphQL string literal as defined by the specification,
+// it only accepts the escape sequences of the specification.
+func decodeString(s string) (string, error) {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return "", fmt.Errorf("not a string literal: %s", s)
+	}
+	s = s[1 : len(s)-1]
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case r == utf8.RuneError && size == 1:
+			return "", fmt.Errorf("invalid utf-8 at %d", i)
+		case r == '"' || r == '\n' || r == '\r':
+			return "", fmt.Errorf("unescaped %q at %d", r, i)
+		case r < 0x20 && r != '\t':
+			return "", fmt.Errorf("invalid source character %U at %d", r, i)
+		case r != '\\':
+			b.WriteRune(r)
+			i += size
+			continue
+		}
+		if i+1 >= len(s) {
+			return "", fmt.Errorf("unterminated escape sequence")
+		}
+		switch e := s[i+1]; e {
+		case '"', '\\', '/':
+			b.WriteByte(e)
+		case 'b':
+			b.WriteByte('\b')
+		case 'f':
+			b.WriteByte('\f')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 't':
+			b.WriteByte('\t')
+		case 'u':
+			if i+6 > len(s) {
+				return "", fmt.Errorf("invalid unicode escape sequence")
+			}
+			v, err := strconv.ParseUint(s[i+2:i+6], 16, 16)
+			if err != nil {
+				return "", err
+			}
+			b.WriteRune(rune(v))
+			i += 4
+		default:
+			return "", fmt.Errorf("invalid escape sequence \\%c", e)
+		}
+		i += 2
+	}
+	return b.String(), nil
+}
+
+// decodeValue decodes a GraphQL input value: strings, ints, floats, booleans, null, enums, lists and objects.
+// Enums are returned as their name, numbers as int64 or float64.
+func decodeValue(s string) (interface{}, error) {
+	d := valueDecoder{src: s}
+	v, err := d.value()
+	if err != nil {
+		return nil, err
+	}
+	if d.pos != len(d.src) {
+		return nil, fmt.Errorf("unexpected %q after value", d.src[d.pos:])
+	}
+	return v, nil
+}
+
+type valueDecoder struct {
+	src string
+	pos int
+}
+
+func (d *valueDecoder) value() (interface{}, error) {
+	if d.pos >= len(d.src) {
+		return nil, fmt.Errorf("unexpected end of value")
+	}
+	switch c := d.src[d.pos]; {
+	case c == '"':
+		end := d.pos + 1
+		for end < len(d.src) && d.src[end] != '"' {
+			if d.src[end] == '\\' {
+				end++
+			}
+			end++
+		}
+		if end >= len(d.src) {
+			return nil, fmt.Errorf("unterminated string")
+		}
+		literal := d.src[d.pos : end+1]
+		d.pos = end + 1
+		return decodeString(literal)
+	case c == '[' || c == '{':
+		d.pos++
+		list, object := []interface{}{}, map[string]interface{}{}
+		for {
+			if d.pos >= len(d.src) {
+				return nil, fmt.Errorf("unterminated list or object")
+			}
+			switch d.src[d.pos] {
+			case ',':
+				d.pos++
+				continue
+			case ']', '}':
+				d.pos++
+				if c == '[' {
+					return list, nil
+				}
+				return object, nil
+			}
+			if c == '{' {
+				name := d.name()
+				if name == "" || d.pos >= len(d.src) || d.src[d.pos] != ':' {
+					return nil, fmt.Errorf("invalid object field at %d", d.pos)
+				}
+				d.pos++
+				v, err := d.value()
+				if err != nil {
+					return nil, err
+				}
+				object[name] = v
+				continue
+			}
+			v, err := d.value()
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, v)
+		}
+	case c == '-' || '0' <= c && c <= '9':
+		start := d.pos
+		for d.pos < len(d.src) && strings.IndexByte("+-.0123456789eE", d.src[d.pos]) >= 0 {
+			d.pos++
+		}
+		raw := d.src[start:d.pos]
+		if strings.ContainsAny(raw, ".eE") {
+			return strconv.ParseFloat(raw, 64)
+		}
+		return strconv.ParseInt(raw, 10, 64)
+	default:
+		switch name := d.name(); name {
+		case "":
+			return nil, fmt.Errorf("unexpected %q", c)
+		case "true", "false":
+			return name == "true", nil
+		case "null":
+			return nil, nil
+		default:
+			return name, nil
+		}
+	}
+}
+
+func (d *valueDecoder) name() string {
+	start := d.pos
+	for d.pos < len(d.src) {
+		c := d.src[d.pos]
+		if c != '_' && !('a' <= c && c <= 'z') && !('A' <= c && c <= 'Z') && !(d.pos != start && '0' <= c && c <= '9') {
+			break
+		}
+		d.pos++
+	}
+	return d.src[start:d.pos]
+}
@@ -0,0 +1,255 @@
+// Package export writes all the items of a board to csv, json lines or xlsx.
+//
+// Items are fetched page by page and written to the output as soon as they are received,
+// so the memory usage does not depend on the size of the board.
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/di-wu/monday"
+)
+
+// Format is the output format of an export.
+type Format struct {
+	format string
+}
+
+var (
+	formatCSV    = Format{"csv"}
+	formatNDJSON = Format{"ndjson"}
+	formatXLSX   = Format{"xlsx"}
+)
+
+// Comma separated values, with a header row.
+func FormatCSV() Format {
+	return formatCSV
+}
+
+// Newline delimited json, one object per item.
+func FormatNDJSON() Format {
+	return formatNDJSON
+}
+
+// Office Open XML spreadsheet, with a header row.
+func FormatXLSX() Format {
+	return formatXLSX
+}
+
+// ParseFormat returns the format with the given name (csv / ndjson / xlsx).
+func ParseFormat(name string) (Format, error) {
+	for _, f := range []Format{formatCSV, formatNDJSON, formatXLSX} {
+		if f.format == name {
+			return f, nil
+		}
+	}
+	return Format{}, fmt.Errorf("unknown export format %q", name)
+}
+
+func (f Format) String() string {
+	return f.format
+}
+
+const defaultPageSize = 100
+
+// Options configure an export.
+type Options struct {
+	// Format is the output format, defaults to csv.
+	Format Format
+	// Typed exports the json values of the columns instead of their text.
+	Typed bool
+	// PageSize is the number of items fetched per request, defaults to 100.
+	PageSize int
+}
+
+// The fixed columns that precede the board's columns.
+var fixedColumns = []string{"Group", "ID", "Name", "Creator", "Created At", "Updated At"}
+
+// Board exports all the items of the board to the writer, one item per row.
+func Board(ctx context.Context, c *monday.Client, boardID int, w io.Writer, opts Options) error {
+	if opts.PageSize <= 0 {
+		opts.PageSize = defaultPageSize
+	}
+	if opts.Format == (Format{}) {
+		opts.Format = formatCSV
+	}
+
+	board, err := getBoard(ctx, c, boardID)
+	if err != nil {
+		return err
+	}
+	var columns []column
+	header := append([]string(nil), fixedColumns...)
+	for _, col := range board.Columns {
+		if col.Type == "name" {
+			continue
+		}
+		columns = append(columns, col)
+		header = append(header, col.Title)
+	}
+	groups := make(map[string]string)
+	for _, g := range board.Groups {
+		groups[g.ID] = g.Title
+	}
+
+	var rw rowWriter
+	switch opts.Format {
+	case formatCSV:
+		rw = newCSVWriter(w)
+	case formatNDJSON:
+		rw = newNDJSONWriter(w)
+	case formatXLSX:
+		rw = newXLSXWriter(w, board.Name)
+	default:
+		return fmt.Errorf("unknown export format %q", opts.Format)
+	}
+	if err := rw.header(header); err != nil {
+		return err
+	}
+
+	for page := 1; ; page++ {
+		items, err := getItems(ctx, c, boardID, page, opts.PageSize)
+		if err != nil {
+			return err
+		}
+		for _, i := range items {
+			values := make(map[string]columnValue)
+			for _, v := range i.Values {
+				values[v.ID] = v
+			}
+			row := []cell{
+				textCell(groups[i.Group.ID]),
+				textCell(i.ID),
+				textCell(i.Name),
+				textCell(i.Creator.Name),
+				textCell(i.CreatedAt),
+				textCell(i.UpdatedAt),
+			}
+			for _, col := range columns {
+				v := values[col.ID]
+				if opts.Typed {
+					row = append(row, jsonCell(v.Value))
+					continue
+				}
+				row = append(row, textCell(v.Text))
+			}
+			if err := rw.row(row); err != nil {
+				return err
+			}
+		}
+		if len(items) < opts.PageSize {
+			break
+		}
+	}
+	return rw.close()
+}
+
+type column struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Type  string `json:"type"`
+}
+
+type board struct {
+	Name    string
+	Columns []column
+	Groups  []struct {
+		ID    string `json:"id"`
+		Title string `json:"title"`
+	}
+}
+
+type columnValue struct {
+	ID    string  `json:"id"`
+	Text  string  `json:"text"`
+	Value *string `json:"value"`
+}
+
+type item struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+	Group     struct {
+		ID string `json:"id"`
+	}
+	Creator struct {
+		Name string `json:"name"`
+	}
+	Values []columnValue `json:"column_values"`
+}
+
+func getBoard(ctx context.Context, c *monday.Client, boardID int) (board, error) {
+	var data struct {
+		Boards []board
+	}
+	if err := c.Do(ctx, monday.NewQueryPayload(
+		monday.Boards.List(
+			[]monday.BoardsField{
+				monday.BoardsNameField(),
+				monday.NewBoardsColumnField([]monday.ColumnsField{
+					monday.ColumnsIDField(),
+					monday.ColumnsTitleField(),
+					monday.ColumnsTypeField(),
+				}),
+				monday.NewBoardsGroupsFields([]monday.GroupsField{
+					monday.GroupsIDField(),
+					monday.GroupsTitleField(),
+				}, nil),
+			},
+			monday.NewBoardsIDsArgument([]int{boardID}),
+		),
+	), &data); err != nil {
+		return board{}, err
+	}
+	if len(data.Boards) != 1 {
+		return board{}, fmt.Errorf("no boards returned for id %d", boardID)
+	}
+	return data.Boards[0], nil
+}
+
+func getItems(ctx context.Context, c *monday.Client, boardID, page, limit int) ([]item, error) {
+	var data struct {
+		Boards []struct {
+			Items []item
+		}
+	}
+	if err := c.Do(ctx, monday.NewQueryPayload(
+		monday.Boards.List(
+			[]monday.BoardsField{
+				monday.NewBoardsItemsFields(
+					[]monday.ItemsField{
+						monday.ItemsIDField(),
+						monday.ItemsNameField(),
+						monday.ItemsCreatedAtField(),
+						monday.ItemsUpdatedAtField(),
+						monday.NewItemsGroupField([]monday.GroupsField{
+							monday.GroupsIDField(),
+						}, nil),
+						monday.NewItemsCreatorField([]monday.UsersField{
+							monday.UsersNameField(),
+						}, nil),
+						monday.NewItemsColumnValuesField([]monday.ColumnValuesField{
+							monday.ColumnValuesIDField(),
+							monday.ColumnValuesTextField(),
+							monday.ColumnValuesValueField(),
+						}, nil),
+					},
+					[]monday.ItemsArgument{
+						monday.NewItemsLimitArgument(limit),
+						monday.NewItemsPageArgument(page),
+					},
+				),
+			},
+			monday.NewBoardsIDsArgument([]int{boardID}),
+		),
+	), &data); err != nil {
+		return nil, err
+	}
+	if len(data.Boards) != 1 {
+		return nil, fmt.Errorf("no boards returned for id %d", boardID)
+	}
+	return data.Boards[0].Items, nil
+}
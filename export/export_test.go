@@ -0,0 +1,103 @@
+package export
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/di-wu/monday"
+	"github.com/di-wu/monday/mondaytest"
+	"github.com/di-wu/monday/pdq"
+)
+
+func TestBoard(t *testing.T) {
+	s := mondaytest.NewServer()
+	defer s.Close()
+	c := pdq.NewSimpleClientWithHTTPClient("mondaytest", s.Client())
+
+	board, _ := c.CreateBoard("Export")
+	status, _ := c.CreateStatusColumn(board.ID(), "Status", []string{"Open", "Closed"})
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		if _, err := c.CreateItemWithColumnValues(board.ID(), "topics", name, []monday.ColumnValue{
+			monday.NewStatusLabelValue(status.Id, "Closed"),
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	export := func(opts Options) []byte {
+		t.Helper()
+		opts.PageSize = 2
+		var buf bytes.Buffer
+		if err := Board(context.Background(), &c.Client, board.ID(), &buf, opts); err != nil {
+			t.Fatal(err)
+		}
+		return buf.Bytes()
+	}
+
+	records, err := csv.NewReader(bytes.NewReader(export(Options{Format: FormatCSV()}))).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 6 {
+		t.Fatalf("got %d records, expected 6", len(records))
+	}
+	if h := strings.Join(records[0], ","); h != "Group,ID,Name,Creator,Created At,Updated At,Status" {
+		t.Errorf("unexpected header %s", h)
+	}
+	if r := records[5]; r[0] != "Group Title" || r[2] != "e" || r[3] != "Test User" || r[6] != "Closed" {
+		t.Errorf("unexpected record %v", r)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(export(Options{Format: FormatNDJSON(), Typed: true})))
+	var lines int
+	for scanner.Scan() {
+		lines++
+		var row map[string]interface{}
+		if err := json.Unmarshal(scanner.Bytes(), &row); err != nil {
+			t.Fatal(err)
+		}
+		if index := row["Status"].(map[string]interface{})["index"]; index != 1.0 {
+			t.Errorf("got index %v, expected 1", index)
+		}
+	}
+	if lines != 5 {
+		t.Errorf("got %d lines, expected 5", lines)
+	}
+
+	raw := export(Options{Format: FormatXLSX()})
+	r, err := zip.NewReader(bytes.NewReader(raw), int64(len(raw)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range r.File {
+		if f.Name != "xl/worksheets/sheet1.xml" {
+			continue
+		}
+		rc, _ := f.Open()
+		sheet, _ := ioutil.ReadAll(rc)
+		rc.Close()
+		if n := strings.Count(string(sheet), "<row "); n != 6 {
+			t.Errorf("got %d rows, expected 6", n)
+		}
+		if !strings.Contains(string(sheet), `<c r="G6" t="inlineStr"><is><t xml:space="preserve">Closed</t></is></c>`) {
+			t.Errorf("missing status cell in %s", sheet)
+		}
+		return
+	}
+	t.Error("missing sheet")
+}
+
+func TestColumnName(t *testing.T) {
+	for i, name := range map[int]string{0: "A", 25: "Z", 26: "AA", 701: "ZZ", 702: "AAA"} {
+		if got := columnName(i); got != name {
+			t.Errorf("got %s, expected %s", got, name)
+		}
+	}
+}
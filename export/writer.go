@@ -0,0 +1,228 @@
+package export
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// cell is a single value of a row, either plain text or a json value.
+type cell struct {
+	text string
+	json bool
+}
+
+func textCell(text string) cell {
+	return cell{text: text}
+}
+
+// jsonCell returns a cell with the json value, or an empty cell if there is no value.
+func jsonCell(value *string) cell {
+	if value == nil {
+		return cell{}
+	}
+	return cell{text: *value, json: true}
+}
+
+type rowWriter interface {
+	header(columns []string) error
+	row(cells []cell) error
+	close() error
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) *csvWriter {
+	return &csvWriter{csv.NewWriter(w)}
+}
+
+func (w *csvWriter) header(columns []string) error {
+	return w.w.Write(columns)
+}
+
+func (w *csvWriter) row(cells []cell) error {
+	record := make([]string, len(cells))
+	for i, c := range cells {
+		record[i] = c.text
+	}
+	if err := w.w.Write(record); err != nil {
+		return err
+	}
+	// Flush every row so that nothing gets buffered across pages.
+	w.w.Flush()
+	return w.w.Error()
+}
+
+func (w *csvWriter) close() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+type ndjsonWriter struct {
+	w       *bufio.Writer
+	columns []string
+}
+
+func newNDJSONWriter(w io.Writer) *ndjsonWriter {
+	return &ndjsonWriter{w: bufio.NewWriter(w)}
+}
+
+func (w *ndjsonWriter) header(columns []string) error {
+	w.columns = columns
+	return nil
+}
+
+// row writes the cells as a json object, keys are written in the order of the columns.
+func (w *ndjsonWriter) row(cells []cell) error {
+	w.w.WriteByte('{')
+	for i, c := range cells {
+		if i != 0 {
+			w.w.WriteByte(',')
+		}
+		key, _ := json.Marshal(w.columns[i])
+		w.w.Write(key)
+		w.w.WriteByte(':')
+		switch {
+		case c.json:
+			w.w.WriteString(c.text)
+		case c.text == "" && i >= len(fixedColumns):
+			w.w.WriteString("null")
+		default:
+			value, _ := json.Marshal(c.text)
+			w.w.Write(value)
+		}
+	}
+	w.w.WriteString("}\n")
+	return w.w.Flush()
+}
+
+func (w *ndjsonWriter) close() error {
+	return w.w.Flush()
+}
+
+// xlsxWriter streams a single sheet workbook, rows are written to the sheet as they come in.
+// Strings are written inline, so no shared strings table needs to be kept in memory.
+type xlsxWriter struct {
+	zip   *zip.Writer
+	sheet *bufio.Writer
+	name  string
+	rows  int
+}
+
+func newXLSXWriter(w io.Writer, name string) *xlsxWriter {
+	return &xlsxWriter{
+		zip:  zip.NewWriter(w),
+		name: sheetName(name),
+	}
+}
+
+// sheetName returns a valid sheet name: at most 31 characters, without []:*?/\.
+func sheetName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`[]:*?/\`, r) {
+			return '_'
+		}
+		return r
+	}, name)
+	if runes := []rune(name); len(runes) > 31 {
+		name = string(runes[:31])
+	}
+	if name == "" {
+		name = "Sheet1"
+	}
+	return name
+}
+
+func (w *xlsxWriter) header(columns []string) error {
+	f, err := w.zip.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+	w.sheet = bufio.NewWriter(f)
+	w.sheet.WriteString(xml.Header)
+	w.sheet.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	cells := make([]cell, len(columns))
+	for i, c := range columns {
+		cells[i] = textCell(c)
+	}
+	return w.row(cells)
+}
+
+func (w *xlsxWriter) row(cells []cell) error {
+	w.rows++
+	fmt.Fprintf(w.sheet, `<row r="%d">`, w.rows)
+	for i, c := range cells {
+		if c.text == "" {
+			continue
+		}
+		fmt.Fprintf(w.sheet, `<c r="%s%d" t="inlineStr"><is><t xml:space="preserve">`, columnName(i), w.rows)
+		if err := xml.EscapeText(w.sheet, []byte(sanitizeXML(c.text))); err != nil {
+			return err
+		}
+		w.sheet.WriteString(`</t></is></c>`)
+	}
+	w.sheet.WriteString(`</row>`)
+	return w.sheet.Flush()
+}
+
+// sanitizeXML removes the characters that are not allowed in xml documents.
+func sanitizeXML(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '\t' || r == '\n' || r == '\r' || r >= 0x20 && r != 0xfffe && r != 0xffff {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// columnName returns the spreadsheet name of the zero based column index (A, B, ..., Z, AA, ...).
+func columnName(i int) string {
+	var name []byte
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = append([]byte{byte('A' + (i-1)%26)}, name...)
+	}
+	return string(name)
+}
+
+func (w *xlsxWriter) close() error {
+	w.sheet.WriteString(`</sheetData></worksheet>`)
+	if err := w.sheet.Flush(); err != nil {
+		return err
+	}
+	var name strings.Builder
+	_ = xml.EscapeText(&name, []byte(w.name))
+	for _, part := range []struct {
+		name, content string
+	}{
+		{"[Content_Types].xml", xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+			`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+			`<Default Extension="xml" ContentType="application/xml"/>` +
+			`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+			`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+			`</Types>`},
+		{"_rels/.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+			`</Relationships>`},
+		{"xl/workbook.xml", xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+			`<sheets><sheet name="` + name.String() + `" sheetId="1" r:id="rId1"/></sheets></workbook>`},
+		{"xl/_rels/workbook.xml.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+			`</Relationships>`},
+	} {
+		f, err := w.zip.Create(part.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(f, part.content); err != nil {
+			return err
+		}
+	}
+	return w.zip.Close()
+}
@@ -0,0 +1,105 @@
+package monday
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Fragment is a named selection of fields of a type, that can be spread into any selection of that type.
+// The fragments that are spread in a payload are defined once in the document that is sent.
+// The typed fragments of the services (e.g. ItemsFragment) are preferred.
+type Fragment struct {
+	name   string
+	on     string
+	fields []field
+}
+
+// NewFragment returns the fragment with the given name of the fields of the type with the given name (e.g. Item).
+func NewFragment(name, on string, fields []Field) Fragment {
+	return Fragment{
+		name:   name,
+		on:     on,
+		fields: selection(fields),
+	}
+}
+
+// Spread returns the field that spreads the fragment into a selection.
+func (f Fragment) Spread() Field {
+	return Field{f.spread()}
+}
+
+func (f Fragment) spread() field {
+	return field{"..." + f.name, &Query{name: "..." + f.name, fragment: &f}}
+}
+
+// inlineFragment returns the field of an inline fragment on the type with the given name, e.g. "... on Item{state}".
+func inlineFragment(on string, fields []field) field {
+	f := Fragment{on: on, fields: fields}
+	return field{"... on " + on, &Query{name: "... on " + on, fragment: &f}}
+}
+
+// inline reports whether the fragment is an inline fragment, which has no name and no definition.
+func (f Fragment) inline() bool {
+	return f.name == ""
+}
+
+func (f Fragment) definition() string {
+	fields := make([]string, 0)
+	for _, field := range f.fields {
+		fields = append(fields, field.stringify())
+	}
+	return fmt.Sprintf("fragment %s on %s{%s}", f.name, f.on, strings.Join(fields, " "))
+}
+
+// fragmentDefinitions returns the definitions of the fragments that are spread in the fields, each fragment is
+// defined once. Different fragments with the same name are reported as an error.
+func fragmentDefinitions(fields [][]field) (string, error) {
+	definitions := make(map[string]string)
+	var collect func(fields []field) error
+	collect = func(fields []field) error {
+		for _, f := range fields {
+			if f.value == nil {
+				continue
+			}
+			fragment := f.value.fragment
+			if fragment == nil || fragment.inline() {
+				fields := f.value.fields
+				if fragment != nil {
+					fields = fragment.fields
+				}
+				if err := collect(fields); err != nil {
+					return err
+				}
+				continue
+			}
+			definition := fragment.definition()
+			if defined, ok := definitions[fragment.name]; ok {
+				if defined != definition {
+					return fmt.Errorf("conflicting definitions of fragment %s", fragment.name)
+				}
+				continue
+			}
+			definitions[fragment.name] = definition
+			if err := collect(fragment.fields); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, f := range fields {
+		if err := collect(f); err != nil {
+			return "", err
+		}
+	}
+	names := make([]string, 0, len(definitions))
+	for name := range definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var document strings.Builder
+	for _, name := range names {
+		document.WriteString(" " + definitions[name])
+	}
+	return document.String(), nil
+}
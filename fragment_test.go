@@ -0,0 +1,94 @@
+package monday
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+type documentTransport struct {
+	documents []string
+}
+
+func (t *documentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, err
+	}
+	t.documents = values["query"]
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(strings.NewReader(`{"data":{}}`)),
+	}, nil
+}
+
+func TestFragments(t *testing.T) {
+	values := NewColumnValuesFragment("values", []ColumnValuesField{
+		ColumnValuesIDField(),
+		ColumnValuesTextField(),
+	})
+	item := NewItemsFragment("item", []ItemsField{
+		ItemsIDField(),
+		ItemsNameField(),
+		NewItemsColumnValuesField([]ColumnValuesField{ColumnValuesFragmentField(values)}, nil),
+	})
+	payload := NewQueryPayload(
+		Boards.List([]BoardsField{
+			NewBoardsItemsFields([]ItemsField{ItemsFragmentField(item)}, nil),
+			NewBoardsGroupsFields([]GroupsField{
+				NewGroupsItemsField([]ItemsField{ItemsFragmentField(item)}, nil),
+			}, nil),
+		}),
+		ItemsByColumnValues.List(1, "status", NewTextValue("status", "Done"), []ItemsByColumnValuesField{
+			ItemsByColumnValuesFragmentField(item),
+		}),
+	)
+
+	transport := new(documentTransport)
+	c := NewClient("token", &http.Client{Transport: transport})
+	resp, err := c.Exec(context.Background(), payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if len(transport.documents) != 1 {
+		t.Fatalf("got %d documents, expected 1", len(transport.documents))
+	}
+	document := transport.documents[0]
+	for _, definition := range []string{
+		"fragment item on Item{id name column_values{...values}}",
+		"fragment values on ColumnValue{id text}",
+	} {
+		if n := strings.Count(document, definition); n != 1 {
+			t.Errorf("%s is defined %d times in %s", definition, n, document)
+		}
+	}
+	if n := strings.Count(document, "...item"); n != 3 {
+		t.Errorf("item is spread %d times in %s", n, document)
+	}
+
+	if err := payload.Validate(loadSchema(t)); err != nil {
+		t.Error(err)
+	}
+	wrong := NewQueryPayload(Boards.List([]BoardsField{
+		{NewFragment("item", "Item", []Field{NewField("id", nil)}).Spread().field},
+	}))
+	if err := wrong.Validate(loadSchema(t)); err == nil || !strings.Contains(err.Error(), "can not be spread on type Board") {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	conflict := NewQueryPayload(Items.List([]ItemsField{
+		ItemsFragmentField(item),
+		ItemsFragmentField(NewItemsFragment("item", []ItemsField{ItemsIDField()})),
+	}))
+	if _, err := c.Exec(context.Background(), conflict); err == nil {
+		t.Error("expected an error for conflicting fragments")
+	}
+}
@@ -116,6 +116,34 @@ func (*GroupsService) Delete(boardID int, groupID string, groupsFields []GroupsF
 	}
 }
 
+// Update returns a mutation that updates the title, color or position of a group.
+// - boardID: the board's unique identifier.
+// - groupID: The group's unique identifier.
+// - attribute: the group's attribute to update.
+// - value: the new value of the attribute.
+//
+// DOCS: https://monday.com/developers/v2#mutations-section-groups-update
+func (*GroupsService) Update(boardID int, groupID string, attribute GroupsAttribute, value string, groupsFields []GroupsField) Mutation {
+	if len(groupsFields) == 0 {
+		groupsFields = append(groupsFields, groupsIDField)
+	}
+
+	var fields []field
+	for _, gf := range groupsFields {
+		fields = append(fields, gf.field)
+	}
+	return Mutation{
+		name:   "update_group",
+		fields: fields,
+		args: []argument{
+			{"board_id", boardID},
+			{"group_id", groupID},
+			{"group_attribute", attribute},
+			{"new_value", value},
+		},
+	}
+}
+
 // List returns a query that gets one group or a collection of groups in a specific board.
 //
 // DOCS: https://monday.com/developers/v2#queries-section-groups
@@ -203,3 +231,65 @@ type GroupsArgument struct {
 func NewGroupsIDsArgument(ids []string) GroupsArgument {
 	return GroupsArgument{argument{"ids", ids}}
 }
+
+// The group's attribute that can be updated.
+type GroupsAttribute struct {
+	attribute string
+}
+
+// EnumValue returns the value as it appears in a query.
+func (a GroupsAttribute) EnumValue() string {
+	return a.attribute
+}
+
+var (
+	groupsAttributeTitle                  = GroupsAttribute{"title"}
+	groupsAttributeColor                  = GroupsAttribute{"color"}
+	groupsAttributePosition               = GroupsAttribute{"position"}
+	groupsAttributeRelativePositionAfter  = GroupsAttribute{"relative_position_after"}
+	groupsAttributeRelativePositionBefore = GroupsAttribute{"relative_position_before"}
+)
+
+// The group's title.
+func GroupsAttributeTitle() GroupsAttribute {
+	return groupsAttributeTitle
+}
+
+// The group's color (e.g. #579bfc).
+func GroupsAttributeColor() GroupsAttribute {
+	return groupsAttributeColor
+}
+
+// The group's position.
+func GroupsAttributePosition() GroupsAttribute {
+	return groupsAttributePosition
+}
+
+// Moves the group after the group with the given identifier.
+func GroupsAttributeRelativePositionAfter() GroupsAttribute {
+	return groupsAttributeRelativePositionAfter
+}
+
+// Moves the group before the group with the given identifier.
+func GroupsAttributeRelativePositionBefore() GroupsAttribute {
+	return groupsAttributeRelativePositionBefore
+}
+
+// The group's graphql fragment, a named selection of group's fields that is defined once per payload.
+type GroupsFragment struct {
+	fragment Fragment
+}
+
+// NewGroupsFragment returns the fragment with the given name of the group's fields.
+func NewGroupsFragment(name string, groupsFields []GroupsField) GroupsFragment {
+	var fields []field
+	for _, f := range groupsFields {
+		fields = append(fields, f.field)
+	}
+	return GroupsFragment{Fragment{name: name, on: "Group", fields: fields}}
+}
+
+// Spreads the fragment of the group's fields.
+func GroupsFragmentField(fragment GroupsFragment) GroupsField {
+	return GroupsField{fragment.fragment.spread()}
+}
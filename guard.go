@@ -0,0 +1,136 @@
+package monday
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// GuardPolicy restricts the mutations that a client sends, see NewGuardInterceptor.
+type GuardPolicy struct {
+	// ReadOnly rejects every payload with mutations.
+	ReadOnly bool
+	// Boards are the identifiers of the boards that mutations may touch, mutations may touch all boards if nil.
+	// The board of a mutation is its board_id argument. Mutations without one (e.g. Items.Delete) are rejected,
+	// since the board they touch is not known before the call.
+	Boards []int
+	// ConfirmDestructive rejects the destructive mutations (Boards.Archive, Items.Delete, Groups.Delete and
+	// Webhooks.Delete), unless they are confirmed with Mutation.Confirm.
+	ConfirmDestructive bool
+}
+
+// destructiveMutations are the names of the mutations that require confirmation.
+var destructiveMutations = map[string]bool{
+	"archive_board":  true,
+	"delete_item":    true,
+	"delete_group":   true,
+	"delete_webhook": true,
+}
+
+// ReadOnlyError is returned for payloads with mutations by read-only clients.
+type ReadOnlyError struct {
+	// Mutation is the name of the rejected mutation.
+	Mutation string
+}
+
+func (e ReadOnlyError) Error() string {
+	return fmt.Sprintf("monday: mutation %s is not allowed by a read-only client", e.Mutation)
+}
+
+// BoardNotAllowedError is returned for mutations that touch a board that is not allowed.
+type BoardNotAllowedError struct {
+	// Mutation is the name of the rejected mutation.
+	Mutation string
+	// BoardID is the identifier of the board, or 0 if the mutation does not name one.
+	BoardID int
+}
+
+func (e BoardNotAllowedError) Error() string {
+	if e.BoardID == 0 {
+		return fmt.Sprintf("monday: mutation %s does not name an allowed board", e.Mutation)
+	}
+	return fmt.Sprintf("monday: mutation %s touches board %d, which is not allowed", e.Mutation, e.BoardID)
+}
+
+// UnconfirmedError is returned for destructive mutations that are not confirmed, see Mutation.Confirm.
+type UnconfirmedError struct {
+	// Mutation is the name of the rejected mutation.
+	Mutation string
+}
+
+func (e UnconfirmedError) Error() string {
+	return fmt.Sprintf("monday: destructive mutation %s is not confirmed", e.Mutation)
+}
+
+// NewGuardInterceptor returns an interceptor that rejects the payloads with mutations that violate the policy,
+// with a ReadOnlyError, BoardNotAllowedError or UnconfirmedError, before the request is sent.
+// Raw documents are parsed to find their mutations, mutations in raw documents can not be confirmed.
+// Use it as the first interceptor of the client, so no other interceptor sees the rejected calls.
+func NewGuardInterceptor(policy GuardPolicy) Interceptor {
+	var boards map[int]bool
+	if policy.Boards != nil {
+		boards = make(map[int]bool)
+		for _, id := range policy.Boards {
+			boards[id] = true
+		}
+	}
+	return func(ctx context.Context, call *Call, next Handler) (*http.Response, error) {
+		if err := policy.check(call.Payload, boards); err != nil {
+			return nil, err
+		}
+		return next(ctx, call)
+	}
+}
+
+// check returns the first violation of the policy by the mutations of the payload.
+func (policy GuardPolicy) check(payload Payload, boards map[int]bool) error {
+	mutations := payload.mutations
+	if payload.raw != "" {
+		raw, err := ParseDocument(payload.raw, nil)
+		if err != nil {
+			return fmt.Errorf("monday: raw document can not be guarded: %w", err)
+		}
+		mutations = append(raw.mutations, mutations...)
+	}
+	for _, m := range mutations {
+		if policy.ReadOnly {
+			return ReadOnlyError{Mutation: m.name}
+		}
+		if policy.ConfirmDestructive && destructiveMutations[m.name] && !m.confirmed {
+			return UnconfirmedError{Mutation: m.name}
+		}
+		if boards != nil {
+			id, ok := m.intArgument("board_id")
+			if !ok || !boards[id] {
+				return BoardNotAllowedError{Mutation: m.name, BoardID: id}
+			}
+		}
+	}
+	return nil
+}
+
+// intArgument returns the value of the argument of the mutation with the given name, e.g. board_id.
+func (m Mutation) intArgument(name string) (int, bool) {
+	for _, a := range m.args {
+		if a.argument != name {
+			continue
+		}
+		switch v := a.value.(type) {
+		case int:
+			return v, true
+		case int64:
+			return int(v), true
+		case float64:
+			return int(v), float64(int(v)) == v
+		case json.Number:
+			id, err := strconv.Atoi(string(v))
+			return id, err == nil
+		case string:
+			id, err := strconv.Atoi(v)
+			return id, err == nil
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,94 @@
+package monday
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestGuardInterceptor(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		policy  GuardPolicy
+		payload Payload
+		err     error
+	}{
+		{
+			name:    "queries are allowed by read-only clients",
+			policy:  GuardPolicy{ReadOnly: true},
+			payload: NewQueryPayload(Boards.List(nil)),
+		},
+		{
+			name:    "mutations are not allowed by read-only clients",
+			policy:  GuardPolicy{ReadOnly: true},
+			payload: NewMutationPayload(Groups.Create(1, "group", nil)),
+			err:     ReadOnlyError{Mutation: "create_group"},
+		},
+		{
+			name:    "raw mutations are not allowed by read-only clients",
+			policy:  GuardPolicy{ReadOnly: true},
+			payload: NewRawPayload(`mutation { archive_item(item_id: 1) { id } }`),
+			err:     ReadOnlyError{Mutation: "archive_item"},
+		},
+		{
+			name:    "allowed boards",
+			policy:  GuardPolicy{Boards: []int{1, 2}},
+			payload: NewMutationPayload(Groups.Create(1, "group", nil), Columns.Create(2, "status", ColumnsTypeStatus(), nil)),
+		},
+		{
+			name:    "boards that are not allowed",
+			policy:  GuardPolicy{Boards: []int{1}},
+			payload: NewMutationPayload(Groups.Create(1, "group", nil), Groups.Create(3, "group", nil)),
+			err:     BoardNotAllowedError{Mutation: "create_group", BoardID: 3},
+		},
+		{
+			name:    "mutations without a board",
+			policy:  GuardPolicy{Boards: []int{1}},
+			payload: NewMutationPayload(Items.Archive(1, nil)),
+			err:     BoardNotAllowedError{Mutation: "archive_item"},
+		},
+		{
+			name:    "unconfirmed destructive mutations",
+			policy:  GuardPolicy{ConfirmDestructive: true},
+			payload: NewMutationPayload(Items.Archive(1, nil), Items.Delete(1, nil)),
+			err:     UnconfirmedError{Mutation: "delete_item"},
+		},
+		{
+			name:   "confirmed destructive mutations",
+			policy: GuardPolicy{ConfirmDestructive: true},
+			payload: NewMutationPayload(
+				Boards.Archive(1, nil).Confirm(),
+				Groups.Delete(1, "topics", nil).Confirm(),
+				Webhooks.Delete(1, nil).Confirm(),
+			),
+		},
+		{
+			name:    "raw destructive mutations",
+			policy:  GuardPolicy{ConfirmDestructive: true},
+			payload: NewRawPayload(`mutation { delete_webhook(id: 1) { id } }`),
+			err:     UnconfirmedError{Mutation: "delete_webhook"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			transport := new(documentTransport)
+			c := NewClient("token", &http.Client{Transport: transport})
+			c.Use(NewGuardInterceptor(test.policy))
+			err := c.Do(context.Background(), test.payload, nil)
+			if err != test.err {
+				t.Fatalf("got error %v, expected %v", err, test.err)
+			}
+			if sent := transport.documents != nil; sent != (test.err == nil) {
+				t.Errorf("the request is sent: %v", sent)
+			}
+		})
+	}
+
+	c := NewClient("token", &http.Client{Transport: new(documentTransport)})
+	c.Use(NewGuardInterceptor(GuardPolicy{ReadOnly: true}))
+	err := c.Do(context.Background(), NewRawPayload(`mutation {`), nil)
+	var readOnly ReadOnlyError
+	if err == nil || errors.As(err, &readOnly) {
+		t.Errorf("unexpected error %v", err)
+	}
+}
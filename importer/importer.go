@@ -0,0 +1,529 @@
+// Package importer creates the items of a board from csv or json lines files.
+//
+// The headers of the file are mapped to the columns of the board, every row is converted into an item with
+// typed column values. Mutations are sent in batches, progress can be saved in a checkpoint file so a failed
+// import can be resumed. Optionally, existing items are updated instead of created, by matching a key column.
+package importer
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/di-wu/monday"
+	"github.com/di-wu/monday/pdq"
+)
+
+// Format is the input format of an import.
+type Format struct {
+	format string
+}
+
+var (
+	formatCSV    = Format{"csv"}
+	formatNDJSON = Format{"ndjson"}
+)
+
+// Comma separated values, the first row contains the headers.
+func FormatCSV() Format {
+	return formatCSV
+}
+
+// Newline delimited json, one object per item.
+func FormatNDJSON() Format {
+	return formatNDJSON
+}
+
+func (f Format) String() string {
+	return f.format
+}
+
+const (
+	// TargetName maps a header to the name of the item.
+	TargetName = "name"
+	// TargetGroup maps a header to the title of the item's group, missing groups get created.
+	TargetGroup = "group"
+
+	defaultBatchSize = 25
+)
+
+// Options configure an import.
+type Options struct {
+	// Format is the input format, defaults to csv.
+	Format Format
+	// Mapping maps the headers of the file to column ids or titles,
+	// or to TargetName and TargetGroup. Unmapped headers are ignored.
+	Mapping map[string]string
+	// DefaultGroup is the title of the group of items without a group, defaults to the board's first group.
+	DefaultGroup string
+	// KeyColumn is the column id or title used to find existing items.
+	// If set, items with the same key get updated instead of created.
+	KeyColumn string
+	// BatchSize is the number of mutations sent per request, defaults to 25.
+	BatchSize int
+	// Checkpoint is the path of the file in which progress is saved.
+	// If the file exists, the rows that got imported are skipped, also those of a batch that partially failed.
+	Checkpoint string
+}
+
+// Result reports the changes made by an import.
+type Result struct {
+	Created, Updated, Skipped int
+}
+
+type checkpoint struct {
+	// Rows is the number of rows that are imported, from the start of the file.
+	Rows int `json:"rows"`
+	// Lines are the rows after Rows that are imported, by batches that partially failed.
+	Lines []int `json:"lines,omitempty"`
+}
+
+// done reports whether the row on the given line is imported.
+func (c checkpoint) done(line int) bool {
+	if line <= c.Rows {
+		return true
+	}
+	for _, l := range c.Lines {
+		if l == line {
+			return true
+		}
+	}
+	return false
+}
+
+// add marks the rows on the given lines as imported.
+func (c *checkpoint) add(lines []int) {
+	imported := make(map[int]bool)
+	for _, l := range append(c.Lines, lines...) {
+		imported[l] = true
+	}
+	for imported[c.Rows+1] {
+		delete(imported, c.Rows+1)
+		c.Rows++
+	}
+	c.Lines = c.Lines[:0]
+	for l := range imported {
+		if l > c.Rows {
+			c.Lines = append(c.Lines, l)
+		}
+	}
+	sort.Ints(c.Lines)
+}
+
+// row is a single record of the file, converted to the values of the item.
+type row struct {
+	line   int
+	name   string
+	group  string
+	key    string
+	values []monday.ColumnValue
+}
+
+// Import creates (or updates) an item for every row that is read from r.
+func Import(ctx context.Context, c *pdq.SimpleClient, boardID int, r io.Reader, opts Options) (Result, error) {
+	if opts.Format == (Format{}) {
+		opts.Format = formatCSV
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultBatchSize
+	}
+
+	var done checkpoint
+	if opts.Checkpoint != "" {
+		raw, err := ioutil.ReadFile(opts.Checkpoint)
+		switch {
+		case err == nil:
+			if err := json.Unmarshal(raw, &done); err != nil {
+				return Result{}, fmt.Errorf("invalid checkpoint %s: %v", opts.Checkpoint, err)
+			}
+		case !os.IsNotExist(err):
+			return Result{}, err
+		}
+	}
+
+	im, err := newImporter(ctx, c, boardID, opts)
+	if err != nil {
+		return Result{}, err
+	}
+
+	var records recordReader
+	switch opts.Format {
+	case formatCSV:
+		records = newCSVReader(r)
+	case formatNDJSON:
+		records = newNDJSONReader(r)
+	default:
+		return Result{}, fmt.Errorf("unknown import format %q", opts.Format)
+	}
+
+	var result Result
+	var batch []row
+	keys := make(map[string]bool)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		imported, created, updated, err := im.apply(ctx, batch)
+		result.Created += created
+		result.Updated += updated
+		// the rows that are imported are saved, also if others failed, so they are not created twice.
+		done.add(imported)
+		batch = batch[:0]
+		keys = make(map[string]bool)
+		if saveErr := saveCheckpoint(opts.Checkpoint, done); err == nil {
+			err = saveErr
+		}
+		return err
+	}
+	for line := 1; ; line++ {
+		record, err := records.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return result, fmt.Errorf("row %d: %v", line, err)
+		}
+		if done.done(line) {
+			result.Skipped++
+			continue
+		}
+		row, err := im.convert(line, record)
+		if err != nil {
+			return result, fmt.Errorf("row %d: %v", line, err)
+		}
+		// rows with the same key are sent in separate batches, so the second one finds the item of the first.
+		if row.key != "" && keys[row.key] {
+			if err := flush(); err != nil {
+				return result, err
+			}
+		}
+		if row.key != "" {
+			keys[row.key] = true
+		}
+		batch = append(batch, row)
+		if len(batch) == opts.BatchSize {
+			if err := flush(); err != nil {
+				return result, err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+func saveCheckpoint(path string, done checkpoint) error {
+	if path == "" {
+		return nil
+	}
+	raw, err := json.Marshal(done)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, raw, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+type importer struct {
+	client  *pdq.SimpleClient
+	boardID int
+	opts    Options
+
+	columns map[string]pdq.Column
+	key     *pdq.Column
+	groups  map[string]string
+	first   string
+}
+
+func newImporter(ctx context.Context, c *pdq.SimpleClient, boardID int, opts Options) (*importer, error) {
+	im := &importer{
+		client:  c,
+		boardID: boardID,
+		opts:    opts,
+		columns: make(map[string]pdq.Column),
+		groups:  make(map[string]string),
+	}
+	columns, err := c.GetColumns(boardID)
+	if err != nil {
+		return nil, err
+	}
+	find := func(target string) (pdq.Column, bool) {
+		for _, col := range columns {
+			if col.Id == target {
+				return col, true
+			}
+		}
+		for _, col := range columns {
+			if col.Title == target {
+				return col, true
+			}
+		}
+		return pdq.Column{}, false
+	}
+	for header, target := range opts.Mapping {
+		if target == TargetName || target == TargetGroup {
+			continue
+		}
+		col, ok := find(target)
+		if !ok {
+			return nil, fmt.Errorf("no column %q on board %d for header %q", target, boardID, header)
+		}
+		im.columns[header] = col
+	}
+	if opts.KeyColumn != "" {
+		col, ok := find(opts.KeyColumn)
+		if !ok {
+			return nil, fmt.Errorf("no key column %q on board %d", opts.KeyColumn, boardID)
+		}
+		im.key = &col
+	}
+
+	groups, err := c.GetGroups(boardID)
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range groups {
+		im.groups[g.Title] = g.Id
+		if im.first == "" {
+			im.first = g.Id
+		}
+	}
+	return im, nil
+}
+
+// convert converts a record of the file into a row with typed column values.
+func (im *importer) convert(line int, record map[string]string) (row, error) {
+	r := row{line: line, group: im.opts.DefaultGroup}
+	for header, target := range im.opts.Mapping {
+		value, ok := record[header]
+		if !ok {
+			continue
+		}
+		switch target {
+		case TargetName:
+			r.name = value
+			continue
+		case TargetGroup:
+			if value != "" {
+				r.group = value
+			}
+			continue
+		}
+		col := im.columns[header]
+		v, err := pdq.ParseColumnValue(col, value)
+		if err != nil {
+			return row{}, err
+		}
+		if im.key != nil && col.Id == im.key.Id {
+			r.key = value
+		}
+		if col.Type == "name" {
+			r.name = value
+			continue
+		}
+		r.values = append(r.values, v)
+	}
+	if r.name == "" {
+		return row{}, errors.New("item name is empty")
+	}
+	if im.key != nil && im.key.Type == "name" {
+		r.key = r.name
+	}
+	return r, nil
+}
+
+// group returns the identifier of the group with the given title, creating it if it does not exist.
+func (im *importer) group(ctx context.Context, title string) (string, error) {
+	if title == "" {
+		return im.first, nil
+	}
+	if id, ok := im.groups[title]; ok {
+		return id, nil
+	}
+	var data struct {
+		Group pdq.Group `json:"create_group"`
+	}
+	if err := im.client.Do(ctx, monday.NewMutationPayload(
+		monday.Groups.Create(im.boardID, title, []monday.GroupsField{
+			monday.GroupsIDField(),
+			monday.GroupsTitleField(),
+		}),
+	), &data); err != nil {
+		return "", err
+	}
+	im.groups[title] = data.Group.Id
+	return data.Group.Id, nil
+}
+
+// existing returns the identifiers of the items that match the keys of the rows.
+func (im *importer) existing(ctx context.Context, rows []row) (map[int]int, error) {
+	ids := make(map[int]int)
+	if im.key == nil {
+		return ids, nil
+	}
+	var queries []monday.Query
+	for i, r := range rows {
+		if r.key == "" {
+			continue
+		}
+		// the key is matched with the text of the column, so it is sent as is and not as a json string.
+		queries = append(queries, monday.ItemsByColumnValues.List(
+			im.boardID, im.key.Id, monday.NewJSONValue(im.key.Id, r.key),
+			[]monday.ItemsByColumnValuesField{
+				monday.ItemsByColumnValuesIDField(),
+			},
+		).WithAlias(alias(i)))
+	}
+	if len(queries) == 0 {
+		return ids, nil
+	}
+	var data map[string][]pdq.Item
+	if err := im.client.Do(ctx, monday.NewQueryPayload(queries...), &data); err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		if items := data[alias(i)]; len(items) != 0 {
+			ids[i] = items[0].ID()
+		}
+	}
+	return ids, nil
+}
+
+// apply creates or updates the items of the rows in a single request.
+// It returns the lines of the rows that are imported, also if the others failed.
+func (im *importer) apply(ctx context.Context, rows []row) ([]int, int, int, error) {
+	existing, err := im.existing(ctx, rows)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	var mutations []monday.Mutation
+	for i, r := range rows {
+		if id, ok := existing[i]; ok {
+			values := append([]monday.ColumnValue{monday.NewItemNameValue("name", r.name)}, r.values...)
+			mutations = append(mutations, monday.Columns.ChangeMultipleValues(
+				id, im.boardID, monday.EncodeColumnValues(values), []monday.ItemsField{monday.ItemsIDField()},
+			).WithAlias(alias(i)))
+			continue
+		}
+		groupID, err := im.group(ctx, r.group)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		mutations = append(mutations, monday.Items.Create(
+			im.boardID, groupID, r.name, r.values, []monday.ItemsField{monday.ItemsIDField()},
+		).WithAlias(alias(i)))
+	}
+
+	// the rows whose mutation returned an item are imported, also if the request failed for others.
+	var data map[string]*pdq.Item
+	err = im.client.Do(ctx, monday.NewMutationPayload(mutations...), &data)
+	var imported []int
+	var created, updated int
+	for i, r := range rows {
+		if data[alias(i)] == nil {
+			continue
+		}
+		imported = append(imported, r.line)
+		if _, ok := existing[i]; ok {
+			updated++
+		} else {
+			created++
+		}
+	}
+	if err != nil {
+		return imported, created, updated, fmt.Errorf("rows %d-%d: %v", rows[0].line, rows[len(rows)-1].line, err)
+	}
+	return imported, created, updated, nil
+}
+
+func alias(i int) string {
+	return "row" + strconv.Itoa(i)
+}
+
+type recordReader interface {
+	next() (map[string]string, error)
+}
+
+type csvReader struct {
+	r      *csv.Reader
+	header []string
+}
+
+func newCSVReader(r io.Reader) *csvReader {
+	return &csvReader{r: csv.NewReader(r)}
+}
+
+func (r *csvReader) next() (map[string]string, error) {
+	if r.header == nil {
+		header, err := r.r.Read()
+		if err != nil {
+			return nil, err
+		}
+		r.header = header
+	}
+	values, err := r.r.Read()
+	if err != nil {
+		return nil, err
+	}
+	record := make(map[string]string, len(values))
+	for i, v := range values {
+		record[r.header[i]] = v
+	}
+	return record, nil
+}
+
+type ndjsonReader struct {
+	s *bufio.Scanner
+}
+
+func newNDJSONReader(r io.Reader) *ndjsonReader {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	return &ndjsonReader{s}
+}
+
+func (r *ndjsonReader) next() (map[string]string, error) {
+	for r.s.Scan() {
+		line := strings.TrimSpace(r.s.Text())
+		if line == "" {
+			continue
+		}
+		var object map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &object); err != nil {
+			return nil, err
+		}
+		record := make(map[string]string, len(object))
+		for k, v := range object {
+			switch v := v.(type) {
+			case nil:
+				record[k] = ""
+			case string:
+				record[k] = v
+			case float64:
+				record[k] = strconv.FormatFloat(v, 'f', -1, 64)
+			case bool:
+				record[k] = strconv.FormatBool(v)
+			default:
+				return nil, fmt.Errorf("unsupported value for %q, expected a string, number or boolean", k)
+			}
+		}
+		return record, nil
+	}
+	if err := r.s.Err(); err != nil {
+		return nil, err
+	}
+	return nil, io.EOF
+}
@@ -0,0 +1,223 @@
+package importer
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/di-wu/monday"
+	"github.com/di-wu/monday/mondaytest"
+	"github.com/di-wu/monday/pdq"
+)
+
+func TestImport(t *testing.T) {
+	s := mondaytest.NewServer()
+	defer s.Close()
+	c := pdq.NewSimpleClientWithHTTPClient("mondaytest", s.Client())
+
+	board, _ := c.CreateBoard("Import")
+	status, _ := c.CreateStatusColumn(board.ID(), "Status", []string{"Open", "Closed"})
+	key, _ := c.CreateColumn(board.ID(), "Key", monday.ColumnsTypeText())
+
+	mapping := map[string]string{
+		"key":    "Key",
+		"title":  TargetName,
+		"state":  status.Id,
+		"bucket": TargetGroup,
+	}
+	dir, err := ioutil.TempDir("", "importer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	checkpoint := filepath.Join(dir, "checkpoint.json")
+
+	csv := "key,title,state,bucket\n" +
+		"1,a,Open,\n" +
+		"2,b,Closed,Later\n" +
+		"3,c,Open,Later\n"
+	result, err := Import(context.Background(), c, board.ID(), strings.NewReader(csv), Options{
+		Mapping:    mapping,
+		KeyColumn:  key.Title,
+		BatchSize:  2,
+		Checkpoint: checkpoint,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result != (Result{Created: 3}) {
+		t.Errorf("unexpected result %+v", result)
+	}
+	groups, _ := c.GetGroups(board.ID())
+	if len(groups) != 2 {
+		t.Fatalf("got %d groups, expected 2", len(groups))
+	}
+	if items, _ := c.GetItems(board.ID(), groups[1].Id); len(items) != 2 {
+		t.Errorf("got %d items in %s, expected 2", len(items), groups[1].Title)
+	}
+
+	// Resuming skips the imported rows.
+	csv += "4,d,Closed,\n"
+	result, err = Import(context.Background(), c, board.ID(), strings.NewReader(csv), Options{
+		Mapping:    mapping,
+		KeyColumn:  key.Title,
+		Checkpoint: checkpoint,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result != (Result{Created: 1, Skipped: 3}) {
+		t.Errorf("unexpected result %+v", result)
+	}
+
+	// Upserts by key column.
+	ndjson := `{"key":"1","title":"A","state":"Closed"}` + "\n" +
+		`{"key":5,"title":"e","state":"Open"}` + "\n"
+	result, err = Import(context.Background(), c, board.ID(), strings.NewReader(ndjson), Options{
+		Format:    FormatNDJSON(),
+		Mapping:   mapping,
+		KeyColumn: key.Id,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result != (Result{Created: 1, Updated: 1}) {
+		t.Errorf("unexpected result %+v", result)
+	}
+	var lookup bool
+	for _, q := range s.Queries() {
+		if strings.Contains(q, `column_value:"\"`) {
+			t.Errorf("the key is sent as a json string: %s", q)
+		}
+		lookup = lookup || strings.Contains(q, `column_value:"1"`)
+	}
+	if !lookup {
+		t.Error("expected the key to be looked up by its text")
+	}
+	var data struct {
+		Items []struct {
+			ColumnValues []struct {
+				ID, Text string
+			} `json:"column_values"`
+		}
+	}
+	if err := c.Do(context.Background(), monday.NewQueryPayload(monday.Items.List(
+		[]monday.ItemsField{
+			monday.NewItemsColumnValuesField([]monday.ColumnValuesField{
+				monday.ColumnValuesIDField(),
+				monday.ColumnValuesTextField(),
+			}, nil),
+		},
+		monday.NewItemsIDsArgument([]int{mustFind(t, c, board.ID(), "A").ID()}),
+	)), &data); err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range data.Items[0].ColumnValues {
+		if v.ID == status.Id && v.Text != "Closed" {
+			t.Errorf("got status %q, expected Closed", v.Text)
+		}
+	}
+
+	if _, err := Import(context.Background(), c, board.ID(), strings.NewReader(`{"title":["x"]}`), Options{
+		Format:  FormatNDJSON(),
+		Mapping: mapping,
+	}); err == nil {
+		t.Error("expected an error for a list value")
+	}
+}
+
+func mustFind(t *testing.T, c *pdq.SimpleClient, boardID int, name string) pdq.Item {
+	t.Helper()
+	groups, _ := c.GetGroups(boardID)
+	for _, g := range groups {
+		items, _ := c.GetItems(boardID, g.Id)
+		for _, item := range items {
+			if item.Name == name {
+				return item
+			}
+		}
+	}
+	t.Fatalf("no item %q", name)
+	return pdq.Item{}
+}
+
+func TestImportPartialFailure(t *testing.T) {
+	s := mondaytest.NewServer()
+	defer s.Close()
+	c := pdq.NewSimpleClientWithHTTPClient("mondaytest", s.Client())
+
+	board, _ := c.CreateBoard("Import")
+	status, _ := c.CreateStatusColumn(board.ID(), "Status", []string{"Open", "Closed"})
+	key, _ := c.CreateColumn(board.ID(), "Key", monday.ColumnsTypeText())
+
+	mapping := map[string]string{
+		"key":   "Key",
+		"title": TargetName,
+		"state": status.Id,
+	}
+	dir, err := ioutil.TempDir("", "importer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	checkpoint := filepath.Join(dir, "checkpoint.json")
+
+	// The second row fails, the first one is created and must not be created again on resume.
+	csv := "key,title,state\n" +
+		"1,a,Open\n" +
+		"2,b,Unknown\n" +
+		"3,c,Open\n"
+	result, err := Import(context.Background(), c, board.ID(), strings.NewReader(csv), Options{
+		Mapping:    mapping,
+		BatchSize:  3,
+		Checkpoint: checkpoint,
+	})
+	if err == nil {
+		t.Fatal("expected an error for an unknown status")
+	}
+	if result != (Result{Created: 1}) {
+		t.Errorf("unexpected result %+v", result)
+	}
+	result, err = Import(context.Background(), c, board.ID(), strings.NewReader(strings.Replace(csv, "Unknown", "Closed", 1)), Options{
+		Mapping:    mapping,
+		BatchSize:  3,
+		Checkpoint: checkpoint,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result != (Result{Created: 2, Skipped: 1}) {
+		t.Errorf("unexpected result %+v", result)
+	}
+
+	// Rows with the same key in one batch create a single item.
+	csv = "key,title,state\n" +
+		"4,d,Open\n" +
+		"4,D,Closed\n"
+	result, err = Import(context.Background(), c, board.ID(), strings.NewReader(csv), Options{
+		Mapping:   mapping,
+		KeyColumn: key.Title,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result != (Result{Created: 1, Updated: 1}) {
+		t.Errorf("unexpected result %+v", result)
+	}
+
+	groups, _ := c.GetGroups(board.ID())
+	var names []string
+	for _, g := range groups {
+		items, _ := c.GetItems(board.ID(), g.Id)
+		for _, item := range items {
+			names = append(names, item.Name)
+		}
+	}
+	if len(names) != 4 {
+		t.Errorf("got items %v, expected a, b, c and D", names)
+	}
+	mustFind(t, c, board.ID(), "D")
+}
@@ -0,0 +1,44 @@
+package monday
+
+import (
+	"context"
+	"net/http"
+)
+
+// Call is a call of the api that passes through the interceptors of a client.
+type Call struct {
+	// Payload is the payload that is executed.
+	Payload Payload
+	// Documents are the graphql documents that are sent, as the query values of the request.
+	Documents []string
+	// Request is the request that is sent, interceptors can change its headers or replace it.
+	Request *http.Request
+}
+
+// Handler executes a call and returns the response of the api.
+type Handler func(ctx context.Context, call *Call) (*http.Response, error)
+
+// Interceptor wraps the execution of a call. It can inspect or change the call before passing it to next,
+// inspect or replace the response that next returns, or short-circuit by returning a response or an error
+// without calling next. Interceptors that return a response must make sure its body can be closed.
+type Interceptor func(ctx context.Context, call *Call, next Handler) (*http.Response, error)
+
+// Use appends interceptors to the chain of the client, the first interceptor of the chain is the outermost.
+// It is not safe to call Use while the client executes payloads.
+func (c *Client) Use(interceptors ...Interceptor) {
+	c.interceptors = append(c.interceptors, interceptors...)
+}
+
+// handler returns the chain of the interceptors around the http client.
+func (c *Client) handler() Handler {
+	h := func(_ context.Context, call *Call) (*http.Response, error) {
+		return c.client.Do(call.Request)
+	}
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		interceptor, next := c.interceptors[i], h
+		h = func(ctx context.Context, call *Call) (*http.Response, error) {
+			return interceptor(ctx, call, next)
+		}
+	}
+	return h
+}
@@ -0,0 +1,79 @@
+package monday
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestInterceptors(t *testing.T) {
+	transport := new(documentTransport)
+	c := NewClient("token", &http.Client{Transport: transport})
+	var order []string
+	c.Use(
+		func(ctx context.Context, call *Call, next Handler) (*http.Response, error) {
+			order = append(order, "outer")
+			if len(call.Payload.Queries()) != 1 || call.Documents[0] != "{me{id}}" {
+				t.Errorf("unexpected call %+v", call)
+			}
+			resp, err := next(ctx, call)
+			order = append(order, "outer response")
+			return resp, err
+		},
+		func(ctx context.Context, call *Call, next Handler) (*http.Response, error) {
+			order = append(order, "inner")
+			if call.Request.Header.Get("Authorization") != "token" {
+				t.Error("missing authorization header")
+			}
+			call.Request.Header.Set("API-Version", "2023-10")
+			resp, err := next(ctx, call)
+			if err == nil && resp.StatusCode != http.StatusOK {
+				t.Errorf("unexpected status %d", resp.StatusCode)
+			}
+			return resp, err
+		},
+	)
+	payload := NewQueryPayload(NewQuery("me", []Field{NewField("id", nil)}, nil))
+	if err := c.Do(context.Background(), payload, nil); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(order, ", ") != "outer, inner, outer response" {
+		t.Errorf("unexpected order: %v", order)
+	}
+	if len(transport.documents) != 1 {
+		t.Error("the request was not sent")
+	}
+
+	// interceptors can short-circuit the call.
+	transport.documents = nil
+	cached := NewClient("token", &http.Client{Transport: transport})
+	cached.Use(func(ctx context.Context, call *Call, next Handler) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader(`{"data":{"me":{"id":"1"}}}`)),
+		}, nil
+	})
+	var data struct {
+		Me struct {
+			ID string
+		}
+	}
+	if err := cached.Do(context.Background(), payload, &data); err != nil {
+		t.Fatal(err)
+	}
+	if data.Me.ID != "1" || transport.documents != nil {
+		t.Errorf("the call was not short-circuited: %+v", data)
+	}
+
+	errDenied := errors.New("denied")
+	denied := NewClient("token", &http.Client{Transport: transport})
+	denied.Use(func(ctx context.Context, call *Call, next Handler) (*http.Response, error) {
+		return nil, errDenied
+	})
+	if _, err := denied.Exec(context.Background(), payload); err != errDenied || transport.documents != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,639 @@
+// Package graphql parses GraphQL documents. It is shared by the monday package and its fake api in mondaytest,
+// so the library and the fake accept the same syntax.
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Document is a parsed GraphQL document.
+type Document struct {
+	Operations []Operation
+	Fragments  map[string]Fragment
+}
+
+// Operation is a query or mutation of a document.
+type Operation struct {
+	Mutation   bool
+	Variables  []VariableDefinition
+	Selections []Selection
+}
+
+// VariableDefinition is a variable declared by an operation.
+type VariableDefinition struct {
+	Name, Type   string
+	DefaultValue interface{}
+}
+
+// Fragment is a named selection of fields.
+type Fragment struct {
+	Name       string
+	Selections []Selection
+}
+
+// Selection is a (nested) field of an operation, or the spread of a fragment.
+type Selection struct {
+	Alias, Name string
+	Args        []Argument
+	Directives  []Directive
+	Selections  []Selection
+	// Spread is the name of a spread fragment, inline fragments have no name and no spread.
+	Spread string
+	Inline bool
+	// On is the type condition of an inline fragment, if any.
+	On string
+}
+
+// Key returns the name under which the selection is returned in the response.
+func (s Selection) Key() string {
+	if s.Alias != "" {
+		return s.Alias
+	}
+	return s.Name
+}
+
+// Argument is an argument of a field or a directive. Values are strings, ints, float64s, bools, nil, Enums,
+// Variables, []interface{} and map[string]interface{}.
+type Argument struct {
+	Name  string
+	Value interface{}
+}
+
+// Directive is a directive of a selection, e.g. @include(if: $all).
+type Directive struct {
+	Name string
+	Args []Argument
+}
+
+// Variable is a reference to a variable, e.g. $id.
+type Variable string
+
+// Enum is an enum value, e.g. public.
+type Enum string
+
+// EnumValue returns the value as it appears in a document.
+func (e Enum) EnumValue() string {
+	return string(e)
+}
+
+// SyntaxError is an error in the syntax of a document.
+type SyntaxError struct {
+	Line, Column int
+	Message      string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Message)
+}
+
+// Parse parses the document, fragments and variables are not resolved, see Document.Resolve.
+func Parse(src string) (Document, error) {
+	p := parser{src: src}
+	document := Document{Fragments: make(map[string]Fragment)}
+	for {
+		p.skip()
+		if p.pos >= len(p.src) {
+			break
+		}
+		if p.peek() == '{' {
+			selections, err := p.selectionSet()
+			if err != nil {
+				return Document{}, err
+			}
+			document.Operations = append(document.Operations, Operation{Selections: selections})
+			continue
+		}
+		switch keyword := p.name(); keyword {
+		case "query", "mutation":
+			operation, err := p.operation(keyword == "mutation")
+			if err != nil {
+				return Document{}, err
+			}
+			document.Operations = append(document.Operations, operation)
+		case "fragment":
+			fragment, err := p.fragment()
+			if err != nil {
+				return Document{}, err
+			}
+			if _, ok := document.Fragments[fragment.Name]; ok {
+				return Document{}, p.errorf("duplicate fragment %q", fragment.Name)
+			}
+			document.Fragments[fragment.Name] = fragment
+		case "subscription":
+			return Document{}, p.errorf("subscriptions are not supported")
+		default:
+			return Document{}, p.errorf("expected an operation or a fragment")
+		}
+	}
+	if len(document.Operations) == 0 {
+		return Document{}, p.errorf("no operations in document")
+	}
+	return document, nil
+}
+
+type parser struct {
+	src string
+	pos int
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return &SyntaxError{
+		Line:    1 + strings.Count(p.src[:p.pos], "\n"),
+		Column:  p.pos - strings.LastIndex(p.src[:p.pos], "\n"),
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func (p *parser) peek() byte {
+	if p.pos >= len(p.src) {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+// skip skips the ignored tokens: white space, line terminators, commas, comments and the byte order mark.
+func (p *parser) skip() {
+	for p.pos < len(p.src) {
+		switch c := p.src[p.pos]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			p.pos++
+		case c == '#':
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' && p.src[p.pos] != '\r' {
+				p.pos++
+			}
+		case strings.HasPrefix(p.src[p.pos:], "\ufeff"):
+			p.pos += len("\ufeff")
+		default:
+			return
+		}
+	}
+}
+
+// consume skips the ignored tokens and reports whether the next token is the given punctuator, consuming it if so.
+func (p *parser) consume(punctuator string) bool {
+	p.skip()
+	if strings.HasPrefix(p.src[p.pos:], punctuator) {
+		p.pos += len(punctuator)
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(punctuator string) error {
+	if !p.consume(punctuator) {
+		return p.errorf("expected %q", punctuator)
+	}
+	return nil
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+// name returns the next name, or an empty string if the next token is not a name.
+func (p *parser) name() string {
+	p.skip()
+	start := p.pos
+	if !isNameStart(p.peek()) {
+		return ""
+	}
+	for p.pos < len(p.src) && (isNameStart(p.src[p.pos]) || '0' <= p.src[p.pos] && p.src[p.pos] <= '9') {
+		p.pos++
+	}
+	return p.src[start:p.pos]
+}
+
+func (p *parser) operation(mutation bool) (Operation, error) {
+	operation := Operation{Mutation: mutation}
+	p.name() // the name of the operation is optional.
+	if p.consume("(") {
+		for !p.consume(")") {
+			if err := p.expect("$"); err != nil {
+				return operation, err
+			}
+			variable := VariableDefinition{Name: p.name()}
+			if variable.Name == "" {
+				return operation, p.errorf("expected a variable name")
+			}
+			if err := p.expect(":"); err != nil {
+				return operation, err
+			}
+			typ, err := p.typeReference()
+			if err != nil {
+				return operation, err
+			}
+			variable.Type = typ
+			if p.consume("=") {
+				if variable.DefaultValue, err = p.value(true); err != nil {
+					return operation, err
+				}
+			}
+			if _, err := p.directives(); err != nil {
+				return operation, err
+			}
+			operation.Variables = append(operation.Variables, variable)
+		}
+	}
+	directives, err := p.directives()
+	if err != nil {
+		return operation, err
+	}
+	if len(directives) != 0 {
+		return operation, p.errorf("directives on operations are not supported")
+	}
+	operation.Selections, err = p.selectionSet()
+	return operation, err
+}
+
+func (p *parser) typeReference() (string, error) {
+	var typ string
+	if p.consume("[") {
+		elem, err := p.typeReference()
+		if err != nil {
+			return "", err
+		}
+		if err := p.expect("]"); err != nil {
+			return "", err
+		}
+		typ = "[" + elem + "]"
+	} else if typ = p.name(); typ == "" {
+		return "", p.errorf("expected a type")
+	}
+	if p.consume("!") {
+		typ += "!"
+	}
+	return typ, nil
+}
+
+func (p *parser) fragment() (Fragment, error) {
+	fragment := Fragment{Name: p.name()}
+	if fragment.Name == "" || fragment.Name == "on" {
+		return fragment, p.errorf("expected a fragment name")
+	}
+	if p.name() != "on" {
+		return fragment, p.errorf("expected a type condition")
+	}
+	if p.name() == "" {
+		return fragment, p.errorf("expected a type")
+	}
+	directives, err := p.directives()
+	if err != nil {
+		return fragment, err
+	}
+	if len(directives) != 0 {
+		return fragment, p.errorf("directives on fragment definitions are not supported")
+	}
+	fragment.Selections, err = p.selectionSet()
+	return fragment, err
+}
+
+func (p *parser) selectionSet() ([]Selection, error) {
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	var selections []Selection
+	for !p.consume("}") {
+		if p.pos >= len(p.src) {
+			return nil, p.errorf("unterminated selection set")
+		}
+		s, err := p.selection()
+		if err != nil {
+			return nil, err
+		}
+		selections = append(selections, s)
+	}
+	if len(selections) == 0 {
+		return nil, p.errorf("empty selection set")
+	}
+	return selections, nil
+}
+
+func (p *parser) selection() (Selection, error) {
+	var s Selection
+	var err error
+	if p.consume("...") {
+		switch name := p.name(); name {
+		case "on", "":
+			if name == "on" {
+				if s.On = p.name(); s.On == "" {
+					return s, p.errorf("expected a type")
+				}
+			}
+			s.Inline = true
+			if s.Directives, err = p.directives(); err != nil {
+				return s, err
+			}
+			s.Selections, err = p.selectionSet()
+			return s, err
+		default:
+			s.Spread = name
+			s.Directives, err = p.directives()
+			return s, err
+		}
+	}
+	if s.Name = p.name(); s.Name == "" {
+		return s, p.errorf("expected a field")
+	}
+	if p.consume(":") {
+		s.Alias = s.Name
+		if s.Name = p.name(); s.Name == "" {
+			return s, p.errorf("expected a field")
+		}
+	}
+	if s.Args, err = p.arguments(false); err != nil {
+		return s, err
+	}
+	if s.Directives, err = p.directives(); err != nil {
+		return s, err
+	}
+	p.skip()
+	if p.peek() == '{' {
+		s.Selections, err = p.selectionSet()
+	}
+	return s, err
+}
+
+func (p *parser) arguments(constant bool) ([]Argument, error) {
+	if !p.consume("(") {
+		return nil, nil
+	}
+	var args []Argument
+	for !p.consume(")") {
+		a := Argument{Name: p.name()}
+		if a.Name == "" {
+			return nil, p.errorf("expected an argument")
+		}
+		if err := p.expect(":"); err != nil {
+			return nil, err
+		}
+		var err error
+		if a.Value, err = p.value(constant); err != nil {
+			return nil, err
+		}
+		args = append(args, a)
+	}
+	if len(args) == 0 {
+		return nil, p.errorf("empty arguments")
+	}
+	return args, nil
+}
+
+func (p *parser) directives() ([]Directive, error) {
+	var directives []Directive
+	for p.consume("@") {
+		d := Directive{Name: p.name()}
+		if d.Name == "" {
+			return nil, p.errorf("expected a directive")
+		}
+		var err error
+		if d.Args, err = p.arguments(false); err != nil {
+			return nil, err
+		}
+		directives = append(directives, d)
+	}
+	return directives, nil
+}
+
+// value parses a value, constant values (e.g. default values) can not contain variables.
+func (p *parser) value(constant bool) (interface{}, error) {
+	p.skip()
+	switch c := p.peek(); {
+	case c == '$':
+		if constant {
+			return nil, p.errorf("unexpected variable in constant value")
+		}
+		p.pos++
+		name := p.name()
+		if name == "" {
+			return nil, p.errorf("expected a variable name")
+		}
+		return Variable(name), nil
+	case c == '"':
+		return p.string()
+	case c == '-' || '0' <= c && c <= '9':
+		return p.number()
+	case c == '[':
+		p.pos++
+		list := make([]interface{}, 0)
+		for !p.consume("]") {
+			if p.pos >= len(p.src) {
+				return nil, p.errorf("unterminated list")
+			}
+			v, err := p.value(constant)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, v)
+		}
+		return list, nil
+	case c == '{':
+		p.pos++
+		object := make(map[string]interface{})
+		for !p.consume("}") {
+			name := p.name()
+			if name == "" {
+				return nil, p.errorf("expected an object field")
+			}
+			if err := p.expect(":"); err != nil {
+				return nil, err
+			}
+			v, err := p.value(constant)
+			if err != nil {
+				return nil, err
+			}
+			object[name] = v
+		}
+		return object, nil
+	case isNameStart(c):
+		switch name := p.name(); name {
+		case "true", "false":
+			return name == "true", nil
+		case "null":
+			return nil, nil
+		default:
+			return Enum(name), nil
+		}
+	}
+	return nil, p.errorf("expected a value")
+}
+
+func (p *parser) number() (interface{}, error) {
+	start := p.pos
+	digits := func() int {
+		n := 0
+		for p.pos < len(p.src) && '0' <= p.src[p.pos] && p.src[p.pos] <= '9' {
+			p.pos++
+			n++
+		}
+		return n
+	}
+	if p.peek() == '-' {
+		p.pos++
+	}
+	if p.peek() == '0' {
+		p.pos++
+	} else if digits() == 0 {
+		return nil, p.errorf("invalid number")
+	}
+	float := false
+	if p.peek() == '.' {
+		float = true
+		p.pos++
+		if digits() == 0 {
+			return nil, p.errorf("invalid number")
+		}
+	}
+	if c := p.peek(); c == 'e' || c == 'E' {
+		float = true
+		p.pos++
+		if c := p.peek(); c == '+' || c == '-' {
+			p.pos++
+		}
+		if digits() == 0 {
+			return nil, p.errorf("invalid number")
+		}
+	}
+	if c := p.peek(); c == '.' || isNameStart(c) || '0' <= c && c <= '9' {
+		return nil, p.errorf("invalid number")
+	}
+	raw := p.src[start:p.pos]
+	if float {
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, p.errorf("invalid float %s", raw)
+		}
+		return f, nil
+	}
+	i, err := strconv.Atoi(raw)
+	if err != nil {
+		return nil, p.errorf("invalid int %s", raw)
+	}
+	return i, nil
+}
+
+func (p *parser) string() (string, error) {
+	if strings.HasPrefix(p.src[p.pos:], `"""`) {
+		return p.blockString()
+	}
+	p.pos++
+	var b strings.Builder
+	for {
+		if p.pos >= len(p.src) {
+			return "", p.errorf("unterminated string")
+		}
+		r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+		switch {
+		case r == '"':
+			p.pos++
+			return b.String(), nil
+		case r == '\n' || r == '\r':
+			return "", p.errorf("unterminated string")
+		case r < 0x20 && r != '\t':
+			return "", p.errorf("invalid character %U in string", r)
+		case r != '\\':
+			b.WriteRune(r)
+			p.pos += size
+			continue
+		}
+		p.pos++
+		escape := p.peek()
+		p.pos++
+		switch escape {
+		case '"', '\\', '/':
+			b.WriteByte(escape)
+		case 'b':
+			b.WriteByte('\b')
+		case 'f':
+			b.WriteByte('\f')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 't':
+			b.WriteByte('\t')
+		case 'u':
+			r, err := p.unicode()
+			if err != nil {
+				return "", err
+			}
+			if 0xd800 <= r && r < 0xdc00 && strings.HasPrefix(p.src[p.pos:], `\u`) {
+				p.pos += 2
+				low, err := p.unicode()
+				if err != nil {
+					return "", err
+				}
+				r = (r-0xd800)<<10 + (low - 0xdc00) + 0x10000
+			}
+			b.WriteRune(r)
+		default:
+			p.pos--
+			return "", p.errorf("invalid escape sequence")
+		}
+	}
+}
+
+func (p *parser) unicode() (rune, error) {
+	if p.pos+4 > len(p.src) {
+		return 0, p.errorf("invalid unicode escape sequence")
+	}
+	v, err := strconv.ParseUint(p.src[p.pos:p.pos+4], 16, 16)
+	if err != nil {
+		return 0, p.errorf("invalid unicode escape sequence")
+	}
+	p.pos += 4
+	return rune(v), nil
+}
+
+// blockString parses a block string and removes its common indentation, as defined by the specification.
+func (p *parser) blockString() (string, error) {
+	p.pos += 3
+	var raw strings.Builder
+	for {
+		switch {
+		case p.pos >= len(p.src):
+			return "", p.errorf("unterminated block string")
+		case strings.HasPrefix(p.src[p.pos:], `\"""`):
+			raw.WriteString(`"""`)
+			p.pos += 4
+			continue
+		case strings.HasPrefix(p.src[p.pos:], `"""`):
+			p.pos += 3
+			return blockStringValue(raw.String()), nil
+		}
+		raw.WriteByte(p.src[p.pos])
+		p.pos++
+	}
+}
+
+func blockStringValue(raw string) string {
+	lines := strings.Split(strings.Replace(strings.Replace(raw, "\r\n", "\n", -1), "\r", "\n", -1), "\n")
+	indent := -1
+	for _, line := range lines[1:] {
+		trimmed := strings.TrimLeft(line, " \t")
+		if trimmed == "" {
+			continue
+		}
+		if n := len(line) - len(trimmed); indent < 0 || n < indent {
+			indent = n
+		}
+	}
+	if indent > 0 {
+		for i := 1; i < len(lines); i++ {
+			if len(lines[i]) >= indent {
+				lines[i] = lines[i][indent:]
+			} else {
+				lines[i] = strings.TrimLeft(lines[i], " \t")
+			}
+		}
+	}
+	for len(lines) != 0 && strings.TrimLeft(lines[0], " \t") == "" {
+		lines = lines[1:]
+	}
+	for len(lines) != 0 && strings.TrimLeft(lines[len(lines)-1], " \t") == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return strings.Join(lines, "\n")
+}
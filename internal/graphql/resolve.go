@@ -0,0 +1,174 @@
+package graphql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Resolve returns the operations of the document with the fragments inlined into the selections that spread
+// them, the @include and @skip directives applied and the variables replaced by the given values, or by their
+// default value if no value is given. Arguments with a declared variable that has no value are left out.
+// Inline fragments with a type condition are kept, since their fields only apply to objects of that type.
+func (d Document) Resolve(variables map[string]interface{}) ([]Operation, error) {
+	var operations []Operation
+	for _, operation := range d.Operations {
+		r := resolver{
+			fragments: d.Fragments,
+			variables: make(map[string]interface{}),
+			declared:  make(map[string]bool),
+			spreading: make(map[string]bool),
+		}
+		for _, definition := range operation.Variables {
+			r.declared[definition.Name] = true
+			if value, ok := variables[definition.Name]; ok {
+				r.variables[definition.Name] = value
+				continue
+			}
+			if definition.DefaultValue != nil {
+				value, err := r.value(definition.DefaultValue)
+				if err != nil {
+					return nil, err
+				}
+				r.variables[definition.Name] = value
+				continue
+			}
+			if strings.HasSuffix(definition.Type, "!") {
+				return nil, fmt.Errorf("missing value for variable $%s of type %s", definition.Name, definition.Type)
+			}
+		}
+		selections, err := r.selections(operation.Selections)
+		if err != nil {
+			return nil, err
+		}
+		operations = append(operations, Operation{Mutation: operation.Mutation, Selections: selections})
+	}
+	return operations, nil
+}
+
+// resolver resolves the selections of an operation.
+type resolver struct {
+	fragments map[string]Fragment
+	variables map[string]interface{}
+	declared  map[string]bool
+	// spreading are the fragments that are being spread, to detect cycles.
+	spreading map[string]bool
+}
+
+// selections applies the directives, inlines the fragments and replaces the variables of the selections.
+func (r *resolver) selections(selections []Selection) ([]Selection, error) {
+	var result []Selection
+	for _, s := range selections {
+		include, err := r.include(s.Directives)
+		if err != nil {
+			return nil, err
+		}
+		if !include {
+			continue
+		}
+		switch {
+		case s.Inline:
+			inlined, err := r.selections(s.Selections)
+			if err != nil {
+				return nil, err
+			}
+			if s.On != "" {
+				// the type condition limits the fields to objects of the type, so the fragment is kept.
+				result = append(result, Selection{Inline: true, On: s.On, Selections: inlined})
+				continue
+			}
+			result = append(result, inlined...)
+		case s.Spread != "":
+			fragment, ok := r.fragments[s.Spread]
+			if !ok {
+				return nil, fmt.Errorf("unknown fragment %q", s.Spread)
+			}
+			if r.spreading[s.Spread] {
+				return nil, fmt.Errorf("fragment %q spreads itself", s.Spread)
+			}
+			r.spreading[s.Spread] = true
+			inlined, err := r.selections(fragment.Selections)
+			delete(r.spreading, s.Spread)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, inlined...)
+		default:
+			field := Selection{Alias: s.Alias, Name: s.Name}
+			for _, a := range s.Args {
+				if ref, ok := a.Value.(Variable); ok {
+					if _, ok := r.variables[string(ref)]; !ok && r.declared[string(ref)] {
+						// an argument with a variable without a value is not provided.
+						continue
+					}
+				}
+				value, err := r.value(a.Value)
+				if err != nil {
+					return nil, err
+				}
+				field.Args = append(field.Args, Argument{a.Name, value})
+			}
+			if field.Selections, err = r.selections(s.Selections); err != nil {
+				return nil, err
+			}
+			result = append(result, field)
+		}
+	}
+	return result, nil
+}
+
+// include evaluates the @include and @skip directives.
+func (r *resolver) include(directives []Directive) (bool, error) {
+	for _, d := range directives {
+		if d.Name != "include" && d.Name != "skip" {
+			return false, fmt.Errorf("unsupported directive @%s", d.Name)
+		}
+		if len(d.Args) != 1 || d.Args[0].Name != "if" {
+			return false, fmt.Errorf("@%s expects an if argument", d.Name)
+		}
+		value, err := r.value(d.Args[0].Value)
+		if err != nil {
+			return false, err
+		}
+		condition, ok := value.(bool)
+		if !ok {
+			return false, fmt.Errorf("@%s expects a boolean, got %v", d.Name, value)
+		}
+		if condition != (d.Name == "include") {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// value replaces the variables in the value by their values.
+func (r *resolver) value(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case Variable:
+		value, ok := r.variables[string(v)]
+		if !ok {
+			return nil, fmt.Errorf("undefined variable $%s", v)
+		}
+		return value, nil
+	case []interface{}:
+		list := make([]interface{}, len(v))
+		for i, elem := range v {
+			value, err := r.value(elem)
+			if err != nil {
+				return nil, err
+			}
+			list[i] = value
+		}
+		return list, nil
+	case map[string]interface{}:
+		object := make(map[string]interface{}, len(v))
+		for name, field := range v {
+			value, err := r.value(field)
+			if err != nil {
+				return nil, err
+			}
+			object[name] = value
+		}
+		return object, nil
+	}
+	return v, nil
+}
@@ -0,0 +1,230 @@
+// Package introspection decodes the schema of a GraphQL api, as returned by an introspection query.
+//
+// The schema of the monday api can be downloaded by running Query, e.g. with NewRawPayload of the monday package,
+// and storing the json response in a file. Parse accepts the full response or only its __schema object.
+package introspection
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Query is the introspection query that returns the schema as expected by Parse.
+const Query = `query IntrospectionQuery {
+  __schema {
+    queryType { name }
+    mutationType { name }
+    subscriptionType { name }
+    types { ...FullType }
+  }
+}
+
+fragment FullType on __Type {
+  kind
+  name
+  description
+  fields(includeDeprecated: true) {
+    name
+    description
+    args { ...InputValue }
+    type { ...TypeRef }
+    isDeprecated
+    deprecationReason
+  }
+  inputFields { ...InputValue }
+  interfaces { ...TypeRef }
+  enumValues(includeDeprecated: true) {
+    name
+    description
+    isDeprecated
+    deprecationReason
+  }
+  possibleTypes { ...TypeRef }
+}
+
+fragment InputValue on __InputValue {
+  name
+  description
+  type { ...TypeRef }
+  defaultValue
+}
+
+fragment TypeRef on __Type {
+  kind
+  name
+  ofType { kind name ofType { kind name ofType { kind name ofType { kind name ofType { kind name } } } } }
+}`
+
+// Kind is the kind of a type.
+type Kind string
+
+const (
+	KindScalar      Kind = "SCALAR"
+	KindObject      Kind = "OBJECT"
+	KindInterface   Kind = "INTERFACE"
+	KindUnion       Kind = "UNION"
+	KindEnum        Kind = "ENUM"
+	KindInputObject Kind = "INPUT_OBJECT"
+	KindList        Kind = "LIST"
+	KindNonNull     Kind = "NON_NULL"
+)
+
+// Schema is the schema of a GraphQL api.
+type Schema struct {
+	QueryType        *TypeName `json:"queryType"`
+	MutationType     *TypeName `json:"mutationType"`
+	SubscriptionType *TypeName `json:"subscriptionType"`
+	Types            []Type    `json:"types"`
+
+	types map[string]*Type
+}
+
+// TypeName refers to a type by its name.
+type TypeName struct {
+	Name string `json:"name"`
+}
+
+// Type is a named type of the schema.
+type Type struct {
+	Kind          Kind         `json:"kind"`
+	Name          string       `json:"name"`
+	Description   string       `json:"description"`
+	Fields        []Field      `json:"fields"`
+	InputFields   []InputValue `json:"inputFields"`
+	Interfaces    []TypeRef    `json:"interfaces"`
+	EnumValues    []EnumValue  `json:"enumValues"`
+	PossibleTypes []TypeRef    `json:"possibleTypes"`
+}
+
+// Field is a field of an object or interface.
+type Field struct {
+	Name              string       `json:"name"`
+	Description       string       `json:"description"`
+	Args              []InputValue `json:"args"`
+	Type              TypeRef      `json:"type"`
+	IsDeprecated      bool         `json:"isDeprecated"`
+	DeprecationReason string       `json:"deprecationReason"`
+}
+
+// InputValue is an argument of a field or a field of an input object.
+type InputValue struct {
+	Name         string  `json:"name"`
+	Description  string  `json:"description"`
+	Type         TypeRef `json:"type"`
+	DefaultValue *string `json:"defaultValue"`
+}
+
+// EnumValue is a value of an enum.
+type EnumValue struct {
+	Name              string `json:"name"`
+	Description       string `json:"description"`
+	IsDeprecated      bool   `json:"isDeprecated"`
+	DeprecationReason string `json:"deprecationReason"`
+}
+
+// TypeRef is a reference to a type, lists and non null types wrap the type they modify.
+type TypeRef struct {
+	Kind   Kind     `json:"kind"`
+	Name   string   `json:"name"`
+	OfType *TypeRef `json:"ofType"`
+}
+
+// Parse decodes a schema from the json result of the introspection query.
+func Parse(r io.Reader) (*Schema, error) {
+	var body struct {
+		Data struct {
+			Schema *Schema `json:"__schema"`
+		} `json:"data"`
+		Schema *Schema `json:"__schema"`
+	}
+	if err := json.NewDecoder(r).Decode(&body); err != nil {
+		return nil, fmt.Errorf("invalid introspection result: %v", err)
+	}
+	schema := body.Data.Schema
+	if schema == nil {
+		schema = body.Schema
+	}
+	if schema == nil {
+		return nil, errors.New("invalid introspection result: no __schema")
+	}
+	schema.types = make(map[string]*Type)
+	for i := range schema.Types {
+		schema.types[schema.Types[i].Name] = &schema.Types[i]
+	}
+	return schema, nil
+}
+
+// Type returns the type with the given name, or nil if it does not exist.
+func (s *Schema) Type(name string) *Type {
+	return s.types[name]
+}
+
+// Query returns the root query type.
+func (s *Schema) Query() *Type {
+	if s.QueryType == nil {
+		return nil
+	}
+	return s.Type(s.QueryType.Name)
+}
+
+// Mutation returns the root mutation type, or nil if the schema has no mutations.
+func (s *Schema) Mutation() *Type {
+	if s.MutationType == nil {
+		return nil
+	}
+	return s.Type(s.MutationType.Name)
+}
+
+// Field returns the field with the given name, or nil if it does not exist.
+func (t *Type) Field(name string) *Field {
+	for i := range t.Fields {
+		if t.Fields[i].Name == name {
+			return &t.Fields[i]
+		}
+	}
+	return nil
+}
+
+// Arg returns the argument with the given name, or nil if it does not exist.
+func (f *Field) Arg(name string) *InputValue {
+	for i := range f.Args {
+		if f.Args[i].Name == name {
+			return &f.Args[i]
+		}
+	}
+	return nil
+}
+
+// NonNull reports whether the type is a non null type.
+func (t TypeRef) NonNull() bool {
+	return t.Kind == KindNonNull
+}
+
+// Elem returns the type that a list or non null type wraps.
+func (t TypeRef) Elem() TypeRef {
+	if t.OfType == nil {
+		return TypeRef{}
+	}
+	return *t.OfType
+}
+
+// Named returns the named type, without the list and non null modifiers.
+func (t TypeRef) Named() TypeRef {
+	for t.OfType != nil && (t.Kind == KindList || t.Kind == KindNonNull) {
+		t = *t.OfType
+	}
+	return t
+}
+
+// String returns the type in GraphQL notation, e.g. [Int!]!.
+func (t TypeRef) String() string {
+	switch t.Kind {
+	case KindNonNull:
+		return t.Elem().String() + "!"
+	case KindList:
+		return "[" + t.Elem().String() + "]"
+	}
+	return t.Name
+}
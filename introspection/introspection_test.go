@@ -0,0 +1,47 @@
+package introspection
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	f, err := os.Open("../api/schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	schema, err := Parse(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	boards := schema.Query().Field("boards")
+	if boards == nil {
+		t.Fatal("no boards query")
+	}
+	if got := boards.Type.String(); got != "[Board]" {
+		t.Errorf("got type %s, expected [Board]", got)
+	}
+	if got := boards.Arg("ids").Type.String(); got != "[Int]" {
+		t.Errorf("got ids type %s, expected [Int]", got)
+	}
+	create := schema.Mutation().Field("create_board")
+	if kind := create.Arg("board_kind").Type; !kind.NonNull() || kind.Named().Name != "BoardKind" {
+		t.Errorf("unexpected board_kind type %s", kind)
+	}
+	if schema.Type("BoardKind").Kind != KindEnum {
+		t.Error("BoardKind is not an enum")
+	}
+	if schema.Type("Unknown") != nil {
+		t.Error("expected no Unknown type")
+	}
+
+	if _, err := Parse(strings.NewReader(`{"data": {}}`)); err == nil {
+		t.Error("expected an error without __schema")
+	}
+	schema, err = Parse(strings.NewReader(`{"__schema": {"queryType": {"name": "Query"}, "types": [{"kind": "OBJECT", "name": "Query"}]}}`))
+	if err != nil || schema.Query() == nil || schema.Mutation() != nil {
+		t.Errorf("unexpected schema %v: %v", schema, err)
+	}
+}
@@ -1,7 +1,5 @@
 package monday
 
-import "fmt"
-
 // ItemsService handles all the item related methods of the Monday API.
 // Items are the objects that hold the actual data within the board, to better illustrate this,
 // you can think of a board as a table and a item as a single row in that table.
@@ -28,12 +26,8 @@ func (*ItemsService) Create(boardID int, groupID, name string, values []ColumnVa
 		{"group_id", groupID},
 		{"item_name", name},
 	}
-	var columnValues string
-	for _, v := range values {
-		columnValues += fmt.Sprintf(`{%q:%s}`, v.id, v.value)
-	}
-	if columnValues != "" {
-		args = append(args, argument{"column_values", columnValues})
+	if len(values) != 0 {
+		args = append(args, argument{"column_values", EncodeColumnValues(values)})
 	}
 	return Mutation{
 		name:   "create_item",
@@ -42,6 +36,37 @@ func (*ItemsService) Create(boardID int, groupID, name string, values []ColumnVa
 	}
 }
 
+// CreateSubitem returns a mutation that allows you to create a new subitem under an item.
+// The subitem is created on the subitems board of the item's board, so its column values refer to the columns
+// of that board.
+// - parentItemID: the parent item's unique identifier.
+// - name: the new subitem's name.
+// - values: the column values of the new subitem.
+//
+// DOCS: https://monday.com/developers/v2#mutations-section-subitems-create
+func (*ItemsService) CreateSubitem(parentItemID int, name string, values []ColumnValue, itemsFields []ItemsField) Mutation {
+	if len(itemsFields) == 0 {
+		itemsFields = append(itemsFields, itemsIDField)
+	}
+
+	var fields []field
+	for _, i := range itemsFields {
+		fields = append(fields, i.field)
+	}
+	args := []argument{
+		{"parent_item_id", parentItemID},
+		{"item_name", name},
+	}
+	if len(values) != 0 {
+		args = append(args, argument{"column_values", EncodeColumnValues(values)})
+	}
+	return Mutation{
+		name:   "create_subitem",
+		fields: fields,
+		args:   args,
+	}
+}
+
 // MoveToGroup returns a mutation that allows you to move a item between groups in the same board.
 // - itemID: the item's unique identifier.
 // - groupID: the group's unique identifier.
@@ -204,6 +229,13 @@ func ItemsStateField() ItemsField {
 	return itemsStateField
 }
 
+// The item's subitems.
+func NewItemsSubitemsField(subitemsFields []ItemsField, subitemsArgs []ItemsArgument) ItemsField {
+	subitems := Items.List(subitemsFields, subitemsArgs...)
+	subitems.name = "subitems"
+	return ItemsField{field{"subitems", &subitems}}
+}
+
 // The pulses's subscribers.
 func NewItemsSubscribersField(subscribersFields []UsersField, subscribersArgs []UsersArgument) ItemsField {
 	subscribers := Users.List(subscribersFields, subscribersArgs...)
@@ -246,3 +278,27 @@ func NewItemsIDsArgument(ids []int) ItemsArgument {
 func NewItemsNewestFirst(value bool) ItemsArgument {
 	return ItemsArgument{argument{"newest_first", value}}
 }
+
+// The item's graphql fragment, a named selection of item's fields that is defined once per payload.
+type ItemsFragment struct {
+	fragment Fragment
+}
+
+// NewItemsFragment returns the fragment with the given name of the item's fields.
+func NewItemsFragment(name string, itemsFields []ItemsField) ItemsFragment {
+	var fields []field
+	for _, f := range itemsFields {
+		fields = append(fields, f.field)
+	}
+	return ItemsFragment{Fragment{name: name, on: "Item", fields: fields}}
+}
+
+// Spreads the fragment of the item's fields.
+func ItemsFragmentField(fragment ItemsFragment) ItemsField {
+	return ItemsField{fragment.fragment.spread()}
+}
+
+// Spreads the fragment of the item's fields.
+func ItemsByColumnValuesFragmentField(fragment ItemsFragment) ItemsByColumnValuesField {
+	return ItemsByColumnValuesField{fragment.fragment.spread()}
+}
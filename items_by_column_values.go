@@ -163,5 +163,5 @@ func NewItemsByColumnValuesColumnTypeArgument(value string) ItemsByColumnValuesA
 
 // The state of the item (all / active / archived / deleted), the default is active.
 func NewItemsByColumnValuesStateArgument(state State) ItemsByColumnValuesArgument {
-	return ItemsByColumnValuesArgument{argument{"state", state.state}}
+	return ItemsByColumnValuesArgument{argument{"state", state}}
 }
@@ -0,0 +1,412 @@
+package monday
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Journal appends the mutations that a client sends to a JSONL file, so they can be reviewed and undone,
+// see Client.Journal.
+type Journal struct {
+	client *Client
+	mu     sync.Mutex
+	file   *os.File
+}
+
+// JournalEntry is a mutation in a journal.
+type JournalEntry struct {
+	// ID is the unique identifier of the entry, see Journal.Undo.
+	ID string `json:"id"`
+	// Time is the time the mutation was sent.
+	Time time.Time `json:"time"`
+	// Mutation is the name of the mutation, e.g. change_column_value.
+	Mutation string `json:"mutation"`
+	// Arguments are the arguments of the mutation, with their values as graphql literals.
+	Arguments map[string]string `json:"arguments,omitempty"`
+	// BoardID is the board that the mutation targets, or 0 if it does not name one.
+	BoardID int `json:"board_id,omitempty"`
+	// ItemID is the item that the mutation targets, or 0 if it does not name one.
+	ItemID int `json:"item_id,omitempty"`
+	// Prior is the state of the item before the mutation, for the mutations that can be undone.
+	Prior *JournalPrior `json:"prior,omitempty"`
+	// Result is the result of the mutation, as returned by the api.
+	Result json.RawMessage `json:"result,omitempty"`
+	// Errors are the errors of the mutation, or of the call if they can not be assigned to the mutation.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// JournalPrior is the state of an item before a mutation.
+type JournalPrior struct {
+	// Values are the values of the changed columns by their identifiers, as returned by the api.
+	// The value of an empty column is nil.
+	Values map[string]*string `json:"values,omitempty"`
+	// GroupID is the group of a moved item.
+	GroupID string `json:"group_id,omitempty"`
+}
+
+// IrreversibleError is returned by Journal.Undo for entries that can not be undone.
+type IrreversibleError struct {
+	// EntryID is the identifier of the entry.
+	EntryID string
+	// Mutation is the name of the mutation of the entry.
+	Mutation string
+	// Reason is the reason the mutation can not be undone.
+	Reason string
+}
+
+func (e IrreversibleError) Error() string {
+	return fmt.Sprintf("monday: mutation %s of journal entry %s can not be undone: %s", e.Mutation, e.EntryID, e.Reason)
+}
+
+// Journal appends every mutation that the client sends to the JSONL file at the given path, with its arguments,
+// the prior state of the item it changes and its result. The prior values of the columns are fetched before
+// change_column_value and change_multiple_column_values, the prior group before move_item_to_group.
+// Each entry is synced to disk once the result is known.
+//
+// The journal is an interceptor that is appended to the chain of the client, interceptors that are used after
+// calling Journal see the queries of the prior values. Raw documents are parsed to find their mutations.
+func (c *Client) Journal(path string) (*Journal, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+	j := &Journal{client: c, file: file}
+	c.Use(j.intercept)
+	return j, nil
+}
+
+// Close closes the file of the journal, mutations that are sent after closing the journal fail.
+func (j *Journal) Close() error {
+	return j.file.Close()
+}
+
+// Entries returns the entries in the file of the journal, in the order they were appended.
+func (j *Journal) Entries() ([]JournalEntry, error) {
+	j.mu.Lock()
+	raw, err := ioutil.ReadFile(j.file.Name())
+	j.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	var entries []JournalEntry
+	for i, line := range bytes.Split(raw, []byte("\n")) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var entry JournalEntry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return nil, fmt.Errorf("monday: invalid journal entry on line %d: %w", i+1, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// Undo applies the inverse of the mutation of the entry with the given identifier: it restores the prior values
+// of changed columns and moves moved items back to their prior group. Other mutations, like archiving (the api
+// can not unarchive), can not be undone and return an IrreversibleError.
+// The inverse is sent by the client of the journal, so it is journaled itself.
+func (j *Journal) Undo(ctx context.Context, entryID string) error {
+	entries, err := j.Entries()
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.ID != entryID {
+			continue
+		}
+		inverse, err := entry.inverse()
+		if err != nil {
+			return err
+		}
+		return j.client.Do(ctx, NewMutationPayload(inverse), nil)
+	}
+	return fmt.Errorf("monday: journal entry %s does not exist", entryID)
+}
+
+// inverse returns the mutation that undoes the mutation of the entry.
+func (e JournalEntry) inverse() (Mutation, error) {
+	irreversible := func(reason string) (Mutation, error) {
+		return Mutation{}, IrreversibleError{EntryID: e.ID, Mutation: e.Mutation, Reason: reason}
+	}
+	if len(e.Errors) != 0 {
+		return irreversible("the mutation failed")
+	}
+	switch e.Mutation {
+	case "change_column_value", "change_multiple_column_values":
+		if e.Prior == nil || e.BoardID == 0 || e.ItemID == 0 {
+			return irreversible("the prior values are not known")
+		}
+		values := make(map[string]json.RawMessage)
+		for id, value := range e.Prior.Values {
+			values[id] = json.RawMessage("null")
+			if value != nil {
+				values[id] = json.RawMessage(*value)
+			}
+		}
+		raw, err := json.Marshal(values)
+		if err != nil {
+			return Mutation{}, err
+		}
+		return Columns.ChangeMultipleValues(e.ItemID, e.BoardID, string(raw), []ItemsField{ItemsIDField()}), nil
+	case "move_item_to_group":
+		if e.Prior == nil || e.Prior.GroupID == "" || e.ItemID == 0 {
+			return irreversible("the prior group is not known")
+		}
+		return Items.MoveToGroup(e.ItemID, e.Prior.GroupID, nil), nil
+	case "archive_board", "archive_group", "archive_item":
+		return irreversible("the api can not unarchive")
+	}
+	return irreversible("the mutation has no inverse")
+}
+
+func (j *Journal) intercept(ctx context.Context, call *Call, next Handler) (*http.Response, error) {
+	mutations := call.Payload.mutations
+	if call.Payload.raw != "" {
+		raw, err := ParseDocument(call.Payload.raw, nil)
+		if err != nil {
+			return nil, fmt.Errorf("monday: raw document can not be journaled: %w", err)
+		}
+		mutations = append(raw.mutations, mutations...)
+	}
+	if len(mutations) == 0 {
+		return next(ctx, call)
+	}
+
+	entries := make([]JournalEntry, len(mutations))
+	for i, m := range mutations {
+		var err error
+		if entries[i], err = newJournalEntry(m); err != nil {
+			return nil, err
+		}
+	}
+	if err := j.prior(ctx, call, next, mutations, entries); err != nil {
+		return nil, err
+	}
+
+	resp, err := next(ctx, call)
+	if err != nil {
+		for i := range entries {
+			entries[i].Errors = []string{err.Error()}
+		}
+		if journalErr := j.append(entries); journalErr != nil {
+			return nil, journalErr
+		}
+		return nil, err
+	}
+	raw, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(raw))
+	var body struct {
+		Data   map[string]json.RawMessage
+		Errors []struct {
+			Message string
+			Path    []interface{}
+		}
+	}
+	_ = json.Unmarshal(raw, &body)
+	errs := responseErrors(raw)
+	for i, m := range mutations {
+		result := body.Data[m.key()]
+		if string(result) != "null" {
+			entries[i].Result = result
+		}
+		// errors are assigned to the mutations by their paths, the errors without a path belong to the
+		// mutations that returned no data.
+		for _, e := range body.Errors {
+			if len(e.Path) != 0 && fmt.Sprint(e.Path[0]) == m.key() {
+				entries[i].Errors = append(entries[i].Errors, e.Message)
+			}
+		}
+		if len(entries[i].Errors) == 0 && entries[i].Result == nil {
+			entries[i].Errors = errs
+		}
+	}
+	if err := j.append(entries); err != nil {
+		resp.Body.Close()
+		return nil, fmt.Errorf("monday: the mutations are sent, but not journaled: %w", err)
+	}
+	return resp, nil
+}
+
+// newJournalEntry returns the entry of the mutation, without its prior state and result.
+func newJournalEntry(m Mutation) (JournalEntry, error) {
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return JournalEntry{}, err
+	}
+	entry := JournalEntry{
+		ID:        hex.EncodeToString(id),
+		Time:      time.Now().UTC(),
+		Mutation:  m.name,
+		Arguments: make(map[string]string),
+	}
+	for _, a := range m.args {
+		value, err := encodeValue(a.value)
+		if err != nil {
+			return JournalEntry{}, err
+		}
+		entry.Arguments[a.argument] = value
+	}
+	entry.BoardID, _ = m.intArgument("board_id")
+	entry.ItemID, _ = m.intArgument("item_id")
+	return entry, nil
+}
+
+// prior fetches the prior state of the items that the mutations change, in one call.
+func (j *Journal) prior(ctx context.Context, call *Call, next Handler, mutations []Mutation, entries []JournalEntry) error {
+	var queries []Query
+	for i, m := range mutations {
+		var fields []ItemsField
+		switch m.name {
+		case "change_column_value", "change_multiple_column_values":
+			columnIDs := m.columnIDs()
+			fields = append(fields, NewItemsColumnValuesField(
+				[]ColumnValuesField{ColumnValuesIDField(), ColumnValuesValueField()},
+				[]ColumnValuesArgument{NewColumnValuesIDsArgument(columnIDs)},
+			))
+			for _, id := range columnIDs {
+				if id == "name" {
+					fields = append(fields, ItemsNameField())
+				}
+			}
+		case "move_item_to_group":
+			fields = append(fields, NewItemsGroupField([]GroupsField{GroupsIDField()}, nil))
+		default:
+			continue
+		}
+		if entries[i].ItemID == 0 {
+			continue
+		}
+		queries = append(queries, Items.List(fields, NewItemsIDsArgument([]int{entries[i].ItemID})).
+			WithAlias(fmt.Sprintf("prior%d", i)))
+	}
+	if len(queries) == 0 {
+		return nil
+	}
+
+	payload := NewQueryPayload(queries...)
+	documents, err := payload.documents()
+	if err != nil {
+		return err
+	}
+	resp, err := next(ctx, &Call{Payload: payload, Documents: documents, Request: withDocuments(call.Request, documents)})
+	if err != nil {
+		return err
+	}
+	raw, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	if errs := responseErrors(raw); len(errs) != 0 {
+		return fmt.Errorf("monday: could not fetch the prior state of the items: %s", strings.Join(errs, "; "))
+	}
+	var body struct {
+		Data map[string][]struct {
+			Name  string
+			Group *struct {
+				ID string
+			}
+			ColumnValues []struct {
+				ID    string
+				Value *string
+			} `json:"column_values"`
+		}
+	}
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return err
+	}
+	for i, m := range mutations {
+		items, ok := body.Data[fmt.Sprintf("prior%d", i)]
+		if !ok || len(items) == 0 {
+			continue
+		}
+		item, prior := items[0], new(JournalPrior)
+		if m.name == "move_item_to_group" {
+			if item.Group != nil {
+				prior.GroupID = item.Group.ID
+			}
+			entries[i].Prior = prior
+			continue
+		}
+		prior.Values = make(map[string]*string)
+		for _, id := range m.columnIDs() {
+			prior.Values[id] = nil
+			if id == "name" {
+				name, _ := json.Marshal(item.Name)
+				value := string(name)
+				prior.Values[id] = &value
+			}
+		}
+		for _, value := range item.ColumnValues {
+			if _, ok := prior.Values[value.ID]; ok {
+				prior.Values[value.ID] = value.Value
+			}
+		}
+		entries[i].Prior = prior
+	}
+	return nil
+}
+
+// columnIDs returns the identifiers of the columns that a mutation changes, sorted.
+func (m Mutation) columnIDs() []string {
+	var ids []string
+	for _, a := range m.args {
+		encoded, err := encodeValue(a.value)
+		if err != nil {
+			continue
+		}
+		var value string
+		switch a.argument {
+		case "column_id":
+			if json.Unmarshal([]byte(encoded), &value) == nil {
+				ids = append(ids, value)
+			}
+		case "column_values":
+			values := make(map[string]json.RawMessage)
+			if json.Unmarshal([]byte(encoded), &value) != nil ||
+				json.Unmarshal([]byte(value), &values) != nil {
+				continue
+			}
+			for id := range values {
+				ids = append(ids, id)
+			}
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// append appends the entries to the file of the journal and syncs it to disk.
+func (j *Journal) append(entries []JournalEntry) error {
+	var b bytes.Buffer
+	for _, entry := range entries {
+		raw, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		b.Write(raw)
+		b.WriteByte('\n')
+	}
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if _, err := j.file.Write(b.Bytes()); err != nil {
+		return err
+	}
+	return j.file.Sync()
+}
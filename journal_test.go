@@ -0,0 +1,166 @@
+package monday
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// sequenceTransport responds to the requests with its responses in order.
+type sequenceTransport struct {
+	responses []string
+	documents []string
+}
+
+func (t *sequenceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, err
+	}
+	t.documents = append(t.documents, strings.Join(values["query"], " "))
+	response := `{"data":{}}`
+	if len(t.responses) != 0 {
+		response, t.responses = t.responses[0], t.responses[1:]
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(strings.NewReader(response)),
+	}, nil
+}
+
+func TestJournal(t *testing.T) {
+	dir, err := ioutil.TempDir("", "monday")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	transport := &sequenceTransport{responses: []string{
+		`{"data":{"prior0":[{"column_values":[{"id":"text","value":"\"old\""}]}]}}`,
+		`{"data":{"change_column_value":{"id":"10"}}}`,
+		`{"data":{"prior0":[{"group":{"id":"topics"}}]}}`,
+		`{"data":{"move_item_to_group":{"id":"10"},"archive_item":{"id":"10"}}}`,
+		`{"data":{"prior0":[{"name":"item","column_values":[{"id":"status","value":null}]}]}}`,
+		`{"errors":[{"message":"boom"}]}`,
+	}}
+	c := NewClient("token", &http.Client{Transport: transport})
+	journal, err := c.Journal(filepath.Join(dir, "journal.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer journal.Close()
+	ctx := context.Background()
+
+	if err := c.Do(ctx, NewMutationPayload(
+		Columns.ChangeValue(10, "text", 1, NewTextValue("text", "new"), nil),
+	), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Do(ctx, NewMutationPayload(
+		Items.MoveToGroup(10, "done", nil),
+		Items.Archive(10, nil),
+	), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Do(ctx, NewMutationPayload(
+		Columns.ChangeMultipleValues(10, 1, `{"name":"renamed","status":{"index":1}}`, []ItemsField{ItemsIDField()}),
+	), nil); err == nil {
+		t.Fatal("expected an error")
+	}
+	if err := c.Do(ctx, NewQueryPayload(Items.List(nil)), nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(transport.documents) != 7 {
+		t.Fatalf("sent %d documents, expected 7: %v", len(transport.documents), transport.documents)
+	}
+	if prior := transport.documents[0]; prior != `{prior0:items(ids:[10]){column_values(ids:["text"]){id value}}}` {
+		t.Errorf("unexpected prior query %s", prior)
+	}
+
+	entries, err := journal.Entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 4 {
+		t.Fatalf("journaled %d entries, expected 4", len(entries))
+	}
+	changed, moved, archived, failed := entries[0], entries[1], entries[2], entries[3]
+	if changed.Mutation != "change_column_value" || changed.BoardID != 1 || changed.ItemID != 10 ||
+		changed.Arguments["column_id"] != `"text"` || string(changed.Result) != `{"id":"10"}` ||
+		*changed.Prior.Values["text"] != `"old"` {
+		t.Errorf("unexpected entry %+v", changed)
+	}
+	if moved.Prior.GroupID != "topics" || archived.Prior != nil || string(archived.Result) != `{"id":"10"}` {
+		t.Errorf("unexpected entries %+v, %+v", moved, archived)
+	}
+	if failed.Prior.Values["status"] != nil || *failed.Prior.Values["name"] != `"item"` ||
+		len(failed.Errors) != 1 || failed.Errors[0] != "boom" {
+		t.Errorf("unexpected entry %+v", failed)
+	}
+
+	transport.documents = nil
+	transport.responses = []string{
+		`{"data":{"prior0":[{"column_values":[{"id":"text","value":"\"new\""}]}]}}`,
+		`{"data":{"change_multiple_column_values":{"id":"10"}}}`,
+	}
+	if err := journal.Undo(ctx, changed.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := journal.Undo(ctx, moved.ID); err != nil {
+		t.Fatal(err)
+	}
+	for i, expected := range []string{
+		`mutation{change_multiple_column_values(item_id:10,board_id:1,column_values:"{\"text\":\"old\"}"){id}}`,
+		`mutation{move_item_to_group(item_id:10,group_id:"topics"){id}}`,
+	} {
+		if !strings.Contains(strings.Join(transport.documents, " "), expected) {
+			t.Errorf("inverse %d is not sent: %v", i, transport.documents)
+		}
+	}
+	for _, id := range []string{archived.ID, failed.ID} {
+		if err, ok := journal.Undo(ctx, id).(IrreversibleError); !ok || err.EntryID != id {
+			t.Errorf("unexpected error %v", err)
+		}
+	}
+	if err := journal.Undo(ctx, "unknown"); err == nil {
+		t.Error("expected an error for an unknown entry")
+	}
+	if entries, _ := journal.Entries(); len(entries) != 6 {
+		t.Errorf("the inverses are not journaled: %d entries", len(entries))
+	}
+
+	// after a partial failure, only the mutation that failed is journaled as failed.
+	transport.responses = []string{
+		`{"data":{"prior0":[{"group":{"id":"done"}}]}}`,
+		`{"data":{"move_item_to_group":{"id":"10"},"archive_item":null},` +
+			`"errors":[{"message":"archived","path":["archive_item"]}]}`,
+		`{"data":{"prior0":[{"group":{"id":"topics"}}]}}`,
+		`{"data":{"move_item_to_group":{"id":"10"}}}`,
+	}
+	if err := c.Do(ctx, NewMutationPayload(
+		Items.MoveToGroup(10, "topics", nil),
+		Items.Archive(10, nil),
+	), nil); err == nil {
+		t.Fatal("expected an error")
+	}
+	entries, err = journal.Entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	moved, archived = entries[len(entries)-2], entries[len(entries)-1]
+	if len(moved.Errors) != 0 || len(archived.Errors) != 1 || archived.Errors[0] != "archived" || archived.Result != nil {
+		t.Errorf("unexpected entries %+v, %+v", moved, archived)
+	}
+	if err := journal.Undo(ctx, moved.ID); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,258 @@
+package monday
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// Logger logs messages with alternating keys and values, a *slog.Logger can be used as is.
+type Logger interface {
+	Debug(msg string, args ...interface{})
+	Error(msg string, args ...interface{})
+}
+
+// Redaction redacts the values of arguments in the logged documents.
+type Redaction struct {
+	// Arguments are the names of the arguments whose values are redacted completely, e.g. body.
+	Arguments []string
+	// Pattern redacts the parts of the strings in the values of all arguments that match it, e.g. email addresses.
+	Pattern *regexp.Regexp
+}
+
+// redacted replaces redacted values.
+const redacted = "[REDACTED]"
+
+// DefaultRedactions redact the bodies of updates, the texts of notifications, email addresses and phone numbers.
+var DefaultRedactions = []Redaction{
+	{Arguments: []string{"body", "text"}},
+	{Pattern: regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)},
+	{Pattern: regexp.MustCompile(`\+[1-9][0-9 ().\-]{6,}[0-9]|\(?[0-9]{3}\)?[ .\-][0-9]{3}[ .\-][0-9]{4}`)},
+}
+
+// LogConfig configures the logging of the calls of a client, see NewLogInterceptor.
+type LogConfig struct {
+	// Redactions redact the values of the arguments in the logged documents, DefaultRedactions if nil.
+	// Raw documents are logged with only the patterns applied.
+	Redactions []Redaction
+	// DumpDir is the directory to which the full request and response of each call are written, for debugging.
+	// The dumps are not redacted, except for the token. Nothing is dumped if it is empty.
+	DumpDir string
+}
+
+// NewLogInterceptor returns an interceptor that logs the redacted documents of each call, its latency, the status
+// of the response and the errors reported by the api. Calls are logged at the debug level, unless they fail.
+// The token is never logged.
+func NewLogInterceptor(logger Logger, config LogConfig) Interceptor {
+	redactions := config.Redactions
+	if redactions == nil {
+		redactions = DefaultRedactions
+	}
+	var dumps uint64
+	return func(ctx context.Context, call *Call, next Handler) (*http.Response, error) {
+		documents, err := call.Payload.redact(redactions).documents()
+		if err != nil {
+			return nil, err
+		}
+		var dump []byte
+		if config.DumpDir != "" {
+			if dump, err = dumpRequest(call.Request); err != nil {
+				logger.Error("monday: could not dump request", "error", err)
+			}
+		}
+
+		start := time.Now()
+		resp, err := next(ctx, call)
+		args := []interface{}{"documents", documents, "latency", time.Since(start)}
+		if err != nil {
+			logger.Error("monday: call failed", append(args, "error", err)...)
+			return nil, err
+		}
+		args = append(args, "status", resp.StatusCode)
+
+		if dump != nil {
+			response, err := httputil.DumpResponse(resp, true)
+			if err == nil {
+				name := fmt.Sprintf("%s-%d.http", start.UTC().Format("20060102T150405.000000000"), atomic.AddUint64(&dumps, 1))
+				path := filepath.Join(config.DumpDir, name)
+				if err = os.MkdirAll(config.DumpDir, 0700); err == nil {
+					err = ioutil.WriteFile(path, append(append(dump, "\n\n"...), response...), 0600)
+				}
+				if err == nil {
+					args = append(args, "dump", path)
+				}
+			}
+			if err != nil {
+				logger.Error("monday: could not dump response", "error", err)
+			}
+		}
+
+		raw, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			logger.Error("monday: could not read response", append(args, "error", err)...)
+			return nil, err
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(raw))
+		if errs := responseErrors(raw); len(errs) != 0 {
+			logger.Error("monday: call returned errors", append(args, "errors", errs)...)
+		} else if resp.StatusCode >= http.StatusBadRequest {
+			logger.Error("monday: call failed", args...)
+		} else {
+			logger.Debug("monday: call", args...)
+		}
+		return resp, nil
+	}
+}
+
+// dumpRequest returns the request as it is sent, without the token.
+func dumpRequest(req *http.Request) ([]byte, error) {
+	clone := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		clone.Body = body
+	}
+	if clone.Header.Get("Authorization") != "" {
+		clone.Header.Set("Authorization", redacted)
+	}
+	return httputil.DumpRequestOut(clone, true)
+}
+
+// responseErrors returns the messages of the errors in the body of a response.
+func responseErrors(raw []byte) []string {
+	var body struct {
+		Errors []struct {
+			Message string
+		}
+		ErrorCode    string `json:"error_code"`
+		ErrorMessage string `json:"error_message"`
+	}
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return []string{fmt.Sprintf("invalid response: %v", err)}
+	}
+	var errs []string
+	for _, e := range body.Errors {
+		errs = append(errs, e.Message)
+	}
+	if body.ErrorMessage != "" {
+		errs = append(errs, fmt.Sprintf("%s: %s", body.ErrorCode, body.ErrorMessage))
+	}
+	return errs
+}
+
+// redact returns a copy of the payload with the values of the arguments redacted.
+// Raw documents are parsed to redact their arguments, only the patterns are applied to those that do not parse.
+func (p Payload) redact(redactions []Redaction) Payload {
+	var result Payload
+	if p.raw != "" {
+		parsed, err := ParseDocument(p.raw, nil)
+		if err != nil {
+			result.raw = redactLiterals(p.raw, redactions)
+		} else {
+			p.queries = append(parsed.queries, p.queries...)
+			p.mutations = append(parsed.mutations, p.mutations...)
+		}
+	}
+	fragments := make(map[*Fragment]*Fragment)
+	for _, q := range p.queries {
+		result.queries = append(result.queries, q.redact(redactions, fragments))
+	}
+	for _, m := range p.mutations {
+		q := Query{fields: m.fields, args: m.args}.redact(redactions, fragments)
+		m.fields, m.args = q.fields, q.args
+		result.mutations = append(result.mutations, m)
+	}
+	return result
+}
+
+func (q Query) redact(redactions []Redaction, fragments map[*Fragment]*Fragment) Query {
+	if q.fragment != nil {
+		f, ok := fragments[q.fragment]
+		if !ok {
+			f = &Fragment{name: q.fragment.name, on: q.fragment.on}
+			fragments[q.fragment] = f
+			f.fields = Query{fields: q.fragment.fields}.redact(redactions, fragments).fields
+		}
+		q.fragment = f
+		return q
+	}
+	args := make([]argument, len(q.args))
+	for i, a := range q.args {
+		args[i] = a.redact(redactions)
+	}
+	fields := make([]field, len(q.fields))
+	for i, f := range q.fields {
+		if f.value != nil {
+			value := f.value.redact(redactions, fragments)
+			f.value = &value
+		}
+		fields[i] = f
+	}
+	q.args, q.fields = args, fields
+	return q
+}
+
+func (a argument) redact(redactions []Redaction) argument {
+	for _, r := range redactions {
+		for _, name := range r.Arguments {
+			if name == a.argument {
+				return argument{a.argument, redacted}
+			}
+		}
+	}
+	value, err := encodeValue(a.value)
+	if err != nil {
+		// the value is kept, so writing the documents reports the error.
+		return a
+	}
+	return argument{a.argument, inputLiteral(redactLiterals(value, redactions))}
+}
+
+// redactLiterals applies the patterns of the redactions to the string literals in the graphql source,
+// so numbers like identifiers are never redacted.
+func redactLiterals(source string, redactions []Redaction) string {
+	var b strings.Builder
+	for {
+		start := strings.IndexByte(source, '"')
+		if start < 0 {
+			b.WriteString(source)
+			return b.String()
+		}
+		end := start + 1
+		for end < len(source) && source[end] != '"' {
+			if source[end] == '\\' {
+				end++
+			}
+			end++
+		}
+		if end > len(source) {
+			end = len(source)
+		}
+		literal := source[start:end]
+		for _, r := range redactions {
+			if r.Pattern != nil {
+				literal = r.Pattern.ReplaceAllString(literal, redacted)
+			}
+		}
+		b.WriteString(source[:start])
+		b.WriteString(literal)
+		source = source[end:]
+		if source != "" {
+			b.WriteByte('"')
+			source = source[1:]
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package monday
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+type logEntry struct {
+	level, msg string
+	args       map[string]interface{}
+}
+
+type testLogger struct {
+	entries []logEntry
+}
+
+func (l *testLogger) log(level, msg string, args []interface{}) {
+	entry := logEntry{level, msg, make(map[string]interface{})}
+	for i := 0; i+1 < len(args); i += 2 {
+		entry.args[fmt.Sprint(args[i])] = args[i+1]
+	}
+	l.entries = append(l.entries, entry)
+}
+
+func (l *testLogger) Debug(msg string, args ...interface{}) { l.log("debug", msg, args) }
+func (l *testLogger) Error(msg string, args ...interface{}) { l.log("error", msg, args) }
+
+type responseTransport string
+
+func (t responseTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     make(http.Header),
+		Body:       ioutil.NopCloser(strings.NewReader(string(t))),
+	}, nil
+}
+
+func TestLogInterceptor(t *testing.T) {
+	dir, err := ioutil.TempDir("", "monday")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	logger := new(testLogger)
+	c := NewClient("secret-token", &http.Client{Transport: responseTransport(`{"data":{}}`)})
+	c.Use(NewLogInterceptor(logger, LogConfig{DumpDir: dir}))
+	payload := NewMutationPayload(
+		Updates.Create(1234567890, "a private update", nil),
+		Items.Create(1234567890, "topics", "item", []ColumnValue{
+			NewEmailValue("email", "jane@example.com", "Jane"),
+			NewTextValue("text", "call +31 6 12345678"),
+			NewDateValue("date", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		}, nil),
+	)
+	if err := c.Do(context.Background(), payload, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(logger.entries) != 1 || logger.entries[0].level != "debug" {
+		t.Fatalf("unexpected entries %+v", logger.entries)
+	}
+	entry := logger.entries[0]
+	documents := fmt.Sprint(entry.args["documents"])
+	for _, secret := range []string{"a private update", "jane@example.com", "+31 6", "secret-token"} {
+		if strings.Contains(documents, secret) {
+			t.Errorf("%q is logged in %s", secret, documents)
+		}
+	}
+	for _, value := range []string{"[REDACTED]", "1234567890", "2021-01-01", "Jane"} {
+		if !strings.Contains(documents, value) {
+			t.Errorf("%q is not logged in %s", value, documents)
+		}
+	}
+	if entry.args["status"] != http.StatusOK || entry.args["latency"] == nil {
+		t.Errorf("unexpected entry %+v", entry)
+	}
+
+	dumps, err := filepath.Glob(filepath.Join(dir, "*.http"))
+	if err != nil || len(dumps) != 1 || entry.args["dump"] != dumps[0] {
+		t.Fatalf("unexpected dumps %v: %v", dumps, err)
+	}
+	dump, err := ioutil.ReadFile(dumps[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(dump), "secret-token") || !strings.Contains(string(dump), "private+update") ||
+		!strings.Contains(string(dump), `{"data":{}}`) {
+		t.Errorf("unexpected dump %s", dump)
+	}
+
+	logger.entries = nil
+	failing := NewClient("secret-token", &http.Client{Transport: responseTransport(`{"errors":[{"message":"boom"}]}`)})
+	failing.Use(NewLogInterceptor(logger, LogConfig{
+		Redactions: []Redaction{{Pattern: regexp.MustCompile(`[0-9]+`)}},
+	}))
+	if err := failing.Do(context.Background(), NewRawPayload(`{items(ids:1){name(x:"12")}}`), nil); err == nil {
+		t.Fatal("expected an error")
+	}
+	if len(logger.entries) != 1 || logger.entries[0].level != "error" {
+		t.Fatalf("unexpected entries %+v", logger.entries)
+	}
+	entry = logger.entries[0]
+	if documents := fmt.Sprint(entry.args["documents"]); documents != `[{items(ids:1){name(x:"[REDACTED]")}}]` {
+		t.Errorf("unexpected documents %s", documents)
+	}
+	if errs := fmt.Sprint(entry.args["errors"]); errs != "[boom]" {
+		t.Errorf("unexpected errors %s", errs)
+	}
+
+	// the arguments of raw documents are redacted by name, the patterns apply if the document does not parse.
+	failing = NewClient("secret-token", &http.Client{Transport: responseTransport(`{"errors":[{"message":"boom"}]}`)})
+	failing.Use(NewLogInterceptor(logger, LogConfig{
+		Redactions: []Redaction{{Arguments: []string{"body"}}, {Pattern: regexp.MustCompile(`[0-9]+`)}},
+	}))
+	for document, expected := range map[string]string{
+		`mutation { create_update(item_id: 1, body: "a private update") { id } }`: `[mutation{create_update(item_id:1,body:"[REDACTED]"){id}}]`,
+		`{ items(ids: 1) { name(x: "1") } `:                                       `[{ items(ids: 1) { name(x: "[REDACTED]") } ]`,
+	} {
+		logger.entries = nil
+		if err := failing.Do(context.Background(), NewRawPayload(document), nil); err == nil {
+			t.Fatal("expected an error")
+		}
+		if documents := fmt.Sprint(logger.entries[0].args["documents"]); documents != expected {
+			t.Errorf("got documents %s, expected %s", documents, expected)
+		}
+	}
+}
@@ -11,14 +11,16 @@ import (
 const baseURL = "https://api.monday.com/v2/"
 
 type Client struct {
-	client *http.Client
-	token  string
+	client       *http.Client
+	token        string
+	interceptors []Interceptor
 }
 
 var (
 	Account             *AccountService
 	Boards              *BoardsService
 	Columns             *ColumnsService
+	Complexity          *ComplexityService
 	Groups              *GroupsService
 	Items               *ItemsService
 	ItemsByColumnValues *ItemsByColumnValuesService
@@ -43,6 +45,8 @@ func NewClient(accessToken string, client *http.Client) *Client {
 	}
 }
 
+// Exec sends the payload to the api and returns the response, after passing it through the interceptors of the
+// client, see Use.
 func (c *Client) Exec(ctx context.Context, payload Payload) (*http.Response, error) {
 	select {
 	case <-ctx.Done():
@@ -50,8 +54,40 @@ func (c *Client) Exec(ctx context.Context, payload Payload) (*http.Response, err
 	default:
 	}
 
+	documents, err := payload.documents()
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, baseURL, strings.NewReader(
+		(url.Values{"query": documents,}).Encode(),
+	))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", c.token)
+	call := &Call{
+		Payload:   payload,
+		Documents: documents,
+		Request:   req.WithContext(ctx),
+	}
+	return c.handler()(ctx, call)
+}
+
+// documents returns the graphql documents of the payload, as they are sent to the api.
+func (p Payload) documents() ([]string, error) {
+	checked := make(map[*Fragment]bool)
+	for _, query := range p.queries {
+		if err := checkArguments(query.fields, query.args, checked); err != nil {
+			return nil, fmt.Errorf("%s: %w", query.name, err)
+		}
+	}
+	for _, mutation := range p.mutations {
+		if err := checkArguments(mutation.fields, mutation.args, checked); err != nil {
+			return nil, fmt.Errorf("%s: %w", mutation.name, err)
+		}
+	}
 	var queries []string
-	for _, query := range payload.queries {
+	for _, query := range p.queries {
 		str := query.stringify()
 		if str == "" {
 			continue
@@ -59,35 +95,48 @@ func (c *Client) Exec(ctx context.Context, payload Payload) (*http.Response, err
 		queries = append(queries, str)
 	}
 	var mutations []string
-	for _, mutation := range payload.mutations {
+	for _, mutation := range p.mutations {
 		str := mutation.stringify()
 		if str == "" {
 			continue
 		}
 		mutations = append(mutations, str)
 	}
-	var query []string
-	if len(queries) != 0 {
-		query = append(query, fmt.Sprintf("{%s}", strings.Join(queries, "")))
+	var queryFields, mutationFields [][]field
+	for _, query := range p.queries {
+		queryFields = append(queryFields, query.fields)
 	}
-	if len(mutations) != 0 {
-		query = append(query, fmt.Sprintf("mutation{%s}", strings.Join(mutations, "")))
+	for _, mutation := range p.mutations {
+		mutationFields = append(mutationFields, mutation.fields)
 	}
-	req, err := http.NewRequest(http.MethodPost, baseURL, strings.NewReader(
-		(url.Values{"query": query,}).Encode(),
-	))
+	queryFragments, err := fragmentDefinitions(queryFields)
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("Authorization", c.token)
-	resp, err := c.client.Do(req)
+	mutationFragments, err := fragmentDefinitions(mutationFields)
 	if err != nil {
 		return nil, err
 	}
-	return resp, nil
+	var documents []string
+	if p.raw != "" {
+		documents = append(documents, p.raw)
+	}
+	if len(queries) != 0 {
+		documents = append(documents, fmt.Sprintf("{%s}", strings.Join(queries, ""))+queryFragments)
+	}
+	if len(mutations) != 0 {
+		documents = append(documents, fmt.Sprintf("mutation{%s}", strings.Join(mutations, ""))+mutationFragments)
+	}
+	return documents, nil
 }
 
 type Payload struct {
 	queries   []Query
 	mutations []Mutation
+	raw       string
+}
+
+// NewRawPayload returns a payload that sends the given graphql document as is.
+func NewRawPayload(document string) Payload {
+	return Payload{raw: document}
 }
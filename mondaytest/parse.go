@@ -0,0 +1,76 @@
+package mondaytest
+
+import (
+	"fmt"
+
+	"github.com/di-wu/monday/internal/graphql"
+)
+
+// operation is a single query or mutation of a graphql document.
+type operation struct {
+	mutation   bool
+	selections []selection
+}
+
+// selection is a (nested) field within an operation.
+type selection struct {
+	alias, name string
+	args        map[string]interface{}
+	selections  []selection
+}
+
+// key returns the name under which the selection is returned in the response.
+func (s selection) key() string {
+	if s.alias != "" {
+		return s.alias
+	}
+	return s.name
+}
+
+// parse parses a graphql document into its operations, with the same parser as monday.ParseDocument.
+// Fragments are inlined into the selections in which they are spread, and the variables are replaced.
+func parse(src string, variables map[string]interface{}) ([]operation, error) {
+	document, err := graphql.Parse(src)
+	if err != nil {
+		if e, ok := err.(*graphql.SyntaxError); ok {
+			return nil, fmt.Errorf("Parse error at [%d, %d]: %s", e.Line, e.Column, e.Message)
+		}
+		return nil, err
+	}
+	resolved, err := document.Resolve(variables)
+	if err != nil {
+		return nil, err
+	}
+	var operations []operation
+	for _, o := range resolved {
+		operations = append(operations, operation{
+			mutation:   o.Mutation,
+			selections: selections(o.Selections),
+		})
+	}
+	return operations, nil
+}
+
+func selections(parsed []graphql.Selection) []selection {
+	var result []selection
+	for _, s := range parsed {
+		if s.Inline {
+			// the fake has no interfaces or unions, so the fields of typed inline fragments always apply.
+			result = append(result, selections(s.Selections)...)
+			continue
+		}
+		sel := selection{
+			alias:      s.Alias,
+			name:       s.Name,
+			selections: selections(s.Selections),
+		}
+		if len(s.Args) != 0 {
+			sel.args = make(map[string]interface{})
+			for _, a := range s.Args {
+				sel.args[a.Name] = a.Value
+			}
+		}
+		result = append(result, sel)
+	}
+	return result
+}
@@ -0,0 +1,303 @@
+package mondaytest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+)
+
+const redacted = "[REDACTED]"
+
+// RecorderMode is the mode of a Recorder.
+type RecorderMode struct {
+	mode string
+}
+
+var (
+	recorderModeRecord = RecorderMode{"record"}
+	recorderModeReplay = RecorderMode{"replay"}
+	recorderModeAuto   = RecorderMode{"auto"}
+)
+
+// Send the requests to the real api and record the interactions.
+func RecorderModeRecord() RecorderMode {
+	return recorderModeRecord
+}
+
+// Serve the recorded interactions without sending any requests.
+func RecorderModeReplay() RecorderMode {
+	return recorderModeReplay
+}
+
+// Replay if the fixture file exists, record otherwise.
+func RecorderModeAuto() RecorderMode {
+	return recorderModeAuto
+}
+
+// Recorder is an http.RoundTripper that records the interactions with the Monday API into a fixture file,
+// or replays them from that file. Requests are matched on their normalized graphql query, identical queries
+// are replayed in the order in which they were recorded. The Authorization header is never recorded.
+type Recorder struct {
+	mode      RecorderMode
+	path      string
+	transport http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+// Interaction is a recorded request/response pair.
+type Interaction struct {
+	Request struct {
+		Method string      `json:"method"`
+		URL    string      `json:"url"`
+		Header http.Header `json:"header"`
+		Query  []string    `json:"query"`
+		Body   string      `json:"body"`
+	} `json:"request"`
+	Response struct {
+		StatusCode int         `json:"status_code"`
+		Header     http.Header `json:"header"`
+		Body       string      `json:"body"`
+	} `json:"response"`
+}
+
+type fixture struct {
+	Version      int           `json:"version"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+// NewRecorder returns a recorder that records to or replays from the fixture file at the given path.
+// The transport is used to send requests while recording, it defaults to http.DefaultTransport.
+func NewRecorder(path string, mode RecorderMode, transport http.RoundTripper) (*Recorder, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	if mode == recorderModeAuto {
+		mode = recorderModeRecord
+		if _, err := os.Stat(path); err == nil {
+			mode = recorderModeReplay
+		}
+	}
+	r := &Recorder{
+		mode:      mode,
+		path:      path,
+		transport: transport,
+	}
+	if mode == recorderModeReplay {
+		raw, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var f fixture
+		if err := json.Unmarshal(raw, &f); err != nil {
+			return nil, fmt.Errorf("invalid fixture %s: %v", path, err)
+		}
+		if f.Version != 1 {
+			return nil, fmt.Errorf("unsupported fixture version %d", f.Version)
+		}
+		r.interactions = f.Interactions
+		r.used = make([]bool, len(f.Interactions))
+	}
+	return r, nil
+}
+
+// Mode returns the mode the recorder operates in, RecorderModeAuto is resolved when creating the recorder.
+func (r *Recorder) Mode() RecorderMode {
+	return r.mode
+}
+
+// Client returns an http client that uses the recorder as transport.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		raw, err := ioutil.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = raw
+	}
+	queries := normalizedQueries(body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.mode == recorderModeReplay {
+		return r.replay(req, queries)
+	}
+
+	forward := req.Clone(req.Context())
+	forward.Body = ioutil.NopCloser(bytes.NewReader(body))
+	resp, err := r.transport.RoundTrip(forward)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := ioutil.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(raw))
+
+	var i Interaction
+	i.Request.Method = req.Method
+	i.Request.URL = req.URL.String()
+	i.Request.Header = redactHeader(req.Header)
+	i.Request.Query = queries
+	i.Request.Body = string(body)
+	i.Response.StatusCode = resp.StatusCode
+	i.Response.Header = redactHeader(resp.Header)
+	i.Response.Body = string(raw)
+	r.interactions = append(r.interactions, i)
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, queries []string) (*http.Response, error) {
+	for n, i := range r.interactions {
+		if r.used[n] || i.Request.Method != req.Method || !equalQueries(i.Request.Query, queries) {
+			continue
+		}
+		r.used[n] = true
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", i.Response.StatusCode, http.StatusText(i.Response.StatusCode)),
+			StatusCode:    i.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        i.Response.Header.Clone(),
+			Body:          ioutil.NopCloser(strings.NewReader(i.Response.Body)),
+			ContentLength: int64(len(i.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("mondaytest: no recorded interaction for query %q", strings.Join(queries, " "))
+}
+
+// Save writes the recorded interactions to the fixture file, it is a no-op when replaying.
+func (r *Recorder) Save() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.mode != recorderModeRecord {
+		return nil
+	}
+	raw, err := json.MarshalIndent(fixture{
+		Version:      1,
+		Interactions: r.interactions,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path, append(raw, '\n'), 0644)
+}
+
+// Unused returns the recorded interactions that were not replayed.
+func (r *Recorder) Unused() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var unused []Interaction
+	for n, i := range r.interactions {
+		if n < len(r.used) && !r.used[n] {
+			unused = append(unused, i)
+		}
+	}
+	return unused
+}
+
+func redactHeader(header http.Header) http.Header {
+	header = header.Clone()
+	for _, key := range []string{"Authorization", "Cookie", "Set-Cookie"} {
+		if header.Get(key) != "" {
+			header.Set(key, redacted)
+		}
+	}
+	return header
+}
+
+// normalizedQueries extracts the graphql queries from the body of a request.
+func normalizedQueries(body []byte) []string {
+	var queries []string
+	if values, err := url.ParseQuery(string(body)); err == nil && len(values["query"]) != 0 {
+		queries = values["query"]
+	} else {
+		var v struct {
+			Query string `json:"query"`
+		}
+		if json.Unmarshal(body, &v) == nil && v.Query != "" {
+			queries = []string{v.Query}
+		} else {
+			queries = []string{string(body)}
+		}
+	}
+	for i, q := range queries {
+		queries[i] = normalizeQuery(q)
+	}
+	return queries
+}
+
+// normalizeQuery removes all insignificant whitespace, commas and comments from a graphql document.
+func normalizeQuery(query string) string {
+	var b strings.Builder
+	var last byte
+	var pendingSpace bool
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case c == '"':
+			end := i + 1
+			for end < len(query) && query[end] != '"' {
+				if query[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(query) {
+				end = len(query) - 1
+			}
+			b.WriteString(query[i : end+1])
+			last, pendingSpace = '"', false
+			i = end
+		case c == '#':
+			for i < len(query) && query[i] != '\n' {
+				i++
+			}
+			pendingSpace = true
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			pendingSpace = true
+		default:
+			if pendingSpace && isNameContinue(last) && isNameContinue(c) {
+				b.WriteByte(' ')
+			}
+			b.WriteByte(c)
+			last, pendingSpace = c, false
+		}
+	}
+	return b.String()
+}
+
+func equalQueries(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// isNameContinue reports whether the byte can be part of a graphql name.
+func isNameContinue(c byte) bool {
+	return c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9'
+}
@@ -0,0 +1,85 @@
+package mondaytest
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/di-wu/monday"
+)
+
+func TestRecorder(t *testing.T) {
+	s := NewServer()
+	s.Token = "secret"
+	path := filepath.Join(t.TempDir(), "fixture.json")
+
+	recorder, err := NewRecorder(path, RecorderModeAuto(), s.Client().Transport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recorder.Mode() != RecorderModeRecord() {
+		t.Fatalf("expected record mode for a missing fixture")
+	}
+	payload := monday.NewMutationPayload(monday.Boards.Create("Board", monday.BoardsKindPublic(), nil))
+	recorded := execBody(t, monday.NewClient("secret", recorder.Client()), payload)
+	if err := recorder.Save(); err != nil {
+		t.Fatal(err)
+	}
+	s.Close()
+
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(raw), "secret") {
+		t.Errorf("fixture contains the access token: %s", raw)
+	}
+
+	replayer, err := NewRecorder(path, RecorderModeAuto(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayer.Mode() != RecorderModeReplay() {
+		t.Fatalf("expected replay mode for an existing fixture")
+	}
+	c := monday.NewClient("", replayer.Client())
+	if replayed := execBody(t, c, payload); replayed != recorded {
+		t.Errorf("got %s, expected %s", replayed, recorded)
+	}
+	if len(replayer.Unused()) != 0 {
+		t.Errorf("expected all interactions to be replayed")
+	}
+	if _, err := c.Exec(context.Background(), payload); err == nil {
+		t.Errorf("expected an error for an interaction that is not recorded")
+	}
+}
+
+func TestNormalizeQuery(t *testing.T) {
+	for _, test := range []struct {
+		query, normalized string
+	}{
+		{"{boards{id}}", "{boards{id}}"},
+		{"query {\n  boards(ids: [1, 2]) {\n    id # comment\n    name\n  }\n}", "query{boards(ids:[1 2]){id name}}"},
+		{`{a(b: "x,  y") { id }}`, `{a(b:"x,  y"){id}}`},
+	} {
+		if normalized := normalizeQuery(test.query); normalized != test.normalized {
+			t.Errorf("got %s, expected %s", normalized, test.normalized)
+		}
+	}
+}
+
+func execBody(t *testing.T, c *monday.Client, payload monday.Payload) string {
+	t.Helper()
+	resp, err := c.Exec(context.Background(), payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	raw, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(raw)
+}
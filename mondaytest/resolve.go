@@ -0,0 +1,1362 @@
+package mondaytest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/di-wu/monday/internal/graphql"
+)
+
+// errUnknownField is returned by field resolvers for fields that do not exist on the type.
+var errUnknownField = errors.New("unknown field")
+
+func (s *Server) execute(op operation, data map[string]interface{}) error {
+	before := s.complexity
+	cost := 0
+	for _, sel := range op.selections {
+		cost += complexity(sel)
+	}
+	s.complexity -= cost
+
+	typ, resolve := "Query", s.query
+	if op.mutation {
+		typ, resolve = "Mutation", s.mutate
+	}
+	for _, sel := range op.selections {
+		if sel.name == "complexity" {
+			v, err := object("Complexity", sel, func(f selection) (interface{}, error) {
+				switch f.name {
+				case "before":
+					return leaf(f, before)
+				case "after":
+					return leaf(f, s.complexity)
+				case "query":
+					return leaf(f, cost)
+				case "reset_in_x_seconds":
+					return leaf(f, 60)
+				}
+				return nil, errUnknownField
+			})
+			if err != nil {
+				return err
+			}
+			data[sel.key()] = v
+			continue
+		}
+		v, err := resolve(sel)
+		if err == errUnknownField {
+			return graphQLError{fmt.Sprintf("Field '%s' doesn't exist on type '%s'", sel.name, typ)}
+		}
+		if err != nil {
+			return err
+		}
+		data[sel.key()] = v
+	}
+	return nil
+}
+
+// complexity returns an estimate of the cost of the selection, nested lists are more expensive.
+func complexity(sel selection) int {
+	cost := 1
+	for _, child := range sel.selections {
+		cost += complexity(child)
+	}
+	if len(sel.selections) != 0 {
+		cost *= 10
+	}
+	return cost
+}
+
+func (s *Server) query(sel selection) (interface{}, error) {
+	switch sel.name {
+	case "boards":
+		var boards []*board
+		ids, filtered, err := argIDs(sel)
+		if err != nil {
+			return nil, err
+		}
+		state, _, err := argString(sel, "state")
+		if err != nil {
+			return nil, err
+		}
+		kind, _, err := argString(sel, "board_kind")
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range s.boards {
+			if filtered && !contains(ids, strconv.Itoa(b.id)) || kind != "" && b.kind != kind || !matchState(b.state, state) {
+				continue
+			}
+			boards = append(boards, b)
+		}
+		if newest, _, _ := argBool(sel, "newest_first"); newest {
+			boards = reverseBoards(boards)
+		}
+		start, end, err := paginate(sel, len(boards), 25)
+		if err != nil {
+			return nil, err
+		}
+		return list(sel, end-start, func(n int) (interface{}, error) {
+			return s.resolveBoard(sel, boards[start+n])
+		})
+	case "items":
+		ids, filtered, err := argIDs(sel)
+		if err != nil {
+			return nil, err
+		}
+		var items []*item
+		for _, i := range s.items {
+			if filtered && contains(ids, strconv.Itoa(i.id)) && i.state != "deleted" || !filtered && i.state == "active" {
+				items = append(items, i)
+			}
+		}
+		if newest, _, _ := argBool(sel, "newest_first"); newest {
+			items = reverseItems(items)
+		}
+		start, end, err := paginate(sel, len(items), 25)
+		if err != nil {
+			return nil, err
+		}
+		return list(sel, end-start, func(n int) (interface{}, error) {
+			return s.resolveItem(sel, items[start+n])
+		})
+	case "items_by_column_values":
+		if err := required(sel, "board_id", "column_id", "column_value"); err != nil {
+			return nil, err
+		}
+		boardID, _, err := argInt(sel, "board_id")
+		if err != nil {
+			return nil, err
+		}
+		b, err := s.board(boardID)
+		if err != nil {
+			return nil, err
+		}
+		columnID, _, _ := argString(sel, "column_id")
+		c, err := b.column(columnID)
+		if err != nil {
+			return nil, err
+		}
+		// the api compares the value with the text of the column as is.
+		value, _, _ := argString(sel, "column_value")
+		state, _, err := argString(sel, "state")
+		if err != nil {
+			return nil, err
+		}
+		var items []*item
+		for _, i := range s.items {
+			if i.board == b && matchState(i.state, state) && s.text(i, c) == value {
+				items = append(items, i)
+			}
+		}
+		start, end, err := paginate(sel, len(items), len(items))
+		if err != nil {
+			return nil, err
+		}
+		return list(sel, end-start, func(n int) (interface{}, error) {
+			return s.resolveItem(sel, items[start+n])
+		})
+	case "users":
+		users, err := s.listUsers(sel)
+		if err != nil {
+			return nil, err
+		}
+		return list(sel, len(users), func(n int) (interface{}, error) {
+			return s.resolveUser(sel, users[n])
+		})
+	case "me":
+		return s.resolveUser(sel, s.me())
+	case "updates":
+		updates := make([]*update, 0, len(s.updates))
+		for i := len(s.updates) - 1; i >= 0; i-- {
+			updates = append(updates, s.updates[i])
+		}
+		start, end, err := paginate(sel, len(updates), 25)
+		if err != nil {
+			return nil, err
+		}
+		return list(sel, end-start, func(n int) (interface{}, error) {
+			return s.resolveUpdate(sel, updates[start+n])
+		})
+	case "tags":
+		ids, filtered, err := argIDs(sel)
+		if err != nil {
+			return nil, err
+		}
+		var tags []*tag
+		for _, t := range s.tags {
+			if t.boardID == 0 && (!filtered || contains(ids, strconv.Itoa(t.id))) {
+				tags = append(tags, t)
+			}
+		}
+		return list(sel, len(tags), func(n int) (interface{}, error) {
+			return s.resolveTag(sel, tags[n])
+		})
+	case "teams":
+		return list(sel, 0, nil)
+	case "webhooks":
+		if err := required(sel, "board_id"); err != nil {
+			return nil, err
+		}
+		boardID, _, err := argInt(sel, "board_id")
+		if err != nil {
+			return nil, err
+		}
+		if _, err := s.board(boardID); err != nil {
+			return nil, err
+		}
+		var webhooks []*webhook
+		for _, w := range s.webhooks {
+			if w.boardID == boardID {
+				webhooks = append(webhooks, w)
+			}
+		}
+		return list(sel, len(webhooks), func(n int) (interface{}, error) {
+			return s.resolveWebhook(sel, webhooks[n])
+		})
+	case "account":
+		return object("Account", sel, func(f selection) (interface{}, error) {
+			switch f.name {
+			case "id":
+				return leaf(f, strconv.Itoa(accountID))
+			case "name":
+				return leaf(f, "Test Account")
+			case "slug":
+				return leaf(f, "test-account")
+			case "first_day_of_the_week":
+				return leaf(f, "monday")
+			case "show_timeline_weekends":
+				return leaf(f, true)
+			case "logo":
+				return leaf(f, nil)
+			case "plan":
+				return object("Plan", f, func(f selection) (interface{}, error) {
+					switch f.name {
+					case "max_users":
+						return leaf(f, 10)
+					case "period":
+						return leaf(f, "monthly")
+					case "tier":
+						return leaf(f, "pro")
+					case "version":
+						return leaf(f, 1)
+					}
+					return nil, errUnknownField
+				})
+			}
+			return nil, errUnknownField
+		})
+	}
+	return nil, errUnknownField
+}
+
+func (s *Server) mutate(sel selection) (interface{}, error) {
+	switch sel.name {
+	case "create_board":
+		if err := required(sel, "board_name", "board_kind"); err != nil {
+			return nil, err
+		}
+		name, _, _ := argString(sel, "board_name")
+		kind, _, _ := argString(sel, "board_kind")
+		if kind != "public" && kind != "private" && kind != "share" {
+			return nil, graphQLError{fmt.Sprintf("Argument 'board_kind' on Field 'create_board' has an invalid value (%s).", kind)}
+		}
+		return s.resolveBoard(sel, s.createBoard(name, kind, s.me().id))
+	case "archive_board":
+		b, err := s.boardArg(sel)
+		if err != nil {
+			return nil, err
+		}
+		b.state = "archived"
+		return s.resolveBoard(sel, b)
+	case "create_group":
+		if err := required(sel, "board_id", "group_name"); err != nil {
+			return nil, err
+		}
+		b, err := s.boardArg(sel)
+		if err != nil {
+			return nil, err
+		}
+		name, _, _ := argString(sel, "group_name")
+		return s.resolveGroup(sel, b, b.createGroup(name, false))
+	case "duplicate_group":
+		if err := required(sel, "board_id", "group_id"); err != nil {
+			return nil, err
+		}
+		b, g, err := s.groupArg(sel)
+		if err != nil {
+			return nil, err
+		}
+		title, ok, _ := argString(sel, "group_title")
+		if !ok {
+			title = "Duplicate of " + g.title
+		}
+		top, _, _ := argBool(sel, "add_to_top")
+		duplicate := b.createGroup(title, top)
+		for _, i := range s.items {
+			if i.board == b && i.groupID == g.id && i.state == "active" {
+				values := make(map[string]json.RawMessage)
+				for k, v := range i.values {
+					values[k] = v
+				}
+				if _, err := s.createItem(b, duplicate.id, i.name, values); err != nil {
+					return nil, err
+				}
+			}
+		}
+		return s.resolveGroup(sel, b, duplicate)
+	case "archive_group", "delete_group":
+		if err := required(sel, "board_id", "group_id"); err != nil {
+			return nil, err
+		}
+		b, g, err := s.groupArg(sel)
+		if err != nil {
+			return nil, err
+		}
+		var active int
+		for _, other := range b.groups {
+			if !other.archived && !other.deleted {
+				active++
+			}
+		}
+		if active == 1 {
+			return nil, apiError{"DeleteLastGroupException", "Deleting the last group of a board is not allowed", 409}
+		}
+		state := "archived"
+		if sel.name == "archive_group" {
+			g.archived = true
+		} else {
+			g.deleted, state = true, "deleted"
+		}
+		for _, i := range s.items {
+			if i.board == b && i.groupID == g.id && i.state == "active" {
+				i.state = state
+			}
+		}
+		return s.resolveGroup(sel, b, g)
+	case "create_column":
+		if err := required(sel, "board_id", "title"); err != nil {
+			return nil, err
+		}
+		b, err := s.boardArg(sel)
+		if err != nil {
+			return nil, err
+		}
+		title, _, _ := argString(sel, "title")
+		typ, ok, err := argString(sel, "column_type")
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			typ = "text"
+		}
+		defaults, _, err := argString(sel, "defaults")
+		if err != nil {
+			return nil, err
+		}
+		c, err := b.createColumn(title, typ, defaults)
+		if err != nil {
+			return nil, err
+		}
+		c.description, _, _ = argString(sel, "description")
+		return s.resolveColumn(sel, c)
+	case "change_column_title", "change_column_metadata", "update_column", "delete_column":
+		b, err := s.boardArg(sel)
+		if err != nil {
+			return nil, err
+		}
+		name := "column_id"
+		if sel.name == "update_column" {
+			name = "id"
+		}
+		if err := required(sel, name); err != nil {
+			return nil, err
+		}
+		id, _, _ := argString(sel, name)
+		c, err := b.column(id)
+		if err != nil {
+			return nil, err
+		}
+		switch sel.name {
+		case "change_column_title":
+			if err := required(sel, "title"); err != nil {
+				return nil, err
+			}
+			c.title, _, _ = argString(sel, "title")
+		case "change_column_metadata":
+			if err := required(sel, "column_property", "value"); err != nil {
+				return nil, err
+			}
+			value, _, err := argString(sel, "value")
+			if err != nil {
+				return nil, err
+			}
+			switch property, _, _ := argString(sel, "column_property"); property {
+			case "title":
+				c.title = value
+			case "description":
+				c.description = value
+			default:
+				return nil, invalidArgument(sel, "column_property")
+			}
+		case "update_column":
+			if typ, ok, _ := argString(sel, "column_type"); ok && typ != c.typ {
+				return nil, apiError{"ColumnTypeChangeException", fmt.Sprintf("column %s of type %s can not be changed to %s", c.id, c.typ, typ), 200}
+			}
+			if raw, ok, err := argString(sel, "settings"); err != nil {
+				return nil, err
+			} else if ok {
+				settings := make(map[string]interface{})
+				if err := json.Unmarshal([]byte(raw), &settings); err != nil {
+					return nil, apiError{"InvalidArgumentException", "Invalid settings: " + err.Error(), 200}
+				}
+				normalizeSettings(c.typ, settings)
+				for k, v := range settings {
+					c.settings[k] = v
+				}
+			}
+		case "delete_column":
+			if c.typ == "name" {
+				return nil, apiError{"DeleteNameColumnException", "The name column can not be deleted", 200}
+			}
+			c.archived = true
+		}
+		return s.resolveColumn(sel, c)
+	case "update_board":
+		if err := required(sel, "board_id", "board_attribute", "new_value"); err != nil {
+			return nil, err
+		}
+		b, err := s.boardArg(sel)
+		if err != nil {
+			return nil, err
+		}
+		value, _, err := argString(sel, "new_value")
+		if err != nil {
+			return nil, err
+		}
+		switch attribute, _, _ := argString(sel, "board_attribute"); attribute {
+		case "name":
+			b.name = value
+		case "description":
+			b.description = value
+		case "communication":
+		default:
+			return nil, invalidArgument(sel, "board_attribute")
+		}
+		return leaf(sel, fmt.Sprintf(`{"success":true,"undo_data":{"board_id":%d}}`, b.id))
+	case "update_group":
+		if err := required(sel, "board_id", "group_id", "group_attribute", "new_value"); err != nil {
+			return nil, err
+		}
+		b, g, err := s.groupArg(sel)
+		if err != nil {
+			return nil, err
+		}
+		value, _, err := argString(sel, "new_value")
+		if err != nil {
+			return nil, err
+		}
+		switch attribute, _, _ := argString(sel, "group_attribute"); attribute {
+		case "title":
+			g.title = value
+		case "color":
+			g.color = value
+		case "position":
+			position, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, invalidArgument(sel, "new_value")
+			}
+			g.position = position
+			sort.SliceStable(b.groups, func(i, j int) bool {
+				return b.groups[i].position < b.groups[j].position
+			})
+		case "relative_position_after", "relative_position_before":
+			other, err := b.group(value)
+			if err != nil {
+				return nil, err
+			}
+			b.moveGroup(g, other, attribute == "relative_position_after")
+		default:
+			return nil, invalidArgument(sel, "group_attribute")
+		}
+		return s.resolveGroup(sel, b, g)
+	case "change_column_value":
+		if err := required(sel, "item_id", "column_id", "board_id", "value"); err != nil {
+			return nil, err
+		}
+		i, err := s.itemOnBoardArg(sel)
+		if err != nil {
+			return nil, err
+		}
+		columnID, _, _ := argString(sel, "column_id")
+		value, _, err := argString(sel, "value")
+		if err != nil {
+			return nil, err
+		}
+		if err := s.setValues(i, map[string]json.RawMessage{columnID: json.RawMessage(value)}); err != nil {
+			return nil, err
+		}
+		return s.resolveItem(sel, i)
+	case "change_multiple_column_values":
+		if err := required(sel, "item_id", "board_id", "column_values"); err != nil {
+			return nil, err
+		}
+		i, err := s.itemOnBoardArg(sel)
+		if err != nil {
+			return nil, err
+		}
+		values, err := argColumnValues(sel)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.setValues(i, values); err != nil {
+			return nil, err
+		}
+		return s.resolveItem(sel, i)
+	case "create_item":
+		if err := required(sel, "board_id", "item_name"); err != nil {
+			return nil, err
+		}
+		b, err := s.boardArg(sel)
+		if err != nil {
+			return nil, err
+		}
+		name, _, _ := argString(sel, "item_name")
+		groupID, _, err := argString(sel, "group_id")
+		if err != nil {
+			return nil, err
+		}
+		values, err := argColumnValues(sel)
+		if err != nil {
+			return nil, err
+		}
+		i, err := s.createItem(b, groupID, name, values)
+		if err != nil {
+			return nil, err
+		}
+		return s.resolveItem(sel, i)
+	case "create_subitem":
+		if err := required(sel, "parent_item_id", "item_name"); err != nil {
+			return nil, err
+		}
+		id, _, err := argInt(sel, "parent_item_id")
+		if err != nil {
+			return nil, err
+		}
+		parent, err := s.item(id)
+		if err != nil {
+			return nil, err
+		}
+		name, _, _ := argString(sel, "item_name")
+		values, err := argColumnValues(sel)
+		if err != nil {
+			return nil, err
+		}
+		i, err := s.createSubitem(parent, name, values)
+		if err != nil {
+			return nil, err
+		}
+		return s.resolveItem(sel, i)
+	case "add_subscribers_to_board":
+		if err := required(sel, "board_id", "user_ids"); err != nil {
+			return nil, err
+		}
+		b, err := s.boardArg(sel)
+		if err != nil {
+			return nil, err
+		}
+		ids, _, err := argIDs(selection{args: map[string]interface{}{"ids": sel.args["user_ids"]}})
+		if err != nil {
+			return nil, err
+		}
+		var users []*user
+		for _, id := range ids {
+			n, _ := strconv.Atoi(id)
+			u := s.user(n)
+			if u == nil {
+				return nil, apiError{"InvalidUserIdException", fmt.Sprintf("User not found (id: %s)", id), 200}
+			}
+			if u.id != b.ownerID && !containsInt(b.subscriberIDs, u.id) {
+				b.subscriberIDs = append(b.subscriberIDs, u.id)
+			}
+			users = append(users, u)
+		}
+		return list(sel, len(users), func(n int) (interface{}, error) {
+			return s.resolveUser(sel, users[n])
+		})
+	case "move_item_to_group":
+		if err := required(sel, "item_id", "group_id"); err != nil {
+			return nil, err
+		}
+		i, err := s.itemArg(sel)
+		if err != nil {
+			return nil, err
+		}
+		groupID, _, _ := argString(sel, "group_id")
+		if _, err := i.board.group(groupID); err != nil {
+			return nil, err
+		}
+		i.groupID = groupID
+		i.updatedAt = s.now()
+		return s.resolveItem(sel, i)
+	case "archive_item", "delete_item":
+		if err := required(sel, "item_id"); err != nil {
+			return nil, err
+		}
+		i, err := s.itemArg(sel)
+		if err != nil {
+			return nil, err
+		}
+		i.state = strings.TrimSuffix(sel.name, "_item") + "d"
+		i.updatedAt = s.now()
+		return s.resolveItem(sel, i)
+	case "create_update":
+		if err := required(sel, "item_id", "body"); err != nil {
+			return nil, err
+		}
+		i, err := s.itemArg(sel)
+		if err != nil {
+			return nil, err
+		}
+		body, _, _ := argString(sel, "body")
+		if parentID, ok, _ := argInt(sel, "parent_id"); ok {
+			for _, u := range s.updates {
+				if u.id == parentID {
+					reply := &update{id: s.id(), itemID: i.id, creatorID: s.me().id, body: body, createdAt: s.now(), updatedAt: s.now()}
+					u.replies = append(u.replies, reply)
+					return s.resolveUpdate(sel, reply)
+				}
+			}
+			return nil, apiError{"InvalidArgumentException", fmt.Sprintf("Update not found (id: %d)", parentID), 200}
+		}
+		return s.resolveUpdate(sel, s.createUpdate(i, body))
+	case "create_or_get_tag":
+		if err := required(sel, "tag_name"); err != nil {
+			return nil, err
+		}
+		name, _, _ := argString(sel, "tag_name")
+		boardID, ok, err := argInt(sel, "board_id")
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			b, err := s.board(boardID)
+			if err != nil {
+				return nil, err
+			}
+			if b.kind != "private" {
+				boardID = 0
+			}
+		}
+		return s.resolveTag(sel, s.createOrGetTag(name, boardID))
+	case "create_webhook":
+		if err := required(sel, "board_id", "url", "event"); err != nil {
+			return nil, err
+		}
+		b, err := s.boardArg(sel)
+		if err != nil {
+			return nil, err
+		}
+		url, _, _ := argString(sel, "url")
+		event, _, _ := argString(sel, "event")
+		config, _, err := argString(sel, "config")
+		if err != nil {
+			return nil, err
+		}
+		if event == "change_specific_column_value" && config == "" {
+			return nil, apiError{"InvalidArgumentException", "change_specific_column_value requires a config with a columnId", 200}
+		}
+		w := &webhook{id: s.id(), boardID: b.id, url: url, event: event, config: config}
+		s.webhooks = append(s.webhooks, w)
+		return s.resolveWebhook(sel, w)
+	case "delete_webhook":
+		if err := required(sel, "id"); err != nil {
+			return nil, err
+		}
+		id, _, err := argInt(sel, "id")
+		if err != nil {
+			return nil, err
+		}
+		for n, w := range s.webhooks {
+			if w.id == id {
+				s.webhooks = append(s.webhooks[:n], s.webhooks[n+1:]...)
+				return s.resolveWebhook(sel, w)
+			}
+		}
+		return nil, apiError{"ResourceNotFoundException", fmt.Sprintf("Webhook not found (id: %d)", id), 404}
+	case "create_notification":
+		if err := required(sel, "text", "user_id", "target_id", "target_type"); err != nil {
+			return nil, err
+		}
+		text, _, _ := argString(sel, "text")
+		id := s.id()
+		return object("Notification", sel, func(f selection) (interface{}, error) {
+			switch f.name {
+			case "id":
+				return leaf(f, strconv.Itoa(id))
+			case "text":
+				return leaf(f, text)
+			}
+			return nil, errUnknownField
+		})
+	}
+	return nil, errUnknownField
+}
+
+func (s *Server) boardArg(sel selection) (*board, error) {
+	if err := required(sel, "board_id"); err != nil {
+		return nil, err
+	}
+	id, _, err := argInt(sel, "board_id")
+	if err != nil {
+		return nil, err
+	}
+	return s.board(id)
+}
+
+func (s *Server) groupArg(sel selection) (*board, *group, error) {
+	b, err := s.boardArg(sel)
+	if err != nil {
+		return nil, nil, err
+	}
+	id, _, err := argString(sel, "group_id")
+	if err != nil {
+		return nil, nil, err
+	}
+	g, err := b.group(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return b, g, nil
+}
+
+func (s *Server) itemArg(sel selection) (*item, error) {
+	id, _, err := argInt(sel, "item_id")
+	if err != nil {
+		return nil, err
+	}
+	return s.item(id)
+}
+
+func (s *Server) itemOnBoardArg(sel selection) (*item, error) {
+	b, err := s.boardArg(sel)
+	if err != nil {
+		return nil, err
+	}
+	i, err := s.itemArg(sel)
+	if err != nil {
+		return nil, err
+	}
+	if i.board != b {
+		return nil, apiError{"InvalidItemIdException", fmt.Sprintf("Item %d is not on board %d", i.id, b.id), 200}
+	}
+	return i, nil
+}
+
+func (s *Server) listUsers(sel selection) ([]*user, error) {
+	ids, filtered, err := argIDs(sel)
+	if err != nil {
+		return nil, err
+	}
+	var users []*user
+	for _, u := range s.users {
+		if !filtered || contains(ids, strconv.Itoa(u.id)) {
+			users = append(users, u)
+		}
+	}
+	if newest, _, _ := argBool(sel, "newest_first"); newest {
+		for i, j := 0, len(users)-1; i < j; i, j = i+1, j-1 {
+			users[i], users[j] = users[j], users[i]
+		}
+	}
+	if limit, ok, err := argInt(sel, "limit"); err != nil {
+		return nil, err
+	} else if ok && limit < len(users) {
+		users = users[:limit]
+	}
+	return users, nil
+}
+
+func (s *Server) resolveBoard(sel selection, b *board) (interface{}, error) {
+	return object("Board", sel, func(f selection) (interface{}, error) {
+		switch f.name {
+		case "id":
+			return leaf(f, strconv.Itoa(b.id))
+		case "name":
+			return leaf(f, b.name)
+		case "description":
+			if b.description == "" {
+				return leaf(f, nil)
+			}
+			return leaf(f, b.description)
+		case "board_kind":
+			return leaf(f, b.kind)
+		case "state":
+			return leaf(f, b.state)
+		case "board_folder_id", "pos", "workspace_id":
+			return leaf(f, nil)
+		case "permissions":
+			return leaf(f, "everyone")
+		case "columns":
+			ids, filtered, err := argIDs(f)
+			if err != nil {
+				return nil, err
+			}
+			var columns []*column
+			for _, c := range b.columns {
+				if !c.archived && (!filtered || contains(ids, c.id)) {
+					columns = append(columns, c)
+				}
+			}
+			return list(f, len(columns), func(n int) (interface{}, error) {
+				return s.resolveColumn(f, columns[n])
+			})
+		case "groups":
+			ids, filtered, err := argIDs(f)
+			if err != nil {
+				return nil, err
+			}
+			var groups []*group
+			for _, g := range b.groups {
+				if !g.archived && !g.deleted && (!filtered || contains(ids, g.id)) {
+					groups = append(groups, g)
+				}
+			}
+			return list(f, len(groups), func(n int) (interface{}, error) {
+				return s.resolveGroup(f, b, groups[n])
+			})
+		case "items":
+			return s.resolveItems(f, func(i *item) bool {
+				return i.board == b
+			})
+		case "owner":
+			return s.resolveUser(f, s.user(b.ownerID))
+		case "subscribers":
+			ids := append([]int{b.ownerID}, b.subscriberIDs...)
+			return list(f, len(ids), func(n int) (interface{}, error) {
+				return s.resolveUser(f, s.user(ids[n]))
+			})
+		case "tags":
+			var tags []*tag
+			for _, t := range s.tags {
+				if t.boardID == b.id {
+					tags = append(tags, t)
+				}
+			}
+			return list(f, len(tags), func(n int) (interface{}, error) {
+				return s.resolveTag(f, tags[n])
+			})
+		case "updates":
+			var updates []*update
+			for n := len(s.updates) - 1; n >= 0; n-- {
+				if i, err := s.item(s.updates[n].itemID); err == nil && i.board == b {
+					updates = append(updates, s.updates[n])
+				}
+			}
+			start, end, err := paginate(f, len(updates), 25)
+			if err != nil {
+				return nil, err
+			}
+			return list(f, end-start, func(n int) (interface{}, error) {
+				return s.resolveUpdate(f, updates[start+n])
+			})
+		}
+		return nil, errUnknownField
+	})
+}
+
+func (s *Server) resolveGroup(sel selection, b *board, g *group) (interface{}, error) {
+	return object("Group", sel, func(f selection) (interface{}, error) {
+		switch f.name {
+		case "id":
+			return leaf(f, g.id)
+		case "title":
+			return leaf(f, g.title)
+		case "color":
+			return leaf(f, g.color)
+		case "archived":
+			return leaf(f, g.archived)
+		case "deleted":
+			return leaf(f, g.deleted)
+		case "position":
+			return leaf(f, strconv.FormatFloat(g.position, 'f', -1, 64))
+		case "items":
+			return s.resolveItems(f, func(i *item) bool {
+				return i.board == b && i.groupID == g.id
+			})
+		}
+		return nil, errUnknownField
+	})
+}
+
+func (s *Server) resolveColumn(sel selection, c *column) (interface{}, error) {
+	return object("Column", sel, func(f selection) (interface{}, error) {
+		switch f.name {
+		case "id":
+			return leaf(f, c.id)
+		case "title":
+			return leaf(f, c.title)
+		case "type":
+			return leaf(f, c.typ)
+		case "archived":
+			return leaf(f, c.archived)
+		case "description":
+			if c.description == "" {
+				return leaf(f, nil)
+			}
+			return leaf(f, c.description)
+		case "width":
+			if c.width == 0 {
+				return leaf(f, nil)
+			}
+			return leaf(f, c.width)
+		case "settings_str":
+			raw, _ := json.Marshal(c.settings)
+			return leaf(f, string(raw))
+		}
+		return nil, errUnknownField
+	})
+}
+
+// resolveItems resolves the active items that match the filter, taking the ids and pagination arguments into account.
+func (s *Server) resolveItems(sel selection, filter func(*item) bool) (interface{}, error) {
+	ids, filtered, err := argIDs(sel)
+	if err != nil {
+		return nil, err
+	}
+	var items []*item
+	for _, i := range s.items {
+		if i.state == "active" && filter(i) && (!filtered || contains(ids, strconv.Itoa(i.id))) {
+			items = append(items, i)
+		}
+	}
+	if newest, _, _ := argBool(sel, "newest_first"); newest {
+		items = reverseItems(items)
+	}
+	start, end, err := paginate(sel, len(items), len(items))
+	if err != nil {
+		return nil, err
+	}
+	return list(sel, end-start, func(n int) (interface{}, error) {
+		return s.resolveItem(sel, items[start+n])
+	})
+}
+
+func (s *Server) resolveItem(sel selection, i *item) (interface{}, error) {
+	return object("Item", sel, func(f selection) (interface{}, error) {
+		switch f.name {
+		case "id":
+			return leaf(f, strconv.Itoa(i.id))
+		case "name":
+			return leaf(f, i.name)
+		case "state":
+			return leaf(f, i.state)
+		case "created_at":
+			return leaf(f, i.createdAt.Format(time.RFC3339))
+		case "updated_at":
+			return leaf(f, i.updatedAt.Format(time.RFC3339))
+		case "creator_id":
+			return leaf(f, strconv.Itoa(i.creatorID))
+		case "creator":
+			return s.resolveUser(f, s.user(i.creatorID))
+		case "subscribers":
+			return list(f, 1, func(int) (interface{}, error) {
+				return s.resolveUser(f, s.user(i.creatorID))
+			})
+		case "board":
+			return s.resolveBoard(f, i.board)
+		case "group":
+			g, err := i.board.group(i.groupID)
+			if err != nil {
+				return nil, err
+			}
+			return s.resolveGroup(f, i.board, g)
+		case "column_values":
+			ids, filtered, err := argIDs(f)
+			if err != nil {
+				return nil, err
+			}
+			var columns []*column
+			for _, c := range i.board.columns {
+				if c.typ != "name" && !c.archived && (!filtered || contains(ids, c.id)) {
+					columns = append(columns, c)
+				}
+			}
+			return list(f, len(columns), func(n int) (interface{}, error) {
+				return s.resolveColumnValue(f, i, columns[n])
+			})
+		case "subitems":
+			var subitems []*item
+			for _, v := range s.items {
+				if v.parentID == i.id && v.state == "active" {
+					subitems = append(subitems, v)
+				}
+			}
+			return list(f, len(subitems), func(n int) (interface{}, error) {
+				return s.resolveItem(f, subitems[n])
+			})
+		case "updates":
+			var updates []*update
+			for n := len(s.updates) - 1; n >= 0; n-- {
+				if s.updates[n].itemID == i.id {
+					updates = append(updates, s.updates[n])
+				}
+			}
+			start, end, err := paginate(f, len(updates), 25)
+			if err != nil {
+				return nil, err
+			}
+			return list(f, end-start, func(n int) (interface{}, error) {
+				return s.resolveUpdate(f, updates[start+n])
+			})
+		}
+		return nil, errUnknownField
+	})
+}
+
+func (s *Server) resolveColumnValue(sel selection, i *item, c *column) (interface{}, error) {
+	return object("ColumnValue", sel, func(f selection) (interface{}, error) {
+		switch f.name {
+		case "id":
+			return leaf(f, c.id)
+		case "title":
+			return leaf(f, c.title)
+		case "type":
+			return leaf(f, c.typ)
+		case "text":
+			return leaf(f, s.text(i, c))
+		case "value":
+			raw, ok := i.values[c.id]
+			if !ok {
+				return leaf(f, nil)
+			}
+			return leaf(f, string(raw))
+		case "additional_info":
+			return leaf(f, nil)
+		}
+		return nil, errUnknownField
+	})
+}
+
+func (s *Server) resolveUser(sel selection, u *user) (interface{}, error) {
+	if u == nil {
+		return nil, nil
+	}
+	return object("User", sel, func(f selection) (interface{}, error) {
+		switch f.name {
+		case "id":
+			return leaf(f, u.id)
+		case "name":
+			return leaf(f, u.name)
+		case "email":
+			return leaf(f, u.email)
+		case "title":
+			return leaf(f, u.title)
+		case "created_at":
+			return leaf(f, u.createdAt.Format(time.RFC3339))
+		case "enabled":
+			return leaf(f, true)
+		case "is_guest", "is_pending":
+			return leaf(f, false)
+		case "birthday", "country_code", "join_date", "location", "mobile_phone", "phone", "photo_original",
+			"photo_small", "photo_thumb", "photo_thumb_small", "photo_tiny", "time_zone_identifier", "url":
+			return leaf(f, nil)
+		case "utc_hours_diff":
+			return leaf(f, 0)
+		case "teams":
+			return list(f, 0, nil)
+		}
+		return nil, errUnknownField
+	})
+}
+
+func (s *Server) resolveUpdate(sel selection, u *update) (interface{}, error) {
+	return object("Update", sel, func(f selection) (interface{}, error) {
+		switch f.name {
+		case "id":
+			return leaf(f, strconv.Itoa(u.id))
+		case "item_id":
+			return leaf(f, strconv.Itoa(u.itemID))
+		case "body":
+			return leaf(f, u.body)
+		case "text_body":
+			return leaf(f, textBody(u.body))
+		case "creator_id":
+			return leaf(f, strconv.Itoa(u.creatorID))
+		case "creator":
+			return s.resolveUser(f, s.user(u.creatorID))
+		case "created_at":
+			return leaf(f, u.createdAt.Format(time.RFC3339))
+		case "updated_at":
+			return leaf(f, u.updatedAt.Format(time.RFC3339))
+		case "replies":
+			return list(f, len(u.replies), func(n int) (interface{}, error) {
+				return s.resolveUpdate(f, u.replies[n])
+			})
+		}
+		return nil, errUnknownField
+	})
+}
+
+func (s *Server) resolveTag(sel selection, t *tag) (interface{}, error) {
+	return object("Tag", sel, func(f selection) (interface{}, error) {
+		switch f.name {
+		case "id":
+			return leaf(f, t.id)
+		case "name":
+			return leaf(f, t.name)
+		case "color":
+			return leaf(f, t.color)
+		}
+		return nil, errUnknownField
+	})
+}
+
+func (s *Server) resolveWebhook(sel selection, w *webhook) (interface{}, error) {
+	return object("Webhook", sel, func(f selection) (interface{}, error) {
+		switch f.name {
+		case "id":
+			return leaf(f, strconv.Itoa(w.id))
+		case "board_id":
+			return leaf(f, strconv.Itoa(w.boardID))
+		case "event":
+			return leaf(f, w.event)
+		case "config":
+			if w.config == "" {
+				return leaf(f, nil)
+			}
+			return leaf(f, w.config)
+		}
+		return nil, errUnknownField
+	})
+}
+
+// object resolves the selection set of an object of the given type.
+func object(typ string, sel selection, resolve func(selection) (interface{}, error)) (interface{}, error) {
+	if len(sel.selections) == 0 {
+		return nil, graphQLError{fmt.Sprintf("Field must have selections (field '%s' returns %s but has no selections. Did you mean '%s { ... }'?)", sel.name, typ, sel.name)}
+	}
+	obj := make(map[string]interface{})
+	for _, f := range sel.selections {
+		v, err := resolve(f)
+		if err == errUnknownField {
+			return nil, graphQLError{fmt.Sprintf("Field '%s' doesn't exist on type '%s'", f.name, typ)}
+		}
+		if err != nil {
+			return nil, err
+		}
+		obj[f.key()] = v
+	}
+	return obj, nil
+}
+
+// list resolves n elements, which are all resolved by the given function.
+func list(sel selection, n int, resolve func(int) (interface{}, error)) (interface{}, error) {
+	if len(sel.selections) == 0 {
+		return nil, graphQLError{fmt.Sprintf("Field must have selections (field '%s' returns a list but has no selections. Did you mean '%s { ... }'?)", sel.name, sel.name)}
+	}
+	values := make([]interface{}, 0, n)
+	for i := 0; i < n; i++ {
+		v, err := resolve(i)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+// leaf resolves a scalar value, which can not have a selection set.
+func leaf(sel selection, v interface{}) (interface{}, error) {
+	if len(sel.selections) != 0 {
+		return nil, graphQLError{fmt.Sprintf("Selections can't be made on scalars (field '%s' returns a scalar but has selections)", sel.name)}
+	}
+	return v, nil
+}
+
+func required(sel selection, names ...string) error {
+	var missing []string
+	for _, name := range names {
+		if v, ok := sel.args[name]; !ok || v == nil {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) != 0 {
+		return graphQLError{fmt.Sprintf("Field '%s' is missing required arguments: %s", sel.name, strings.Join(missing, ", "))}
+	}
+	return nil
+}
+
+func invalidArgument(sel selection, name string) error {
+	return graphQLError{fmt.Sprintf("Argument '%s' on Field '%s' has an invalid value (%v).", name, sel.name, sel.args[name])}
+}
+
+func argInt(sel selection, name string) (int, bool, error) {
+	switch v := sel.args[name].(type) {
+	case nil:
+		return 0, false, nil
+	case int:
+		return v, true, nil
+	case string:
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, false, invalidArgument(sel, name)
+		}
+		return i, true, nil
+	}
+	return 0, false, invalidArgument(sel, name)
+}
+
+func argString(sel selection, name string) (string, bool, error) {
+	switch v := sel.args[name].(type) {
+	case nil:
+		return "", false, nil
+	case string:
+		return v, true, nil
+	case graphql.Enum:
+		return string(v), true, nil
+	case int:
+		return strconv.Itoa(v), true, nil
+	}
+	return "", false, invalidArgument(sel, name)
+}
+
+func argBool(sel selection, name string) (bool, bool, error) {
+	switch v := sel.args[name].(type) {
+	case nil:
+		return false, false, nil
+	case bool:
+		return v, true, nil
+	}
+	return false, false, invalidArgument(sel, name)
+}
+
+// argIDs returns the ids argument, the ids can be either a single or a list of ints or strings.
+func argIDs(sel selection) ([]string, bool, error) {
+	var values []interface{}
+	switch v := sel.args["ids"].(type) {
+	case nil:
+		return nil, false, nil
+	case []interface{}:
+		values = v
+	default:
+		values = []interface{}{v}
+	}
+	ids := make([]string, 0, len(values))
+	for _, v := range values {
+		switch v := v.(type) {
+		case int:
+			ids = append(ids, strconv.Itoa(v))
+		case string:
+			ids = append(ids, v)
+		default:
+			return nil, false, invalidArgument(sel, "ids")
+		}
+	}
+	return ids, true, nil
+}
+
+// argColumnValues parses the column values json argument.
+func argColumnValues(sel selection) (map[string]json.RawMessage, error) {
+	raw, ok, err := argString(sel, "column_values")
+	if err != nil || !ok {
+		if v, isObject := sel.args["column_values"].(map[string]interface{}); isObject {
+			values := make(map[string]json.RawMessage)
+			for k, v := range v {
+				values[k], _ = json.Marshal(v)
+			}
+			return values, nil
+		}
+		return nil, err
+	}
+	values := make(map[string]json.RawMessage)
+	if err := json.Unmarshal([]byte(raw), &values); err != nil {
+		return nil, apiError{"ColumnValueException", "invalid column values: " + err.Error(), 200}
+	}
+	for k, v := range values {
+		// Simple columns accept plain strings, which the API converts to json itself.
+		if len(v) != 0 && v[0] != '"' && v[0] != '{' && v[0] != '[' && v[0] != 'n' {
+			values[k], _ = json.Marshal(string(v))
+		}
+	}
+	return values, nil
+}
+
+// paginate returns the range of elements on the requested page.
+func paginate(sel selection, n, defaultLimit int) (int, int, error) {
+	limit, hasLimit, err := argInt(sel, "limit")
+	if err != nil {
+		return 0, 0, err
+	}
+	page, hasPage, err := argInt(sel, "page")
+	if err != nil {
+		return 0, 0, err
+	}
+	if !hasLimit {
+		limit = defaultLimit
+		if hasPage && limit == n {
+			limit = 25
+		}
+	}
+	if !hasPage || page < 1 {
+		page = 1
+	}
+	start := (page - 1) * limit
+	if start > n {
+		start = n
+	}
+	end := start + limit
+	if end > n || limit < 0 {
+		end = n
+	}
+	return start, end, nil
+}
+
+func matchState(state, filter string) bool {
+	switch filter {
+	case "", "active":
+		return state == "active"
+	case "all":
+		return true
+	}
+	return state == filter
+}
+
+func contains(ids []string, id string) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInt(ids []int, id int) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+func reverseBoards(boards []*board) []*board {
+	reversed := make([]*board, 0, len(boards))
+	for i := len(boards) - 1; i >= 0; i-- {
+		reversed = append(reversed, boards[i])
+	}
+	return reversed
+}
+
+func reverseItems(items []*item) []*item {
+	reversed := make([]*item, 0, len(items))
+	for i := len(items) - 1; i >= 0; i-- {
+		reversed = append(reversed, items[i])
+	}
+	return reversed
+}
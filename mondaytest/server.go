@@ -0,0 +1,252 @@
+// Package mondaytest provides an in-memory fake of the Monday API for hermetic tests.
+//
+// The fake parses the graphql documents the library emits and keeps boards, groups, columns, items,
+// column values, updates, tags, users and webhooks in memory. Responses and errors mimic the real API.
+package mondaytest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/di-wu/monday"
+)
+
+// Server is a fake Monday API server.
+type Server struct {
+	*httptest.Server
+
+	// Token is the access token the server accepts. If empty, any non-empty token is accepted.
+	Token string
+	// Now returns the current time, defaults to time.Now.
+	Now func() time.Time
+
+	mu      sync.Mutex
+	queries []string
+	*store
+}
+
+// NewServer starts and returns a new fake server with a single user that owns the access token.
+// The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		Now:   time.Now,
+		store: newStore(),
+	}
+	s.store.now = func() time.Time {
+		return s.Now().UTC()
+	}
+	s.store.addUser("Test User", "test@example.com")
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+// Client returns an http client that sends all requests, regardless of their host, to the fake server.
+func (s *Server) Client() *http.Client {
+	target, _ := url.Parse(s.URL)
+	return &http.Client{
+		Transport: rewriteTransport{
+			base:   s.Server.Client().Transport,
+			target: target,
+		},
+	}
+}
+
+// NewClient returns a monday client that is connected to the fake server.
+func (s *Server) NewClient() *monday.Client {
+	token := s.Token
+	if token == "" {
+		token = "mondaytest"
+	}
+	return monday.NewClient(token, s.Client())
+}
+
+// AddUser adds a user to the account and returns its unique identifier.
+func (s *Server) AddUser(name, email string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addUser(name, email).id
+}
+
+// Queries returns all the graphql documents the server received, in order.
+func (s *Server) Queries() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.queries...)
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	token := r.Header.Get("Authorization")
+	if token == "" || s.Token != "" && token != s.Token && token != "Bearer "+s.Token {
+		writeJSON(w, http.StatusUnauthorized, map[string]interface{}{
+			"error_message": "Not Authenticated",
+			"status_code":   http.StatusUnauthorized,
+		})
+		return
+	}
+	if r.Method != http.MethodPost {
+		writeJSON(w, http.StatusMethodNotAllowed, map[string]interface{}{
+			"error_message": "Only POST requests are supported",
+			"status_code":   http.StatusMethodNotAllowed,
+		})
+		return
+	}
+
+	documents, variables, err := readDocuments(r)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]interface{}{
+			"error_message": err.Error(),
+			"status_code":   http.StatusBadRequest,
+		})
+		return
+	}
+	if len(documents) == 0 {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"errors": []graphQLError{{"No query string was present"}},
+		})
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.queries = append(s.queries, documents...)
+
+	data := make(map[string]interface{})
+	for _, document := range documents {
+		operations, err := parse(document, variables)
+		if err != nil {
+			writeJSON(w, http.StatusOK, map[string]interface{}{
+				"errors": []graphQLError{{err.Error()}},
+			})
+			return
+		}
+		for _, operation := range operations {
+			if err := s.execute(operation, data); err != nil {
+				writeError(w, err, data)
+				return
+			}
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data":       data,
+		"account_id": accountID,
+	})
+}
+
+// readDocuments reads the graphql documents from a form encoded or json encoded request.
+func readDocuments(r *http.Request) ([]string, map[string]interface{}, error) {
+	raw, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		var body struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+		if err := json.Unmarshal(raw, &body); err != nil {
+			return nil, nil, fmt.Errorf("invalid json body: %v", err)
+		}
+		if body.Query == "" {
+			return nil, body.Variables, nil
+		}
+		return []string{body.Query}, normalizeVariables(body.Variables), nil
+	}
+	values, err := url.ParseQuery(string(raw))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid form body: %v", err)
+	}
+	var variables map[string]interface{}
+	if v := values.Get("variables"); v != "" {
+		if err := json.Unmarshal([]byte(v), &variables); err != nil {
+			return nil, nil, fmt.Errorf("invalid variables: %v", err)
+		}
+	}
+	return values["query"], normalizeVariables(variables), nil
+}
+
+// normalizeVariables converts json numbers to the values the parser produces.
+func normalizeVariables(variables map[string]interface{}) map[string]interface{} {
+	for k, v := range variables {
+		if f, ok := v.(float64); ok && f == float64(int(f)) {
+			variables[k] = int(f)
+		}
+	}
+	return variables
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeError writes the error, together with the data of the selections that were executed before it, like the
+// results of the mutations that succeeded.
+func writeError(w http.ResponseWriter, err error, data map[string]interface{}) {
+	var body map[string]interface{}
+	switch err := err.(type) {
+	case graphQLError:
+		body = map[string]interface{}{
+			"errors":     []graphQLError{err},
+			"account_id": accountID,
+		}
+	case apiError:
+		body = map[string]interface{}{
+			"error_code":    err.Code,
+			"status_code":   err.StatusCode,
+			"error_message": err.Message,
+			"error_data":    map[string]interface{}{},
+			"account_id":    accountID,
+		}
+	default:
+		writeJSON(w, http.StatusInternalServerError, map[string]interface{}{
+			"error_message": "Internal server error",
+			"status_code":   http.StatusInternalServerError,
+		})
+		return
+	}
+	if len(data) != 0 {
+		body["data"] = data
+	}
+	writeJSON(w, http.StatusOK, body)
+}
+
+// graphQLError is an error in the graphql document itself, like an unknown field or a missing argument.
+type graphQLError struct {
+	Message string `json:"message"`
+}
+
+func (e graphQLError) Error() string {
+	return e.Message
+}
+
+// apiError is an error raised while executing the document, like a board that does not exist.
+type apiError struct {
+	Code       string
+	Message    string
+	StatusCode int
+}
+
+func (e apiError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+type rewriteTransport struct {
+	base   http.RoundTripper
+	target *url.URL
+}
+
+func (t rewriteTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	r.URL.Scheme = t.target.Scheme
+	r.URL.Host = t.target.Host
+	r.Host = t.target.Host
+	return t.base.RoundTrip(r)
+}
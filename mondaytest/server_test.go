@@ -0,0 +1,176 @@
+package mondaytest
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/di-wu/monday"
+)
+
+func exec(t *testing.T, c *monday.Client, payload monday.Payload) (int, map[string]interface{}) {
+	t.Helper()
+	resp, err := c.Exec(context.Background(), payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	raw, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var body map[string]interface{}
+	if err := json.Unmarshal(raw, &body); err != nil {
+		t.Fatalf("%v: %s", err, raw)
+	}
+	return resp.StatusCode, body
+}
+
+func TestServer(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	c := s.NewClient()
+
+	_, body := exec(t, c, monday.NewMutationPayload(
+		monday.Boards.Create("Board", monday.BoardsKindPublic(), nil),
+	))
+	boardID := body["data"].(map[string]interface{})["create_board"].(map[string]interface{})["id"].(string)
+	id := mustAtoi(t, boardID)
+
+	_, body = exec(t, c, monday.NewMutationPayload(
+		monday.Columns.CreateWithDefaults(id, "Status", monday.ColumnsTypeStatus(), `{"labels":["Open","Closed"]}`, nil),
+	))
+	columnID := body["data"].(map[string]interface{})["create_column"].(map[string]interface{})["id"].(string)
+
+	_, body = exec(t, c, monday.NewMutationPayload(
+		monday.Items.Create(id, "topics", "Item", []monday.ColumnValue{
+			monday.NewStatusLabelValue(columnID, "Closed"),
+		}, nil),
+	))
+	if _, ok := body["data"]; !ok {
+		t.Fatalf("expected data, got %v", body)
+	}
+
+	_, body = exec(t, c, monday.NewQueryPayload(
+		monday.ItemsByColumnValues.List(id, columnID, monday.NewJSONValue(columnID, "Closed"),
+			[]monday.ItemsByColumnValuesField{
+				monday.ItemsByColumnValuesNameField(),
+				monday.NewItemsByColumnValuesColumnValuesField([]monday.ColumnValuesField{
+					monday.ColumnValuesTextField(),
+					monday.ColumnValuesValueField(),
+				}, nil),
+			}),
+	))
+	items := body["data"].(map[string]interface{})["items_by_column_values"].([]interface{})
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", body)
+	}
+	values := items[0].(map[string]interface{})["column_values"].([]interface{})
+	value := values[0].(map[string]interface{})
+	if value["text"] != "Closed" || value["value"] != `{"index":1}` {
+		t.Errorf("unexpected column value %v", value)
+	}
+
+	// the value is not a json string.
+	_, body = exec(t, c, monday.NewQueryPayload(
+		monday.ItemsByColumnValues.List(id, columnID, monday.NewTextValue(columnID, "Closed"), nil),
+	))
+	if items := body["data"].(map[string]interface{})["items_by_column_values"].([]interface{}); len(items) != 0 {
+		t.Errorf("expected no items for a quoted value, got %v", items)
+	}
+}
+
+func TestServerErrors(t *testing.T) {
+	s := NewServer()
+	s.Token = "secret"
+	defer s.Close()
+
+	if status, _ := exec(t, monday.NewClient("wrong", s.Client()), monday.NewQueryPayload(
+		monday.Boards.List(nil),
+	)); status != http.StatusUnauthorized {
+		t.Errorf("got status %d, expected %d", status, http.StatusUnauthorized)
+	}
+
+	c := s.NewClient()
+	for _, test := range []struct {
+		payload monday.Payload
+		key     string
+		message string
+	}{
+		{
+			payload: monday.NewMutationPayload(monday.Items.Archive(42, nil)),
+			key:     "error_code",
+			message: "InvalidItemIdException",
+		},
+		{
+			payload: monday.NewMutationPayload(monday.Groups.Create(42, "Group", nil)),
+			key:     "error_code",
+			message: "InvalidBoardIdException",
+		},
+		{
+			payload: monday.NewQueryPayload(monday.Account.Get([]monday.AccountField{
+				monday.AccountLogoField(),
+				monday.AccountFirstDayOfTheWeekField(),
+			})),
+			key: "data",
+		},
+	} {
+		_, body := exec(t, c, test.payload)
+		v, ok := body[test.key]
+		if !ok {
+			t.Errorf("expected %s, got %v", test.key, body)
+			continue
+		}
+		if test.message != "" && v != test.message {
+			t.Errorf("got %v, expected %s", v, test.message)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	for _, test := range []struct {
+		document string
+		err      string
+	}{
+		{document: `{boards(ids:[1,2]){id}}`},
+		{document: `query Q($id: Int!) { a: boards(ids: $id) { ...B } } fragment B on Board { id name }`},
+		{document: `mutation{create_update(item_id:1,body:"\u0000\ud83d\ude00 😀"){id}}`},
+		{document: `{boards{id}`, err: "Parse error"},
+		{document: `{boards{...B}}`, err: `unknown fragment "B"`},
+		{document: `{boards(ids:$id){id}}`, err: "undefined variable $id"},
+	} {
+		variables := map[string]interface{}{"id": 1}
+		if strings.Contains(test.err, "variable") {
+			variables = nil
+		}
+		_, err := parse(test.document, variables)
+		switch {
+		case test.err == "" && err != nil:
+			t.Errorf("%s: unexpected error %v", test.document, err)
+		case test.err != "" && (err == nil || !strings.Contains(err.Error(), test.err)):
+			t.Errorf("%s: got error %v, expected %s", test.document, err, test.err)
+		}
+	}
+
+	operations, err := parse(`{b:boards{...B}} fragment B on Board{id groups{id}}`, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sel := operations[0].selections[0]
+	if sel.key() != "b" || len(sel.selections) != 2 || sel.selections[1].name != "groups" {
+		t.Errorf("unexpected selection %v", sel)
+	}
+}
+
+func mustAtoi(t *testing.T, s string) int {
+	t.Helper()
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return i
+}
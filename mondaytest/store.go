@@ -0,0 +1,665 @@
+package mondaytest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const accountID = 1
+
+// defaultStatusLabels are the labels a status column gets when created without defaults.
+var defaultStatusLabels = map[string]string{
+	"0": "Working on it",
+	"1": "Done",
+	"2": "Stuck",
+}
+
+type store struct {
+	now    func() time.Time
+	nextID int
+
+	users    []*user
+	boards   []*board
+	items    []*item
+	updates  []*update
+	tags     []*tag
+	webhooks []*webhook
+
+	// complexity is the remaining complexity budget of the current minute.
+	complexity int
+}
+
+func newStore() *store {
+	return &store{
+		now:        time.Now,
+		nextID:     1000,
+		complexity: 10000000,
+	}
+}
+
+func (s *store) id() int {
+	s.nextID++
+	return s.nextID
+}
+
+type user struct {
+	id                 int
+	name, email, title string
+	createdAt          time.Time
+}
+
+type board struct {
+	id                             int
+	name, description, kind, state string
+	ownerID                        int
+	subscriberIDs                  []int
+	groups                         []*group
+	// subitems is the board that holds the subitems of the items of this board, created with the first subitem.
+	subitems  *board
+	columns   []*column
+	createdAt time.Time
+}
+
+type group struct {
+	id, title, color  string
+	position          float64
+	archived, deleted bool
+}
+
+type column struct {
+	id, title, typ string
+	description    string
+	settings       map[string]interface{}
+	archived       bool
+	width          int
+}
+
+type item struct {
+	id                   int
+	name, groupID, state string
+	board                *board
+	parentID             int
+	creatorID            int
+	createdAt, updatedAt time.Time
+	values               map[string]json.RawMessage
+}
+
+type update struct {
+	id, itemID, creatorID int
+	body                  string
+	createdAt, updatedAt  time.Time
+	replies               []*update
+}
+
+type tag struct {
+	id, boardID int
+	name, color string
+}
+
+type webhook struct {
+	id, boardID        int
+	url, event, config string
+}
+
+func (s *store) addUser(name, email string) *user {
+	u := &user{
+		id:        s.id(),
+		name:      name,
+		email:     email,
+		createdAt: s.now(),
+	}
+	s.users = append(s.users, u)
+	return u
+}
+
+// me returns the user that owns the access token.
+func (s *store) me() *user {
+	return s.users[0]
+}
+
+func (s *store) user(id int) *user {
+	for _, u := range s.users {
+		if u.id == id {
+			return u
+		}
+	}
+	return nil
+}
+
+func (s *store) board(id int) (*board, error) {
+	for _, b := range s.boards {
+		if b.id == id && b.state != "deleted" {
+			return b, nil
+		}
+	}
+	return nil, apiError{"InvalidBoardIdException", fmt.Sprintf("Board not found (id: %d)", id), 200}
+}
+
+func (s *store) item(id int) (*item, error) {
+	for _, i := range s.items {
+		if i.id == id && i.state != "deleted" {
+			return i, nil
+		}
+	}
+	return nil, apiError{"InvalidItemIdException", fmt.Sprintf("Item not found (id: %d)", id), 200}
+}
+
+func (b *board) group(id string) (*group, error) {
+	for _, g := range b.groups {
+		if g.id == id && !g.deleted {
+			return g, nil
+		}
+	}
+	return nil, apiError{"InvalidGroupIdException", fmt.Sprintf("Group not found (id: %s)", id), 200}
+}
+
+func (b *board) column(id string) (*column, error) {
+	for _, c := range b.columns {
+		if c.id == id && !c.archived {
+			return c, nil
+		}
+	}
+	return nil, apiError{"InvalidColumnIdException", fmt.Sprintf("This column ID doesn't exist for the board (id: %s)", id), 200}
+}
+
+func (s *store) createBoard(name, kind string, ownerID int) *board {
+	b := &board{
+		id:        s.id(),
+		name:      name,
+		kind:      kind,
+		state:     "active",
+		ownerID:   ownerID,
+		createdAt: s.now(),
+	}
+	b.columns = append(b.columns, &column{id: "name", title: "Name", typ: "name", settings: map[string]interface{}{}})
+	b.groups = append(b.groups, &group{id: "topics", title: "Group Title", color: groupColors[0]})
+	s.boards = append(s.boards, b)
+	return b
+}
+
+var groupColors = []string{"#579bfc", "#00c875", "#fdab3d", "#e2445c", "#a25ddc", "#66ccff", "#784bd1", "#ff642e"}
+
+var nonAlphaNumeric = regexp.MustCompile(`[^a-z0-9]+`)
+
+func (b *board) createGroup(title string, top bool) *group {
+	id := nonAlphaNumeric.ReplaceAllString(strings.ToLower(title), "_")
+	if id == "" || id == "_" {
+		id = "new_group"
+	}
+	for n := 0; ; n++ {
+		candidate := id
+		if n != 0 {
+			candidate = fmt.Sprintf("%s%d", id, n)
+		}
+		if _, err := b.group(candidate); err != nil {
+			id = candidate
+			break
+		}
+	}
+	g := &group{
+		id:    id,
+		title: title,
+		color: groupColors[len(b.groups)%len(groupColors)],
+	}
+	if top {
+		g.position = -float64(len(b.groups) + 1)
+		b.groups = append([]*group{g}, b.groups...)
+		return g
+	}
+	g.position = float64(len(b.groups))
+	b.groups = append(b.groups, g)
+	return g
+}
+
+func (b *board) createColumn(title, typ, defaults string) (*column, error) {
+	if !columnTypes[typ] {
+		return nil, graphQLError{fmt.Sprintf("Argument 'column_type' on Field 'create_column' has an invalid value (%s).", typ)}
+	}
+	settings := make(map[string]interface{})
+	if defaults != "" {
+		if err := json.Unmarshal([]byte(defaults), &settings); err != nil {
+			return nil, apiError{"InvalidArgumentException", "Invalid defaults: " + err.Error(), 200}
+		}
+	}
+	normalizeSettings(typ, settings)
+
+	id := typ
+	for n := 0; ; n++ {
+		candidate := id
+		if n != 0 {
+			candidate = fmt.Sprintf("%s%d", id, n)
+		}
+		var taken bool
+		for _, c := range b.columns {
+			taken = taken || c.id == candidate
+		}
+		if !taken {
+			id = candidate
+			break
+		}
+	}
+	c := &column{
+		id:       id,
+		title:    title,
+		typ:      typ,
+		settings: settings,
+	}
+	b.columns = append(b.columns, c)
+	return c, nil
+}
+
+// normalizeSettings converts the labels of status and dropdown columns to the form in which monday stores them.
+func normalizeSettings(typ string, settings map[string]interface{}) {
+	switch typ {
+	case "status":
+		labels := make(map[string]interface{})
+		switch l := settings["labels"].(type) {
+		case []interface{}:
+			for i, v := range l {
+				labels[strconv.Itoa(i)] = v
+			}
+		case map[string]interface{}:
+			labels = l
+		default:
+			for k, v := range defaultStatusLabels {
+				labels[k] = v
+			}
+		}
+		settings["labels"] = labels
+	case "dropdown":
+		var labels []interface{}
+		if l, ok := settings["labels"].([]interface{}); ok {
+			for i, v := range l {
+				if name, ok := v.(string); ok {
+					v = map[string]interface{}{"id": float64(i + 1), "name": name}
+				}
+				labels = append(labels, v)
+			}
+		}
+		settings["labels"] = labels
+	}
+}
+
+// moveGroup moves the group directly after (or before) the other group.
+func (b *board) moveGroup(g, other *group, after bool) {
+	var groups []*group
+	for _, v := range b.groups {
+		if v != g {
+			groups = append(groups, v)
+		}
+	}
+	for i, v := range groups {
+		if v != other {
+			continue
+		}
+		if after {
+			i++
+		}
+		groups = append(groups[:i], append([]*group{g}, groups[i:]...)...)
+		break
+	}
+	for i, v := range groups {
+		v.position = float64(i)
+	}
+	b.groups = groups
+}
+
+var columnTypes = map[string]bool{
+	"auto_number": true, "checkbox": true, "country": true, "color_picker": true, "creation_log": true,
+	"date": true, "dropdown": true, "email": true, "hour": true, "item_id": true, "last_updated": true,
+	"link": true, "location": true, "long_text": true, "numbers": true, "people": true, "phone": true,
+	"progress": true, "rating": true, "status": true, "team": true, "tags": true, "text": true,
+	"timeline": true, "time_tracking": true, "vote": true, "week": true, "world_clock": true,
+}
+
+func (s *store) createItem(b *board, groupID, name string, values map[string]json.RawMessage) (*item, error) {
+	if groupID == "" {
+		for _, g := range b.groups {
+			if !g.archived && !g.deleted {
+				groupID = g.id
+				break
+			}
+		}
+	}
+	if _, err := b.group(groupID); err != nil {
+		return nil, err
+	}
+	now := s.now()
+	i := &item{
+		id:        s.id(),
+		name:      name,
+		groupID:   groupID,
+		state:     "active",
+		board:     b,
+		creatorID: s.me().id,
+		createdAt: now,
+		updatedAt: now,
+		values:    make(map[string]json.RawMessage),
+	}
+	if err := s.setValues(i, values); err != nil {
+		return nil, err
+	}
+	s.items = append(s.items, i)
+	return i, nil
+}
+
+// createSubitem creates a subitem on the subitems board of the parent's board.
+func (s *store) createSubitem(parent *item, name string, values map[string]json.RawMessage) (*item, error) {
+	b := parent.board
+	if b.subitems == nil {
+		b.subitems = s.createBoard("Subitems of "+b.name, b.kind, b.ownerID)
+		b.subitems.groups[0].title = "Subitems"
+	}
+	i, err := s.createItem(b.subitems, "", name, values)
+	if err != nil {
+		return nil, err
+	}
+	i.parentID = parent.id
+	return i, nil
+}
+
+// setValues sets multiple column values at once, either all or none of the values are applied.
+func (s *store) setValues(i *item, values map[string]json.RawMessage) error {
+	normalized := make(map[string]json.RawMessage)
+	var name string
+	ids := make([]string, 0, len(values))
+	for id := range values {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		raw := values[id]
+		if id == "name" {
+			if err := json.Unmarshal(raw, &name); err != nil || name == "" {
+				return apiError{"ColumnValueException", "invalid value for the name column", 200}
+			}
+			continue
+		}
+		c, err := i.board.column(id)
+		if err != nil {
+			return err
+		}
+		v, err := s.normalize(c, raw)
+		if err != nil {
+			return err
+		}
+		normalized[id] = v
+	}
+	if name != "" {
+		i.name = name
+	}
+	for id, v := range normalized {
+		if v == nil {
+			delete(i.values, id)
+			continue
+		}
+		i.values[id] = v
+	}
+	i.updatedAt = s.now()
+	return nil
+}
+
+// normalize validates the value of a column and converts it into the form the API stores it in.
+// A nil value means that the value should be removed.
+func (s *store) normalize(c *column, raw json.RawMessage) (json.RawMessage, error) {
+	invalid := func(reason string) error {
+		return apiError{"ColumnValueException", fmt.Sprintf("invalid value for column %s: %s", c.id, reason), 200}
+	}
+	raw = bytes.TrimSpace(raw)
+	switch string(raw) {
+	case "", "null", `""`, "{}":
+		return nil, nil
+	}
+	var v interface{}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, invalid(err.Error())
+	}
+	switch c.typ {
+	case "text", "numbers", "name":
+		str, ok := v.(string)
+		if !ok {
+			if f, isNumber := v.(float64); isNumber && c.typ == "numbers" {
+				str, ok = strconv.FormatFloat(f, 'f', -1, 64), true
+			}
+		}
+		if !ok {
+			return nil, invalid("expected a string")
+		}
+		if c.typ == "numbers" {
+			if _, err := strconv.ParseFloat(str, 64); err != nil {
+				return nil, invalid("expected a number")
+			}
+		}
+		return json.Marshal(str)
+	case "status":
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			if label, isString := v.(string); isString {
+				obj, ok = map[string]interface{}{"label": label}, true
+			}
+		}
+		if !ok {
+			return nil, invalid("expected an index or label")
+		}
+		labels, _ := c.settings["labels"].(map[string]interface{})
+		if label, ok := obj["label"].(string); ok {
+			for index, l := range labels {
+				if l == label {
+					i, _ := strconv.Atoi(index)
+					return json.Marshal(map[string]interface{}{"index": i})
+				}
+			}
+			return nil, invalid(fmt.Sprintf("this status label doesn't exist, possible statuses are: %v", labelNames(labels)))
+		}
+		if index, ok := obj["index"].(float64); ok {
+			if _, ok := labels[strconv.Itoa(int(index))]; !ok {
+				return nil, invalid(fmt.Sprintf("this status index doesn't exist: %d", int(index)))
+			}
+			return json.Marshal(map[string]interface{}{"index": int(index)})
+		}
+		return nil, invalid("expected an index or label")
+	case "dropdown":
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, invalid("expected ids or labels")
+		}
+		labels, _ := c.settings["labels"].([]interface{})
+		var ids []int
+		if names, ok := obj["labels"].([]interface{}); ok {
+		names:
+			for _, name := range names {
+				for _, l := range labels {
+					if l, ok := l.(map[string]interface{}); ok && l["name"] == name {
+						ids = append(ids, int(l["id"].(float64)))
+						continue names
+					}
+				}
+				return nil, invalid(fmt.Sprintf("the dropdown label %v doesn't exist", name))
+			}
+		} else if values, ok := obj["ids"].([]interface{}); ok {
+		ids:
+			for _, id := range values {
+				for _, l := range labels {
+					if l, ok := l.(map[string]interface{}); ok && l["id"] == id {
+						ids = append(ids, int(id.(float64)))
+						continue ids
+					}
+				}
+				return nil, invalid(fmt.Sprintf("the dropdown id %v doesn't exist", id))
+			}
+		} else {
+			return nil, invalid("expected ids or labels")
+		}
+		return json.Marshal(map[string]interface{}{"ids": ids})
+	case "long_text", "date", "email", "link", "checkbox", "country", "phone", "timeline", "week", "hour",
+		"rating", "tags", "people", "team", "world_clock", "location":
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, invalid("expected a json object")
+		}
+		if c.typ == "date" {
+			date, _ := obj["date"].(string)
+			if _, err := time.Parse("2006-01-02", date); err != nil {
+				return nil, invalid("expected a date in YYYY-MM-DD format")
+			}
+		}
+		if c.typ == "checkbox" && fmt.Sprint(obj["checked"]) != "true" {
+			return nil, nil
+		}
+		return json.Marshal(obj)
+	default:
+		return nil, apiError{"ColumnValueException", fmt.Sprintf("column %s of type %s can not be changed", c.id, c.typ), 200}
+	}
+}
+
+func labelNames(labels map[string]interface{}) []string {
+	var names []string
+	for _, l := range labels {
+		if l, ok := l.(string); ok && l != "" {
+			names = append(names, l)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// text returns the textual representation of the column value, like the API shows it in the ui.
+func (s *store) text(i *item, c *column) string {
+	if c.typ == "name" {
+		return i.name
+	}
+	raw, ok := i.values[c.id]
+	if !ok {
+		return ""
+	}
+	var v interface{}
+	_ = json.Unmarshal(raw, &v)
+	if str, ok := v.(string); ok {
+		return str
+	}
+	obj, _ := v.(map[string]interface{})
+	str := func(key string) string {
+		if v, ok := obj[key]; ok && v != nil {
+			return fmt.Sprint(v)
+		}
+		return ""
+	}
+	switch c.typ {
+	case "status":
+		labels, _ := c.settings["labels"].(map[string]interface{})
+		if l, ok := labels[str("index")].(string); ok {
+			return l
+		}
+	case "dropdown":
+		labels, _ := c.settings["labels"].([]interface{})
+		var names []string
+		ids, _ := obj["ids"].([]interface{})
+		for _, id := range ids {
+			for _, l := range labels {
+				if l, ok := l.(map[string]interface{}); ok && l["id"] == id {
+					names = append(names, fmt.Sprint(l["name"]))
+				}
+			}
+		}
+		return strings.Join(names, ", ")
+	case "date":
+		if t := str("time"); t != "" {
+			return str("date") + " " + t
+		}
+		return str("date")
+	case "timeline":
+		return str("from") + " - " + str("to")
+	case "week":
+		week, _ := obj["week"].(map[string]interface{})
+		return fmt.Sprintf("%v - %v", week["startDate"], week["endDate"])
+	case "hour":
+		hour, _ := obj["hour"].(float64)
+		minute, _ := obj["minute"].(float64)
+		return fmt.Sprintf("%02d:%02d", int(hour), int(minute))
+	case "checkbox":
+		return "v"
+	case "email":
+		return str("email")
+	case "link":
+		if str("text") == "" {
+			return str("url")
+		}
+		return str("text") + " - " + str("url")
+	case "country":
+		return str("countryName")
+	case "phone":
+		return str("phone")
+	case "world_clock":
+		return str("timezone")
+	case "rating":
+		return str("rating")
+	case "long_text":
+		return str("text")
+	case "people":
+		var names []string
+		people, _ := obj["personsAndTeams"].([]interface{})
+		for _, p := range people {
+			p, _ := p.(map[string]interface{})
+			id, _ := p["id"].(float64)
+			if u := s.user(int(id)); u != nil {
+				names = append(names, u.name)
+			}
+		}
+		return strings.Join(names, ", ")
+	case "tags":
+		var names []string
+		ids, _ := obj["tag_ids"].([]interface{})
+		for _, id := range ids {
+			id, _ := id.(float64)
+			for _, t := range s.tags {
+				if t.id == int(id) {
+					names = append(names, t.name)
+				}
+			}
+		}
+		return strings.Join(names, ", ")
+	}
+	return ""
+}
+
+func (s *store) createUpdate(i *item, body string) *update {
+	now := s.now()
+	u := &update{
+		id:        s.id(),
+		itemID:    i.id,
+		creatorID: s.me().id,
+		body:      body,
+		createdAt: now,
+		updatedAt: now,
+	}
+	s.updates = append(s.updates, u)
+	return u
+}
+
+var htmlTags = regexp.MustCompile(`<[^>]*>`)
+
+func textBody(body string) string {
+	return htmlTags.ReplaceAllString(body, "")
+}
+
+func (s *store) createOrGetTag(name string, boardID int) *tag {
+	for _, t := range s.tags {
+		if t.name == name && t.boardID == boardID {
+			return t
+		}
+	}
+	t := &tag{
+		id:      s.id(),
+		boardID: boardID,
+		name:    name,
+		color:   groupColors[len(s.tags)%len(groupColors)],
+	}
+	s.tags = append(s.tags, t)
+	return t
+}
@@ -10,9 +10,46 @@ func NewMutationPayload(mutations ...Mutation) Payload {
 }
 
 type Mutation struct {
+	alias  string
 	name   string
 	fields []field
 	args   []argument
+	// scalar mutations return a scalar (e.g. JSON) and therefore have no fields.
+	scalar bool
+	// confirmed mutations are allowed to be destructive, see GuardPolicy.
+	confirmed bool
+}
+
+// WithAlias returns the mutation under the given alias, so the same mutation can be used multiple times in a payload.
+// The result of the mutation is returned under the alias instead of the name of the mutation.
+func (m Mutation) WithAlias(alias string) Mutation {
+	m.alias = alias
+	return m
+}
+
+// Confirm returns the mutation confirmed to be destructive, so it is sent by clients that require destructive
+// mutations to be confirmed, see GuardPolicy.
+func (m Mutation) Confirm() Mutation {
+	m.confirmed = true
+	return m
+}
+
+// Name returns the name of the root field of the mutation (e.g. create_item).
+func (m Mutation) Name() string {
+	return m.name
+}
+
+// Alias returns the alias of the mutation, or an empty string if it has none.
+func (m Mutation) Alias() string {
+	return m.alias
+}
+
+// key returns the key of the result of the mutation in the data of the response.
+func (m Mutation) key() string {
+	if m.alias != "" {
+		return m.alias
+	}
+	return m.name
 }
 
 func (m Mutation) stringify() string {
@@ -24,12 +61,22 @@ func (m Mutation) stringify() string {
 	for _, arg := range m.args {
 		args = append(args, arg.stringify())
 	}
-	if len(fields) == 0 {
+	if len(fields) == 0 && !m.scalar {
 		return ``
 	}
+	name := m.name
+	if m.alias != "" {
+		name = fmt.Sprintf(`%s:%s`, m.alias, m.name)
+	}
+	if m.scalar && len(args) == 0 {
+		return name
+	}
+	if m.scalar {
+		return fmt.Sprintf(`%s(%s)`, name, strings.Join(args, ","))
+	}
 	if len(args) == 0 {
-		return fmt.Sprintf(`%s{%s}`, m.name, strings.Join(fields, " "))
+		return fmt.Sprintf(`%s{%s}`, name, strings.Join(fields, " "))
 	}
-	return fmt.Sprintf(`%s(%s){%s}`, m.name, strings.Join(args, ","), strings.Join(fields, " "))
+	return fmt.Sprintf(`%s(%s){%s}`, name, strings.Join(args, ","), strings.Join(fields, " "))
 
 }
@@ -77,6 +77,11 @@ type NotificationType struct {
 	kind string
 }
 
+// EnumValue returns the value as it appears in a query.
+func (t NotificationType) EnumValue() string {
+	return t.kind
+}
+
 var (
 	notificationTypeProject = NotificationType{"project"}
 	notificationTypePost    = NotificationType{"post"}
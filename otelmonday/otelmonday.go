@@ -0,0 +1,304 @@
+// Package otelmonday instruments the calls of a monday client with OpenTelemetry tracing and metrics.
+//
+// The instrumentation is an interceptor, so the monday package itself does not depend on OpenTelemetry:
+//
+//	client := monday.NewClient(token, nil)
+//	client.Use(otelmonday.Interceptor())
+//
+// Each call gets one client span with the names of its operations (e.g. boards, create_item), the number of
+// aliased operations, the complexity reported by the complexity field, the http status and the error code.
+// The token, the documents and the values of the arguments are never recorded.
+//
+// The monday client does not retry calls or wait for rate limits itself. Interceptors that do, and that are used
+// after this interceptor, report it with RecordRetry and RecordRateLimitWait.
+package otelmonday
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/di-wu/monday"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/di-wu/monday/otelmonday"
+
+// The attributes of the spans and metrics.
+const (
+	OperationNamesKey    = attribute.Key("monday.operation.names")
+	OperationTypeKey     = attribute.Key("monday.operation.type")
+	AliasedOperationsKey = attribute.Key("monday.operation.aliased")
+	ComplexityQueryKey   = attribute.Key("monday.complexity.query")
+	ComplexityBeforeKey  = attribute.Key("monday.complexity.before")
+	ComplexityAfterKey   = attribute.Key("monday.complexity.after")
+	ErrorCodeKey         = attribute.Key("monday.error_code")
+	RetriesKey           = attribute.Key("monday.retries")
+	HTTPStatusCodeKey    = attribute.Key("http.response.status_code")
+)
+
+// rawOperations is the type of the operations of raw documents, their operations are not known.
+const rawOperations = "raw"
+
+type config struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+}
+
+// Option configures the instrumentation.
+type Option func(*config)
+
+// WithTracerProvider sets the tracer provider, the global provider is used by default.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.tracerProvider = provider
+	}
+}
+
+// WithMeterProvider sets the meter provider, the global provider is used by default.
+func WithMeterProvider(provider metric.MeterProvider) Option {
+	return func(c *config) {
+		c.meterProvider = provider
+	}
+}
+
+type instruments struct {
+	duration      metric.Float64Histogram
+	complexity    metric.Int64Counter
+	retries       metric.Int64Counter
+	rateLimitWait metric.Float64Histogram
+}
+
+func newInstruments(meter metric.Meter) instruments {
+	var i instruments
+	var err error
+	if i.duration, err = meter.Float64Histogram("monday.client.duration",
+		metric.WithDescription("Duration of the calls of the monday api."),
+		metric.WithUnit("s"),
+	); err != nil {
+		otel.Handle(err)
+	}
+	if i.complexity, err = meter.Int64Counter("monday.client.complexity",
+		metric.WithDescription("Complexity consumed by the calls of the monday api, as reported by the complexity field."),
+		metric.WithUnit("{complexity}"),
+	); err != nil {
+		otel.Handle(err)
+	}
+	if i.retries, err = meter.Int64Counter("monday.client.retries",
+		metric.WithDescription("Retries of the calls of the monday api."),
+		metric.WithUnit("{retry}"),
+	); err != nil {
+		otel.Handle(err)
+	}
+	if i.rateLimitWait, err = meter.Float64Histogram("monday.client.rate_limit.wait",
+		metric.WithDescription("Time waited for the rate limit of the monday api."),
+		metric.WithUnit("s"),
+	); err != nil {
+		otel.Handle(err)
+	}
+	return i
+}
+
+// Interceptor returns an interceptor that traces and measures the calls of a client.
+func Interceptor(options ...Option) monday.Interceptor {
+	c := config{
+		tracerProvider: otel.GetTracerProvider(),
+		meterProvider:  otel.GetMeterProvider(),
+	}
+	for _, option := range options {
+		option(&c)
+	}
+	tracer := c.tracerProvider.Tracer(instrumentationName)
+	instruments := newInstruments(c.meterProvider.Meter(instrumentationName))
+
+	return func(ctx context.Context, call *monday.Call, next monday.Handler) (*http.Response, error) {
+		ops := newOperations(call.Payload)
+		ctx, span := tracer.Start(ctx, "monday "+ops.typ,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				OperationNamesKey.StringSlice(ops.names),
+				OperationTypeKey.String(ops.typ),
+				AliasedOperationsKey.Int(ops.aliased),
+			),
+		)
+		defer span.End()
+		r := &recorder{span: span, instruments: instruments, attributes: []attribute.KeyValue{OperationTypeKey.String(ops.typ)}}
+		ctx = context.WithValue(ctx, recorderKey{}, r)
+
+		start := time.Now()
+		resp, err := next(ctx, call)
+		if err == nil {
+			err = r.response(ctx, resp, ops.complexity)
+		}
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.SetAttributes(RetriesKey.Int(r.retries))
+		instruments.duration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(r.attributes...))
+		if err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+}
+
+// operations are the names of the operations of a payload, without their arguments.
+type operations struct {
+	names   []string
+	typ     string
+	aliased int
+	// complexity is the key of the complexity field in the data of the response, if it is queried.
+	complexity string
+}
+
+func newOperations(payload monday.Payload) operations {
+	var ops operations
+	var types []string
+	if queries := payload.Queries(); len(queries) != 0 {
+		types = append(types, "query")
+		for _, q := range queries {
+			ops.names = append(ops.names, q.Name())
+			if q.Alias() != "" {
+				ops.aliased++
+			}
+			if q.Name() == "complexity" {
+				ops.complexity = q.Name()
+				if q.Alias() != "" {
+					ops.complexity = q.Alias()
+				}
+			}
+		}
+	}
+	if mutations := payload.Mutations(); len(mutations) != 0 {
+		types = append(types, "mutation")
+		for _, m := range mutations {
+			ops.names = append(ops.names, m.Name())
+			if m.Alias() != "" {
+				ops.aliased++
+			}
+		}
+	}
+	ops.typ = strings.Join(types, ",")
+	if ops.typ == "" {
+		ops.typ = rawOperations
+	}
+	return ops
+}
+
+type recorderKey struct{}
+
+// recorder records the results of a call on its span and metrics.
+type recorder struct {
+	span        trace.Span
+	instruments instruments
+	// attributes are the attributes of the metrics, with a low cardinality.
+	attributes []attribute.KeyValue
+	retries    int
+}
+
+// response records the status, the error code and the complexity of the response.
+// The body is read and replaced, so it can still be decoded by the caller.
+func (r *recorder) response(ctx context.Context, resp *http.Response, complexity string) error {
+	status := HTTPStatusCodeKey.Int(resp.StatusCode)
+	r.span.SetAttributes(status)
+	r.attributes = append(r.attributes, status)
+	if resp.StatusCode >= http.StatusBadRequest {
+		r.span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+	}
+	if resp.Body == nil {
+		return nil
+	}
+	raw, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(raw))
+
+	var body struct {
+		Data   map[string]json.RawMessage `json:"data"`
+		Errors []struct {
+			Message    string `json:"message"`
+			Extensions struct {
+				Code string `json:"code"`
+			} `json:"extensions"`
+		} `json:"errors"`
+		ErrorCode    string `json:"error_code"`
+		ErrorMessage string `json:"error_message"`
+	}
+	if err := json.Unmarshal(raw, &body); err != nil {
+		// the caller reports the invalid response.
+		return nil
+	}
+	code := body.ErrorCode
+	for _, e := range body.Errors {
+		if code == "" {
+			code = e.Extensions.Code
+		}
+	}
+	if len(body.Errors) != 0 || body.ErrorMessage != "" {
+		if code == "" {
+			code = "GraphQLError"
+		}
+		r.span.SetStatus(codes.Error, code)
+	}
+	if code != "" {
+		r.span.SetAttributes(ErrorCodeKey.String(code))
+		r.attributes = append(r.attributes, ErrorCodeKey.String(code))
+	}
+
+	if raw, ok := body.Data[complexity]; ok && complexity != "" {
+		var c struct {
+			Query  *int `json:"query"`
+			Before *int `json:"before"`
+			After  *int `json:"after"`
+		}
+		if err := json.Unmarshal(raw, &c); err == nil {
+			for key, value := range map[attribute.Key]*int{
+				ComplexityQueryKey:  c.Query,
+				ComplexityBeforeKey: c.Before,
+				ComplexityAfterKey:  c.After,
+			} {
+				if value != nil {
+					r.span.SetAttributes(key.Int(*value))
+				}
+			}
+			if c.Query != nil {
+				r.instruments.complexity.Add(ctx, int64(*c.Query), metric.WithAttributes(r.attributes[:1]...))
+			}
+		}
+	}
+	return nil
+}
+
+// RecordRetry records that the call of the context is retried, e.g. by an interceptor that retries failed calls.
+// It has no effect if the context is not of a call that is instrumented.
+func RecordRetry(ctx context.Context) {
+	r, ok := ctx.Value(recorderKey{}).(*recorder)
+	if !ok {
+		return
+	}
+	r.retries++
+	r.span.AddEvent("retry", trace.WithAttributes(attribute.Key("monday.retry").Int(r.retries)))
+	r.instruments.retries.Add(ctx, 1, metric.WithAttributes(r.attributes[:1]...))
+}
+
+// RecordRateLimitWait records that the call of the context waited for the rate limit of the api.
+// It has no effect if the context is not of a call that is instrumented.
+func RecordRateLimitWait(ctx context.Context, wait time.Duration) {
+	r, ok := ctx.Value(recorderKey{}).(*recorder)
+	if !ok {
+		return
+	}
+	r.span.AddEvent("rate limit wait", trace.WithAttributes(attribute.Key("monday.rate_limit.wait").Float64(wait.Seconds())))
+	r.instruments.rateLimitWait.Record(ctx, wait.Seconds(), metric.WithAttributes(r.attributes[:1]...))
+}
@@ -0,0 +1,135 @@
+package otelmonday
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/di-wu/monday"
+	"github.com/di-wu/monday/mondaytest"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestInterceptor(t *testing.T) {
+	s := mondaytest.NewServer()
+	defer s.Close()
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	c := s.NewClient()
+	c.Use(
+		Interceptor(
+			WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))),
+			WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+		),
+		// an interceptor that retries once and waits for the rate limit.
+		func(ctx context.Context, call *monday.Call, next monday.Handler) (*http.Response, error) {
+			RecordRateLimitWait(ctx, 2*time.Second)
+			resp, err := next(ctx, call)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body.Close()
+			RecordRetry(ctx)
+			return next(ctx, call)
+		},
+	)
+	ctx := context.Background()
+
+	var created struct {
+		Board struct {
+			ID string
+		} `json:"create_board"`
+	}
+	secret := "secret board name"
+	if err := c.Do(ctx, monday.NewMutationPayload(
+		monday.Boards.Create(secret, monday.BoardsKindPublic(), nil),
+	), &created); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Do(ctx, monday.NewQueryPayload(
+		monday.Boards.List([]monday.BoardsField{monday.BoardsNameField()}).WithAlias("first"),
+		monday.Complexity.List(nil),
+	), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Do(ctx, monday.NewRawPayload(`{unknown{id}}`), nil); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	ended := spans.Ended()
+	if len(ended) != 3 {
+		t.Fatalf("got %d spans, expected 3", len(ended))
+	}
+	attributes := func(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+		m := make(map[attribute.Key]attribute.Value)
+		for _, kv := range span.Attributes() {
+			m[kv.Key] = kv.Value
+		}
+		return m
+	}
+
+	mutation := attributes(ended[0])
+	if ended[0].Name() != "monday mutation" || ended[0].SpanKind().String() != "client" {
+		t.Errorf("unexpected span %s", ended[0].Name())
+	}
+	if names := mutation[OperationNamesKey].AsStringSlice(); len(names) != 1 || names[0] != "create_board" {
+		t.Errorf("unexpected operation names %v", names)
+	}
+	if mutation[HTTPStatusCodeKey].AsInt64() != http.StatusOK || mutation[RetriesKey].AsInt64() != 1 {
+		t.Errorf("unexpected attributes %v", mutation)
+	}
+	if events := ended[0].Events(); len(events) != 2 || events[0].Name != "rate limit wait" || events[1].Name != "retry" {
+		t.Errorf("unexpected events %v", events)
+	}
+
+	query := attributes(ended[1])
+	if query[AliasedOperationsKey].AsInt64() != 1 {
+		t.Errorf("unexpected aliased operations %v", query[AliasedOperationsKey])
+	}
+	if _, ok := query[ComplexityQueryKey]; !ok {
+		t.Errorf("missing complexity in %v", query)
+	}
+	if _, ok := query[ComplexityAfterKey]; !ok {
+		t.Errorf("missing complexity in %v", query)
+	}
+
+	if ended[2].Name() != "monday raw" || ended[2].Status().Code != codes.Error || attributes(ended[2])[ErrorCodeKey].AsString() == "" {
+		t.Errorf("unexpected status %v", ended[2].Status())
+	}
+
+	for _, span := range ended {
+		for _, kv := range span.Attributes() {
+			if value := kv.Value.Emit(); strings.Contains(value, "mondaytest") || strings.Contains(value, secret) {
+				t.Errorf("span %s records %s: %s", span.Name(), kv.Key, value)
+			}
+		}
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(ctx, &rm); err != nil {
+		t.Fatal(err)
+	}
+	recorded := make(map[string]bool)
+	for _, scope := range rm.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			recorded[m.Name] = true
+		}
+	}
+	for _, name := range []string{
+		"monday.client.duration",
+		"monday.client.complexity",
+		"monday.client.retries",
+		"monday.client.rate_limit.wait",
+	} {
+		if !recorded[name] {
+			t.Errorf("metric %s is not recorded", name)
+		}
+	}
+}
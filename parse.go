@@ -0,0 +1,85 @@
+package monday
+
+import (
+	"fmt"
+
+	"github.com/di-wu/monday/internal/graphql"
+)
+
+// ParseDocument parses a GraphQL document, e.g. written in GraphiQL, into a payload of queries and mutations.
+// The result can be composed with queries and mutations of the services, see Payload.Queries and Payload.Mutations.
+//
+// The variables of the operations are replaced by the given values, or by their default value if no value is given.
+// Fragments are inlined into the selections that spread them, and the @include and @skip directives are applied.
+// All operations of the document are added to the payload.
+func ParseDocument(document string, variables map[string]interface{}) (Payload, error) {
+	parsed, err := graphql.Parse(document)
+	if err != nil {
+		return Payload{}, fmt.Errorf("parse document: %v", err)
+	}
+	operations, err := parsed.Resolve(variables)
+	if err != nil {
+		return Payload{}, fmt.Errorf("parse document: %v", err)
+	}
+
+	var payload Payload
+	for _, operation := range operations {
+		for _, s := range operation.Selections {
+			query := parsedQuery(s)
+			if operation.Mutation {
+				payload.mutations = append(payload.mutations, Mutation{
+					alias:  query.alias,
+					name:   query.name,
+					fields: query.fields,
+					args:   query.args,
+					scalar: query.scalar,
+				})
+				continue
+			}
+			payload.queries = append(payload.queries, query)
+		}
+	}
+	return payload, nil
+}
+
+// Queries returns the queries of the payload.
+func (p Payload) Queries() []Query {
+	return append([]Query(nil), p.queries...)
+}
+
+// Mutations returns the mutations of the payload.
+func (p Payload) Mutations() []Mutation {
+	return append([]Mutation(nil), p.mutations...)
+}
+
+// parsedQuery converts a resolved selection into a query.
+func parsedQuery(s graphql.Selection) Query {
+	query := Query{alias: s.Alias, name: s.Name}
+	for _, a := range s.Args {
+		query.args = append(query.args, argument{a.Name, a.Value})
+	}
+	if len(s.Selections) == 0 {
+		query.scalar = true
+		return query
+	}
+	query.fields = parsedFields(s.Selections)
+	return query
+}
+
+// parsedFields converts resolved selections into fields, aliased fields keep their alias apart from their name.
+func parsedFields(selections []graphql.Selection) []field {
+	var fields []field
+	for _, sub := range selections {
+		if sub.Inline {
+			fields = append(fields, inlineFragment(sub.On, parsedFields(sub.Selections)))
+			continue
+		}
+		q := parsedQuery(sub)
+		if q.scalar && len(q.args) == 0 && q.alias == "" {
+			fields = append(fields, field{q.name, nil})
+			continue
+		}
+		fields = append(fields, field{q.name, &q})
+	}
+	return fields
+}
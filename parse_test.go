@@ -0,0 +1,167 @@
+package monday
+
+import (
+	"strings"
+	"testing"
+)
+
+func stringifyPayload(p Payload) string {
+	var parts []string
+	for _, q := range p.queries {
+		parts = append(parts, q.stringify())
+	}
+	for _, m := range p.mutations {
+		parts = append(parts, "mutation "+m.stringify())
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestParseDocument(t *testing.T) {
+	for _, test := range []struct {
+		document  string
+		variables map[string]interface{}
+		str       string
+	}{
+		{
+			document: `{ boards(ids: [1, 2], limit: 5) { id name } }`,
+			str:      `boards(ids:[1,2],limit:5){id name}`,
+		},
+		{
+			document: `query Boards($ids: [Int], $kind: BoardKind = public) {
+				first: boards(ids: $ids, board_kind: $kind) { id title: name }
+				second: boards(state: active) { items(limit: 1) { id } }
+			}`,
+			variables: map[string]interface{}{"ids": []int{1}},
+			str:       `first:boards(ids:[1],board_kind:public){id title:name} second:boards(state:active){items(limit:1){id}}`,
+		},
+		{
+			document: `query ($limit: Int) { boards(limit: $limit) { id } }`,
+			str:      `boards{id}`,
+		},
+		{
+			document: `
+				# fragments can be defined after their use.
+				query { items(ids: 1) { ...Item ... on Item { state } } }
+				fragment Item on Item { id name column_values(ids: ["status"]) { ...Value } }
+				fragment Value on ColumnValue { id text }`,
+			str: `items(ids:1){id name column_values(ids:["status"]){id text} ... on Item{state}}`,
+		},
+		{
+			document: `query ($verbose: Boolean!) {
+				boards { id name @include(if: $verbose) description @skip(if: true) }
+			}`,
+			variables: map[string]interface{}{"verbose": false},
+			str:       `boards{id}`,
+		},
+		{
+			document: `mutation {
+				create_update(item_id: 1, body: """
+					Hello "world"
+					  indented
+				""") { id }
+				change_multiple_column_values(board_id: 1, item_id: 2, column_values: "{\"text\":\"é\"}")
+			}`,
+			str: `mutation create_update(item_id:1,body:"Hello \"world\"\n  indented"){id} mutation change_multiple_column_values(board_id:1,item_id:2,column_values:"{\"text\":\"é\"}")`,
+		},
+		{
+			document: `{ complexity { before after } } mutation { archive_board(board_id: -1) { id } }`,
+			str:      `complexity{before after} mutation archive_board(board_id:-1){id}`,
+		},
+		{
+			document: `{ items_by_column_values(board_id: 1, column_id: "status", column_value: "Done", state: all) { id } }`,
+			str:      `items_by_column_values(board_id:1,column_id:"status",column_value:"Done",state:all){id}`,
+		},
+	} {
+		payload, err := ParseDocument(test.document, test.variables)
+		if err != nil {
+			t.Errorf("%s: %v", test.document, err)
+			continue
+		}
+		if str := stringifyPayload(payload); str != test.str {
+			t.Errorf("got: %s, expected: %s", str, test.str)
+		}
+	}
+}
+
+func TestParseDocumentCompose(t *testing.T) {
+	parsed, err := ParseDocument(`{ me { id } }`, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload := NewQueryPayload(append(parsed.Queries(), Boards.List(
+		[]BoardsField{BoardsIDField()},
+		NewBoardsLimitArgument(1),
+	))...)
+	if str := stringifyPayload(payload); str != `me{id} boards(limit:1){id}` {
+		t.Errorf("unexpected payload: %s", str)
+	}
+	if err := payload.Validate(loadSchema(t)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestParseDocumentValidate(t *testing.T) {
+	schema := loadSchema(t)
+	payload, err := ParseDocument(`{ boards(board_kind: public, state: active) { id } }`, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := payload.Validate(schema); err != nil {
+		t.Error(err)
+	}
+	payload, err = ParseDocument(`{ boards(board_kind: secret) { id } }`, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := payload.Validate(schema); err == nil {
+		t.Error("expected an error for an unknown enum value")
+	}
+}
+
+func TestParseDocumentValidateSelections(t *testing.T) {
+	schema := loadSchema(t)
+	payload, err := ParseDocument(`{ boards(ids: [1]) { title: name id ... on Board { kind: board_kind } } }`, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if str := stringifyPayload(payload); str != `boards(ids:[1]){title:name id ... on Board{kind:board_kind}}` {
+		t.Errorf("unexpected payload: %s", str)
+	}
+	if err := payload.Validate(schema); err != nil {
+		t.Error(err)
+	}
+	payload, err = ParseDocument(`{ boards { ... on Item { id } } }`, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := payload.Validate(schema); err == nil || !strings.Contains(err.Error(), "inline fragment on Item") {
+		t.Errorf("expected an error for the type condition, got: %v", err)
+	}
+}
+
+func TestParseDocumentErrors(t *testing.T) {
+	for _, test := range []struct {
+		document  string
+		variables map[string]interface{}
+		err       string
+	}{
+		{document: ``, err: "no operations"},
+		{document: `{ boards { id }`, err: "unterminated selection set"},
+		{document: `{ boards {} }`, err: "empty selection set"},
+		{document: `subscription { boards { id } }`, err: "not supported"},
+		{document: "{ boards(name: \"a\nb\") { id } }", err: "line 1, column 18: unterminated string"},
+		{document: `{ boards(name: "\x") { id } }`, err: "invalid escape"},
+		{document: `{ boards(limit: 01) { id } }`, err: "invalid number"},
+		{document: `query ($id: Int!) { boards(ids: $id) { id } }`, err: "missing value for variable $id"},
+		{document: `{ boards(ids: $id) { id } }`, err: "undefined variable $id"},
+		{document: `{ boards { ...Unknown } }`, err: `unknown fragment "Unknown"`},
+		{document: `{ boards { ...A } } fragment A on Board { ...A }`, err: `fragment "A" spreads itself`},
+		{document: `{ boards { id @deprecated } }`, err: "unsupported directive @deprecated"},
+		{document: `query ($a: Int = $b) { boards { id } }`, err: "unexpected variable"},
+	} {
+		_, err := ParseDocument(test.document, test.variables)
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: got: %v, expected: %s", test.document, err, test.err)
+		}
+	}
+}
@@ -1,8 +1,11 @@
 package pdq
 
 import (
+	"context"
 	"fmt"
 	"testing"
+
+	"github.com/di-wu/monday"
 )
 
 // WARNING: this board will get modified/cleared of all its data!
@@ -31,3 +34,28 @@ func TestBoards(t *testing.T) {
 		t.Errorf("got %v, expected %v", get, board)
 	}
 }
+
+func TestBoardsParsedDocument(t *testing.T) {
+	board, _, err := c.EnsureBoard(testBoardName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, err := monday.ParseDocument(`query ($id: Int!) {
+		board: boards(ids: [$id]) { ...Board }
+	}
+	fragment Board on Board { id title: name }`, map[string]interface{}{"id": board.ID()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resp struct {
+		Board []struct {
+			Title string `json:"title"`
+		} `json:"board"`
+	}
+	if err := c.Do(context.Background(), payload, &resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Board) != 1 || resp.Board[0].Title != testBoardName {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+}
@@ -1,6 +1,9 @@
 package pdq
 
 import (
+	"context"
+	"net/http"
+
 	"github.com/di-wu/monday"
 )
 
@@ -11,3 +14,14 @@ type SimpleClient struct {
 func NewSimpleClient(secret string) *SimpleClient {
 	return &SimpleClient{*monday.NewClient(secret, nil)}
 }
+
+// NewSimpleClientWithHTTPClient returns a client that uses the given http client to reach the api.
+func NewSimpleClientWithHTTPClient(secret string, client *http.Client) *SimpleClient {
+	return &SimpleClient{*monday.NewClient(secret, client)}
+}
+
+// Do executes the payload and decodes the data of the response into v.
+// Errors reported by the api are returned as an error.
+func (c SimpleClient) Do(ctx context.Context, payload monday.Payload, v interface{}) error {
+	return c.Client.Do(ctx, payload, v)
+}
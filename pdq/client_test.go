@@ -4,6 +4,8 @@ import (
 	"log"
 	"os"
 	"testing"
+
+	"github.com/di-wu/monday/mondaytest"
 )
 
 var c *SimpleClient
@@ -11,9 +13,47 @@ var c *SimpleClient
 func TestMain(m *testing.M) {
 	mondayAPIToken, ok := os.LookupEnv("MONDAY_API_TOKEN")
 	if !ok {
-		log.Println("could not get monday api token from env")
-		return
+		log.Println("could not get monday api token from env, using the fake monday api")
+		server := mondaytest.NewServer()
+		c = NewSimpleClientWithHTTPClient("mondaytest", server.Client())
+		code := m.Run()
+		server.Close()
+		os.Exit(code)
 	}
 	c = NewSimpleClient(mondayAPIToken)
 	m.Run()
 }
+
+func TestDryRun(t *testing.T) {
+	server := mondaytest.NewServer()
+	defer server.Close()
+	c := NewSimpleClientWithHTTPClient("mondaytest", server.Client())
+	board, err := c.CreateBoard("dry run")
+	if err != nil {
+		t.Fatal(err)
+	}
+	groups, err := c.GetGroups(board.ID())
+	if err != nil || len(groups) == 0 {
+		t.Fatalf("unexpected groups %v: %v", groups, err)
+	}
+
+	dry := NewSimpleClientWithHTTPClient("mondaytest", server.Client())
+	plan := dry.DryRun()
+	item, err := dry.CreateItem(board.ID(), groups[0].Id, "item")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item.ID() != -1 {
+		t.Errorf("unexpected item %+v", item)
+	}
+	items, err := dry.GetItems(board.ID(), groups[0].Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 0 {
+		t.Errorf("the item is created: %v", items)
+	}
+	if p := plan.Plan(); len(p) != 1 || p[0].Name != "create_item" || p[0].BoardID != board.ID() {
+		t.Errorf("unexpected plan %v", p)
+	}
+}
@@ -2,6 +2,10 @@ package pdq
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	. "github.com/di-wu/monday"
 )
@@ -14,3 +18,148 @@ func (c SimpleClient) EnsureColumnValue(boardID int, itemID int, value ColumnVal
 	))
 	return err
 }
+
+// ParseColumnValue converts the textual representation of a value into a column value of the column's type.
+// The accepted formats match the text the Monday API returns for the column, an empty value removes the value.
+//   - dropdown, people and tags: a comma separated list of labels, user ids or tag ids.
+//   - date: YYYY-MM-DD, optionally followed by HH:MM:SS.
+//   - timeline and week: YYYY-MM-DD - YYYY-MM-DD.
+//   - hour: HH:MM.
+//   - link: url or text - url.
+//   - phone and country: the number or iso-2 code, followed by the iso-2 code or country name.
+func ParseColumnValue(column Column, value string) (ColumnValue, error) {
+	id := column.Id
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return RemoveValue(id), nil
+	}
+	invalid := func(format string) (ColumnValue, error) {
+		return ColumnValue{}, fmt.Errorf("invalid value %q for %s column %s, expected %s", value, column.Type, column.Title, format)
+	}
+	switch column.Type {
+	case "name":
+		return NewItemNameValue(id, value), nil
+	case "text":
+		return NewTextValue(id, value), nil
+	case "long_text":
+		return NewLongTextValue(id, value), nil
+	case "numbers":
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return invalid("a whole number")
+		}
+		return NewNumberValue(id, n), nil
+	case "status":
+		return NewStatusLabelValue(id, value), nil
+	case "dropdown":
+		return NewDropdownLabelValue(id, splitList(value)), nil
+	case "date":
+		for _, layout := range []string{"2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02"} {
+			if t, err := time.Parse(layout, value); err == nil {
+				return NewDateValue(id, t), nil
+			}
+		}
+		return invalid("YYYY-MM-DD [HH:MM:SS]")
+	case "timeline", "week":
+		parts := strings.Split(value, " - ")
+		if len(parts) != 2 {
+			return invalid("YYYY-MM-DD - YYYY-MM-DD")
+		}
+		from, err := time.Parse("2006-01-02", strings.TrimSpace(parts[0]))
+		if err != nil {
+			return invalid("YYYY-MM-DD - YYYY-MM-DD")
+		}
+		to, err := time.Parse("2006-01-02", strings.TrimSpace(parts[1]))
+		if err != nil {
+			return invalid("YYYY-MM-DD - YYYY-MM-DD")
+		}
+		if column.Type == "week" {
+			return NewWeekValue(id, from, to), nil
+		}
+		return NewTimelineValue(id, from, to), nil
+	case "hour":
+		t, err := time.Parse("15:04", value)
+		if err != nil {
+			return invalid("HH:MM")
+		}
+		return NewHourValue(id, t), nil
+	case "email":
+		return NewEmailValue(id, value, value), nil
+	case "link":
+		if i := strings.LastIndex(value, " - "); i >= 0 {
+			return NewURLValue(id, value[i+3:], value[:i]), nil
+		}
+		return NewURLValue(id, value, value), nil
+	case "phone":
+		fields := strings.Fields(value)
+		number, err := strconv.Atoi(strings.TrimPrefix(fields[0], "+"))
+		if err != nil || len(fields) > 2 {
+			return invalid("the number followed by the iso-2 country code")
+		}
+		var code string
+		if len(fields) == 2 {
+			code = fields[1]
+		}
+		return NewPhoneValue(id, number, code), nil
+	case "country":
+		fields := strings.SplitN(value, " ", 2)
+		if len(fields) != 2 || len(fields[0]) != 2 {
+			return invalid("the iso-2 country code followed by the country name")
+		}
+		return NewCountryValue(id, fields[0], fields[1]), nil
+	case "checkbox":
+		if value == "v" {
+			return NewCheckboxValue(id, true), nil
+		}
+		checked, err := strconv.ParseBool(value)
+		if err != nil {
+			return invalid("a boolean")
+		}
+		if !checked {
+			return RemoveValue(id), nil
+		}
+		return NewCheckboxValue(id, true), nil
+	case "rating":
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return invalid("a whole number")
+		}
+		return NewRatingValue(id, n), nil
+	case "world_clock":
+		return NewWorldClockValue(id, value), nil
+	case "team":
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return invalid("a team id")
+		}
+		return NewTeamValue(id, n), nil
+	case "people", "tags":
+		var ids []int
+		for _, v := range splitList(value) {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return invalid("a comma separated list of ids")
+			}
+			ids = append(ids, n)
+		}
+		if column.Type == "tags" {
+			return NewTagsValue(id, ids), nil
+		}
+		var people []People
+		for _, n := range ids {
+			people = append(people, People{ID: n, Kind: PeopleKindPerson()})
+		}
+		return NewPeopleValue(id, people), nil
+	}
+	return ColumnValue{}, fmt.Errorf("setting values of %s column %s is not supported", column.Type, column.Title)
+}
+
+func splitList(value string) []string {
+	var values []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
@@ -10,22 +10,48 @@ func TestColumnValue(t *testing.T) {
 	board, _, _ := c.EnsureBoard(testBoardName)
 	group, _, _ := c.EnsureGroup(board.ID(), testGroupName)
 	item, _, _ := c.EnsureItem(board.ID(), group.Id, testItemName)
-	columns, _ := c.GetColumns(board.ID())
-
-	var columnID string
-	for _, c := range columns {
-		if c.Title == testColumnTitle {
-			columnID = c.Id
-			break
-		}
-	}
+	column, _, _ := c.EnsureColumn(board.ID(), testColumnTitle, ColumnsTypeStatus())
+	columnID := column.Id
 
 	if err := c.EnsureColumnValue(board.ID(), item.ID(), NewStatusLabelValue(columnID, "Stuck")); err != nil {
 		t.Error(err)
 	}
 
-	_, err := c.GetItemColumnValues(item.ID())
+	values, err := c.GetItemColumnValues(item.ID())
 	if err != nil {
-		t.Error()
+		t.Error(err)
+	}
+	if len(values) < 1 {
+		t.Error("no column values found")
+	}
+}
+
+func TestParseColumnValue(t *testing.T) {
+	for _, test := range []struct {
+		typ, value, str string
+	}{
+		{"text", "Sample text", `"Sample text"`},
+		{"numbers", "3", `"3"`},
+		{"status", "Done", `{"label":"Done"}`},
+		{"dropdown", "a, b", `{"labels":["a","b"]}`},
+		{"date", "2019-06-03 13:25:00", `{"date":"2019-06-03","time":"13:25:00"}`},
+		{"timeline", "2019-06-03 - 2019-06-07", `{"from":"2019-06-03","to":"2019-06-07"}`},
+		{"link", "go to monday! - http://monday.com", `{"url":"http://monday.com","text":"go to monday!"}`},
+		{"checkbox", "v", `{"checked":"true"}`},
+		{"people", "1, 2", `{"personsAndTeams":[{"id":1,"kind":"person"},{"id":2,"kind":"person"}]}`},
+		{"text", "", `{}`},
+	} {
+		value, err := ParseColumnValue(Column{Id: "id", Type: test.typ}, test.value)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if value.Value() != test.str {
+			t.Errorf("got: %s, expected: %s", value.Value(), test.str)
+		}
+	}
+
+	if _, err := ParseColumnValue(Column{Id: "id", Type: "numbers"}, "three"); err == nil {
+		t.Error("expected an error for an invalid number")
 	}
 }
@@ -181,3 +181,22 @@ func (c SimpleClient) GetColumns(boardID int) ([]Column, error) {
 	}
 	return body.Data.Boards[0].Columns, nil
 }
+
+var columnsTypes = []func() ColumnsType{
+	ColumnsTypeAutoNumber, ColumnsTypeCheckBox, ColumnsTypeCountry, ColumnsTypeColorPicker, ColumnsTypeCreationLog,
+	ColumnsTypeDate, ColumnsTypeDropdown, ColumnsTypeEmail, ColumnsTypeHour, ColumnsTypeItemID,
+	ColumnsTypeLastUpdated, ColumnsTypeLink, ColumnsTypeLocation, ColumnsTypeLongText, ColumnsTypeNumbers,
+	ColumnsTypePeople, ColumnsTypePhone, ColumnsTypeProgress, ColumnsTypeRating, ColumnsTypeStatus,
+	ColumnsTypeTeam, ColumnsTypeTags, ColumnsTypeText, ColumnsTypeTimeline, ColumnsTypeTimeTracking,
+	ColumnsTypeVote, ColumnsTypeWeek, ColumnsTypeWorldClock,
+}
+
+// columnsType returns the column type with the given name, as returned by the type field of a column.
+func columnsType(name string) (ColumnsType, bool) {
+	for _, typ := range columnsTypes {
+		if typ().String() == name {
+			return typ(), true
+		}
+	}
+	return ColumnsType{}, false
+}
@@ -0,0 +1,630 @@
+package pdq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	. "github.com/di-wu/monday"
+)
+
+// BoardSpec describes the desired structure of a board.
+type BoardSpec struct {
+	Name        string
+	Kind        BoardsKind // Defaults to public, can not be changed after the board is created.
+	Description string
+	// Groups in the order in which they should appear on the board.
+	Groups  []GroupSpec
+	Columns []ColumnSpec
+	// Prune deletes the groups and columns that are not part of the spec.
+	// Note that deleting a group also deletes all of its items.
+	Prune bool
+}
+
+// GroupSpec describes a group of a board.
+type GroupSpec struct {
+	// ID is optional, if set the group is matched by its identifier instead of its title, so it can be renamed.
+	ID    string
+	Title string
+	// Color of the group (e.g. #579bfc), left untouched if empty.
+	Color string
+}
+
+// ColumnSpec describes a column of a board.
+type ColumnSpec struct {
+	// ID is optional, if set the column is matched by its identifier instead of its title, so it can be renamed.
+	ID          string
+	Title       string
+	Type        ColumnsType
+	Description string
+	// Labels of a status or dropdown column, left untouched if nil.
+	Labels []string
+}
+
+// Action is the kind of change made to a board.
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionMove   Action = "move"
+	ActionDelete Action = "delete"
+)
+
+var actionSymbols = map[Action]string{
+	ActionCreate: "+",
+	ActionUpdate: "~",
+	ActionMove:   ">",
+	ActionDelete: "-",
+}
+
+// Change is a single change of a plan.
+type Change struct {
+	Action Action
+	// Resource is either "board", "group" or "column".
+	Resource string
+	// ID is the identifier of the resource, empty if the resource still needs to be created.
+	ID    string
+	Title string
+	// Attribute is the updated attribute (e.g. title, color, description, labels) or the
+	// relative position of a moved group (relative_position_after or relative_position_before).
+	Attribute string
+	Old, New  string
+
+	column ColumnSpec
+}
+
+func (c Change) String() string {
+	name := c.Resource
+	if c.ID != "" {
+		name += " " + c.ID
+	}
+	if c.Title != "" {
+		name += fmt.Sprintf(" %q", c.Title)
+	}
+	switch c.Action {
+	case ActionCreate:
+		if c.Resource == "column" {
+			name += fmt.Sprintf(" (%s)", c.column.Type)
+		}
+	case ActionUpdate:
+		name += fmt.Sprintf(" %s: %q -> %q", c.Attribute, c.Old, c.New)
+	case ActionMove:
+		name += fmt.Sprintf(" %s %q", strings.TrimPrefix(c.Attribute, "relative_position_"), c.New)
+	}
+	return fmt.Sprintf("%s %s", actionSymbols[c.Action], name)
+}
+
+// BoardPlan contains the changes needed to make a board match its spec.
+type BoardPlan struct {
+	// BoardID is the identifier of the board, zero if the board still needs to be created.
+	BoardID int
+	Spec    BoardSpec
+	Changes []Change
+
+	// groups maps the titles of the existing groups of the spec to their identifier.
+	groups map[string]string
+}
+
+// Empty reports whether the board already matches its spec.
+func (p BoardPlan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+func (p BoardPlan) String() string {
+	if p.Empty() {
+		return "No changes, the board matches its spec.\n"
+	}
+	var b strings.Builder
+	for _, c := range p.Changes {
+		b.WriteString(c.String())
+		b.WriteByte('\n')
+	}
+	var create, update, move, remove int
+	for _, c := range p.Changes {
+		switch c.Action {
+		case ActionCreate:
+			create++
+		case ActionUpdate:
+			update++
+		case ActionMove:
+			move++
+		case ActionDelete:
+			remove++
+		}
+	}
+	fmt.Fprintf(&b, "Plan: %d to create, %d to update, %d to move, %d to delete.\n", create, update, move, remove)
+	return b.String()
+}
+
+type liveBoard struct {
+	Id, Name, Description string
+	Kind                  string `json:"board_kind"`
+	Groups                []struct {
+		Id, Title, Color string
+	}
+	Columns []struct {
+		Column
+		Description string
+	}
+}
+
+// Plan computes the changes needed to make the board with given identifier match the spec.
+// If the identifier is zero, the plan creates a new board.
+func (c SimpleClient) Plan(ctx context.Context, boardID int, spec BoardSpec) (BoardPlan, error) {
+	plan := BoardPlan{BoardID: boardID, Spec: spec, groups: make(map[string]string)}
+	var live liveBoard
+	if boardID == 0 {
+		plan.Changes = append(plan.Changes, Change{Action: ActionCreate, Resource: "board", Title: spec.Name})
+		if spec.Description != "" {
+			plan.Changes = append(plan.Changes, updateChange("board", "", spec.Name, "description", "", spec.Description))
+		}
+	} else {
+		var data struct {
+			Boards []liveBoard
+		}
+		if err := c.Do(ctx, NewQueryPayload(
+			Boards.List(
+				[]BoardsField{
+					BoardsIDField(),
+					BoardsNameField(),
+					BoardsDescriptionField(),
+					BoardsKindField(),
+					NewBoardsGroupsFields([]GroupsField{
+						GroupsIDField(),
+						GroupsTitleField(),
+						GroupsColorField(),
+					}, nil),
+					NewBoardsColumnField([]ColumnsField{
+						ColumnsIDField(),
+						ColumnsTitleField(),
+						ColumnsTypeField(),
+						ColumnsDescriptionField(),
+						ColumnsSettingsStrField(),
+					}),
+				},
+				NewBoardsIDsArgument([]int{boardID}),
+			),
+		), &data); err != nil {
+			return BoardPlan{}, err
+		}
+		if len(data.Boards) != 1 {
+			return BoardPlan{}, fmt.Errorf("no boards returned for id %d", boardID)
+		}
+		live = data.Boards[0]
+		if spec.Kind != (BoardsKind{}) && spec.Kind.String() != live.Kind {
+			return BoardPlan{}, fmt.Errorf("board kind can not be changed from %s to %s", live.Kind, spec.Kind)
+		}
+		if spec.Name != live.Name {
+			plan.Changes = append(plan.Changes, updateChange("board", live.Id, live.Name, "name", live.Name, spec.Name))
+		}
+		if spec.Description != live.Description {
+			plan.Changes = append(plan.Changes, updateChange("board", live.Id, spec.Name, "description", live.Description, spec.Description))
+		}
+	}
+
+	// Groups.
+	var order, created []string // titles of the groups in their current order
+	matchedGroups := make(map[string]bool)
+	var deletes []Change
+	for _, g := range spec.Groups {
+		found := -1
+		for i, v := range live.Groups {
+			if !matchedGroups[v.Id] && (g.ID != "" && v.Id == g.ID || g.ID == "" && v.Title == g.Title) {
+				found = i
+				break
+			}
+		}
+		if found == -1 {
+			plan.Changes = append(plan.Changes, Change{Action: ActionCreate, Resource: "group", Title: g.Title})
+			if g.Color != "" {
+				plan.Changes = append(plan.Changes, updateChange("group", "", g.Title, "color", "", g.Color))
+			}
+			created = append(created, g.Title)
+			continue
+		}
+		v := live.Groups[found]
+		matchedGroups[v.Id] = true
+		plan.groups[g.Title] = v.Id
+		if v.Title != g.Title {
+			plan.Changes = append(plan.Changes, updateChange("group", v.Id, g.Title, "title", v.Title, g.Title))
+		}
+		if g.Color != "" && v.Color != g.Color {
+			plan.Changes = append(plan.Changes, updateChange("group", v.Id, g.Title, "color", v.Color, g.Color))
+		}
+	}
+	titles := make(map[string]string)
+	for title, id := range plan.groups {
+		titles[id] = title
+	}
+	for _, v := range live.Groups {
+		if matchedGroups[v.Id] {
+			order = append(order, titles[v.Id])
+			continue
+		}
+		if spec.Prune {
+			deletes = append(deletes, Change{Action: ActionDelete, Resource: "group", ID: v.Id, Title: v.Title})
+		}
+	}
+
+	// Columns.
+	matchedColumns := make(map[string]bool)
+	for _, col := range spec.Columns {
+		found := -1
+		for i, v := range live.Columns {
+			if !matchedColumns[v.Id] && (col.ID != "" && v.Id == col.ID || col.ID == "" && v.Title == col.Title) {
+				found = i
+				break
+			}
+		}
+		if found == -1 {
+			if col.Type == (ColumnsType{}) {
+				return BoardPlan{}, fmt.Errorf("column %q has no type", col.Title)
+			}
+			plan.Changes = append(plan.Changes, Change{Action: ActionCreate, Resource: "column", Title: col.Title, column: col})
+			continue
+		}
+		v := live.Columns[found]
+		matchedColumns[v.Id] = true
+		if col.Type != (ColumnsType{}) && col.Type.String() != v.Type {
+			return BoardPlan{}, fmt.Errorf("type of column %s can not be changed from %s to %s", v.Id, v.Type, col.Type)
+		}
+		if v.Title != col.Title {
+			plan.Changes = append(plan.Changes, updateChange("column", v.Id, col.Title, "title", v.Title, col.Title))
+		}
+		if v.Description != col.Description {
+			plan.Changes = append(plan.Changes, updateChange("column", v.Id, col.Title, "description", v.Description, col.Description))
+		}
+		if col.Labels != nil {
+			labels, err := columnLabels(v.Type, v.SettingsStr)
+			if err != nil {
+				return BoardPlan{}, err
+			}
+			if !sameLabels(labels, col.Labels) {
+				change := updateChange("column", v.Id, col.Title, "labels", strings.Join(labels, ", "), strings.Join(col.Labels, ", "))
+				change.column = col
+				change.column.Type, _ = columnsType(v.Type)
+				plan.Changes = append(plan.Changes, change)
+			}
+		}
+	}
+	for _, v := range live.Columns {
+		if !matchedColumns[v.Id] && spec.Prune && v.Type != "name" {
+			deletes = append(deletes, Change{Action: ActionDelete, Resource: "column", ID: v.Id, Title: v.Title})
+		}
+	}
+
+	plan.Changes = append(plan.Changes, moves(spec.Groups, order, created)...)
+	plan.Changes = append(plan.Changes, deletes...)
+	return plan, nil
+}
+
+func updateChange(resource, id, title, attribute, from, to string) Change {
+	return Change{
+		Action:    ActionUpdate,
+		Resource:  resource,
+		ID:        id,
+		Title:     title,
+		Attribute: attribute,
+		Old:       from,
+		New:       to,
+	}
+}
+
+// moves returns the changes that put the groups in the order of the spec.
+// The order contains the titles of the existing groups, created groups are always moved since the position
+// in which the API creates them is not guaranteed.
+func moves(groups []GroupSpec, order, created []string) []Change {
+	isNew := make(map[string]bool)
+	for _, title := range created {
+		isNew[title] = true
+	}
+	order = append(order, created...)
+	index := func(title string) int {
+		for i, v := range order {
+			if v == title {
+				return i
+			}
+		}
+		return -1
+	}
+	move := func(title, target string, after bool) {
+		var rest []string
+		for _, v := range order {
+			if v != title {
+				rest = append(rest, v)
+			}
+		}
+		order = rest
+		i := index(target)
+		if after {
+			i++
+		}
+		order = append(order[:i], append([]string{title}, order[i:]...)...)
+	}
+
+	var changes []Change
+	for i, g := range groups {
+		if i == 0 {
+			if first := order[0]; first != g.Title {
+				changes = append(changes, Change{Action: ActionMove, Resource: "group", Title: g.Title,
+					Attribute: "relative_position_before", New: first})
+				move(g.Title, first, false)
+			}
+			continue
+		}
+		prev := groups[i-1].Title
+		if isNew[g.Title] || index(g.Title) != index(prev)+1 {
+			changes = append(changes, Change{Action: ActionMove, Resource: "group", Title: g.Title,
+				Attribute: "relative_position_after", New: prev})
+			move(g.Title, prev, true)
+		}
+	}
+	return changes
+}
+
+// columnLabels returns the labels of a status or dropdown column, ordered by their index.
+func columnLabels(typ, settingsStr string) ([]string, error) {
+	labels, err := parseLabels(typ, settingsStr)
+	if err != nil {
+		return nil, err
+	}
+	var indexes []int
+	for i := range labels {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+	var names []string
+	for _, i := range indexes {
+		names = append(names, labels[i])
+	}
+	return names, nil
+}
+
+// parseLabels returns the labels of a status or dropdown column by their index (or identifier).
+func parseLabels(typ, settingsStr string) (map[int]string, error) {
+	labels := make(map[int]string)
+	if settingsStr == "" {
+		return labels, nil
+	}
+	switch typ {
+	case "status":
+		var settings struct {
+			Labels map[string]string
+		}
+		if err := json.Unmarshal([]byte(settingsStr), &settings); err != nil {
+			return nil, err
+		}
+		for k, v := range settings.Labels {
+			i, err := strconv.Atoi(k)
+			if err != nil {
+				return nil, err
+			}
+			labels[i] = v
+		}
+	case "dropdown":
+		var settings struct {
+			Labels []struct {
+				Id   int
+				Name string
+			}
+		}
+		if err := json.Unmarshal([]byte(settingsStr), &settings); err != nil {
+			return nil, err
+		}
+		for _, l := range settings.Labels {
+			labels[l.Id] = l.Name
+		}
+	default:
+		return nil, fmt.Errorf("columns of type %s have no labels", typ)
+	}
+	return labels, nil
+}
+
+func sameLabels(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	count := make(map[string]int)
+	for _, v := range a {
+		count[v]++
+	}
+	for _, v := range b {
+		count[v]--
+		if count[v] < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// labelSettings returns the settings of a status or dropdown column with the desired labels.
+// Existing labels keep their index, so the values of the column are preserved.
+func labelSettings(typ, settingsStr string, desired []string) (string, error) {
+	current, err := parseLabels(typ, settingsStr)
+	if err != nil {
+		return "", err
+	}
+	indexes := make(map[string]int)
+	next := 0
+	for i, v := range current {
+		indexes[v] = i
+		if i >= next {
+			next = i + 1
+		}
+	}
+	if typ == "dropdown" && next == 0 {
+		next = 1
+	}
+	labels := make(map[int]string)
+	for _, v := range desired {
+		i, ok := indexes[v]
+		if !ok {
+			i = next
+			next++
+		}
+		labels[i] = v
+	}
+	var raw []byte
+	switch typ {
+	case "status":
+		settings := make(map[string]string)
+		for i, v := range labels {
+			settings[strconv.Itoa(i)] = v
+		}
+		raw, err = json.Marshal(map[string]interface{}{"labels": settings})
+	default:
+		type label struct {
+			ID   int    `json:"id"`
+			Name string `json:"name"`
+		}
+		var settings []label
+		for i, v := range labels {
+			settings = append(settings, label{i, v})
+		}
+		sort.Slice(settings, func(i, j int) bool {
+			return settings[i].ID < settings[j].ID
+		})
+		raw, err = json.Marshal(map[string]interface{}{"labels": settings})
+	}
+	return string(raw), err
+}
+
+// Apply executes the changes of the plan, each change is written to w (if not nil) before it is executed.
+// If dryRun is set, the changes are only written and nothing is executed.
+// If the plan creates a new board, the remaining changes are planned again against the created board,
+// so they take the default group and columns of the new board into account.
+// Apply returns the identifier of the board.
+func (c SimpleClient) Apply(ctx context.Context, plan BoardPlan, dryRun bool, w io.Writer) (int, error) {
+	write := func(change Change) {
+		if w != nil {
+			fmt.Fprintln(w, change.String())
+		}
+	}
+	if dryRun {
+		if w != nil {
+			fmt.Fprint(w, plan.String())
+		}
+		return plan.BoardID, nil
+	}
+
+	if plan.BoardID == 0 {
+		write(plan.Changes[0])
+		kind := plan.Spec.Kind
+		if kind == (BoardsKind{}) {
+			kind = BoardsKindPublic()
+		}
+		var data struct {
+			Board Board `json:"create_board"`
+		}
+		if err := c.Do(ctx, NewMutationPayload(
+			Boards.Create(plan.Spec.Name, kind, []BoardsField{BoardsIDField()}),
+		), &data); err != nil {
+			return 0, err
+		}
+		next, err := c.Plan(ctx, data.Board.ID(), plan.Spec)
+		if err != nil {
+			return data.Board.ID(), err
+		}
+		return c.Apply(ctx, next, false, w)
+	}
+
+	groups := make(map[string]string)
+	for title, id := range plan.groups {
+		groups[title] = id
+	}
+	group := func(change Change) string {
+		if change.ID != "" {
+			return change.ID
+		}
+		return groups[change.Title]
+	}
+	for _, change := range plan.Changes {
+		write(change)
+		var m Mutation
+		switch change.Resource + " " + string(change.Action) {
+		case "board update":
+			attribute := BoardsAttributeName()
+			if change.Attribute == "description" {
+				attribute = BoardsAttributeDescription()
+			}
+			m = Boards.Update(plan.BoardID, attribute, change.New)
+		case "group create":
+			var data struct {
+				Group Group `json:"create_group"`
+			}
+			if err := c.Do(ctx, NewMutationPayload(
+				Groups.Create(plan.BoardID, change.Title, []GroupsField{GroupsIDField()}),
+			), &data); err != nil {
+				return plan.BoardID, err
+			}
+			groups[change.Title] = data.Group.Id
+			continue
+		case "group update":
+			attribute := GroupsAttributeTitle()
+			if change.Attribute == "color" {
+				attribute = GroupsAttributeColor()
+			}
+			m = Groups.Update(plan.BoardID, group(change), attribute, change.New, nil)
+		case "group move":
+			attribute := GroupsAttributeRelativePositionAfter()
+			if change.Attribute == "relative_position_before" {
+				attribute = GroupsAttributeRelativePositionBefore()
+			}
+			m = Groups.Update(plan.BoardID, group(change), attribute, groups[change.New], nil)
+		case "group delete":
+			m = Groups.Delete(plan.BoardID, change.ID, nil)
+		case "column create":
+			spec := change.column
+			var defaults string
+			if spec.Labels != nil {
+				raw, err := json.Marshal(map[string][]string{"labels": spec.Labels})
+				if err != nil {
+					return plan.BoardID, err
+				}
+				defaults = string(raw)
+			}
+			var data struct {
+				Column Column `json:"create_column"`
+			}
+			if err := c.Do(ctx, NewMutationPayload(
+				Columns.CreateWithDefaults(plan.BoardID, spec.Title, spec.Type, defaults, []ColumnsField{ColumnsIDField()}),
+			), &data); err != nil {
+				return plan.BoardID, err
+			}
+			if spec.Description == "" {
+				continue
+			}
+			m = Columns.ChangeMetadata(plan.BoardID, data.Column.Id, ColumnsPropertyDescription(), spec.Description, nil)
+		case "column update":
+			switch change.Attribute {
+			case "title":
+				m = Columns.ChangeMetadata(plan.BoardID, change.ID, ColumnsPropertyTitle(), change.New, nil)
+			case "description":
+				m = Columns.ChangeMetadata(plan.BoardID, change.ID, ColumnsPropertyDescription(), change.New, nil)
+			case "labels":
+				column, err := c.GetColumnWithID(plan.BoardID, change.ID)
+				if err != nil {
+					return plan.BoardID, err
+				}
+				settings, err := labelSettings(column.Type, column.SettingsStr, change.column.Labels)
+				if err != nil {
+					return plan.BoardID, err
+				}
+				m = Columns.Update(plan.BoardID, change.ID, change.column.Type, settings, nil)
+			}
+		case "column delete":
+			m = Columns.Delete(plan.BoardID, change.ID, nil)
+		default:
+			return plan.BoardID, fmt.Errorf("unsupported change: %s", change)
+		}
+		if err := c.Do(ctx, NewMutationPayload(m), nil); err != nil {
+			return plan.BoardID, fmt.Errorf("%s: %v", change, err)
+		}
+	}
+	return plan.BoardID, nil
+}
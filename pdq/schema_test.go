@@ -0,0 +1,114 @@
+package pdq
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	. "github.com/di-wu/monday"
+)
+
+const testSchemaBoardName = "pdq schema"
+
+func TestPlan(t *testing.T) {
+	board, _, err := c.EnsureBoard(testSchemaBoardName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := BoardSpec{
+		Name:        testSchemaBoardName,
+		Description: "Managed by pdq.",
+		Groups: []GroupSpec{
+			{Title: "Done", Color: "#00c875"},
+			{Title: "Todo"},
+		},
+		Columns: []ColumnSpec{
+			{Title: "Status", Type: ColumnsTypeStatus(), Labels: []string{"Open", "Closed"}},
+			{Title: "Notes", Type: ColumnsTypeText(), Description: "Free text."},
+		},
+		Prune: true,
+	}
+	apply := func(spec BoardSpec) BoardPlan {
+		t.Helper()
+		plan, err := c.Plan(context.Background(), board.ID(), spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := c.Apply(context.Background(), plan, false, nil); err != nil {
+			t.Fatal(err)
+		}
+		if plan, err := c.Plan(context.Background(), board.ID(), spec); err != nil {
+			t.Fatal(err)
+		} else if !plan.Empty() {
+			t.Fatalf("expected no changes after apply, got:\n%s", plan)
+		}
+		return plan
+	}
+	apply(spec)
+
+	groups, _ := c.GetGroups(board.ID())
+	if len(groups) != 2 || groups[0].Title != "Done" || groups[1].Title != "Todo" {
+		t.Errorf("unexpected groups %v", groups)
+	}
+
+	// Rename a group by its identifier, reorder the groups and add a label.
+	spec.Groups = []GroupSpec{
+		{ID: groups[1].Id, Title: "Backlog"},
+		{Title: "Done"},
+	}
+	spec.Columns[0].Labels = []string{"Open", "Blocked", "Closed"}
+	plan := apply(spec)
+	var actions []string
+	for _, change := range plan.Changes {
+		actions = append(actions, string(change.Action))
+	}
+	if a := strings.Join(actions, ","); a != "update,update,move" {
+		t.Errorf("got actions %s, expected update,update,move", a)
+	}
+	groups, _ = c.GetGroups(board.ID())
+	if len(groups) != 2 || groups[0].Title != "Backlog" || groups[1].Title != "Done" {
+		t.Errorf("unexpected groups %v", groups)
+	}
+
+	// Pruning the column removes it.
+	spec.Columns = spec.Columns[:1]
+	apply(spec)
+	if _, err := c.GetColumnWithID(board.ID(), "text"); err == nil {
+		t.Error("expected the notes column to be deleted")
+	}
+
+	if _, err := c.Plan(context.Background(), board.ID(), BoardSpec{
+		Name:    testSchemaBoardName,
+		Columns: []ColumnSpec{{Title: "Status", Type: ColumnsTypeText()}},
+	}); err == nil {
+		t.Error("expected an error when changing the type of a column")
+	}
+}
+
+func TestApplyDryRun(t *testing.T) {
+	plan, err := c.Plan(context.Background(), 0, BoardSpec{
+		Name:   "pdq dry run",
+		Groups: []GroupSpec{{Title: "Todo"}, {Title: "Done"}},
+		Columns: []ColumnSpec{
+			{Title: "Status", Type: ColumnsTypeStatus()},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if _, err := c.Apply(context.Background(), plan, true, &buf); err != nil {
+		t.Fatal(err)
+	}
+	expected := `+ board "pdq dry run"
++ group "Todo"
++ group "Done"
++ column "Status" (status)
+> group "Done" after "Todo"
+Plan: 4 to create, 0 to update, 1 to move, 0 to delete.
+`
+	if buf.String() != expected {
+		t.Errorf("got:\n%s\nexpected:\n%s", buf.String(), expected)
+	}
+}
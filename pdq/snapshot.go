@@ -0,0 +1,742 @@
+package pdq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	. "github.com/di-wu/monday"
+)
+
+// SnapshotVersion is the version of the snapshot format written by Snapshot.
+const SnapshotVersion = 1
+
+const (
+	snapshotPageSize    = 50
+	snapshotUpdateLimit = 100
+)
+
+// BoardSnapshot is a complete copy of a board, which can be serialized to a json archive.
+type BoardSnapshot struct {
+	Version   int           `json:"version"`
+	CreatedAt time.Time     `json:"created_at"`
+	Board     SnapshotBoard `json:"board"`
+}
+
+type SnapshotBoard struct {
+	ID          string           `json:"id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description,omitempty"`
+	Kind        string           `json:"board_kind"`
+	Groups      []SnapshotGroup  `json:"groups"`
+	Columns     []SnapshotColumn `json:"columns"`
+	// SubitemColumns are the columns of the board that holds the subitems of the board.
+	SubitemColumns []SnapshotColumn `json:"subitem_columns,omitempty"`
+	Items          []SnapshotItem   `json:"items"`
+	Tags           []SnapshotTag    `json:"tags,omitempty"`
+	Subscribers    []SnapshotUser   `json:"subscribers,omitempty"`
+}
+
+type SnapshotGroup struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Color string `json:"color,omitempty"`
+}
+
+type SnapshotColumn struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+	SettingsStr string `json:"settings_str,omitempty"`
+}
+
+type SnapshotItem struct {
+	ID           string                `json:"id"`
+	Name         string                `json:"name"`
+	GroupID      string                `json:"group_id,omitempty"`
+	CreatorID    string                `json:"creator_id,omitempty"`
+	CreatedAt    string                `json:"created_at,omitempty"`
+	UpdatedAt    string                `json:"updated_at,omitempty"`
+	ColumnValues []SnapshotColumnValue `json:"column_values,omitempty"`
+	Subitems     []SnapshotItem        `json:"subitems,omitempty"`
+	// Updates in the order in which they were posted.
+	Updates []SnapshotUpdate `json:"updates,omitempty"`
+}
+
+type SnapshotColumnValue struct {
+	ID   string `json:"id"`
+	Text string `json:"text,omitempty"`
+	// Value is the json value of the column, empty if the column has no value.
+	Value string `json:"value,omitempty"`
+}
+
+type SnapshotUpdate struct {
+	ID        string           `json:"id"`
+	Body      string           `json:"body"`
+	CreatorID string           `json:"creator_id,omitempty"`
+	CreatedAt string           `json:"created_at,omitempty"`
+	Replies   []SnapshotUpdate `json:"replies,omitempty"`
+}
+
+type SnapshotTag struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color,omitempty"`
+}
+
+type SnapshotUser struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email,omitempty"`
+}
+
+// Write writes the snapshot as an indented json archive.
+func (s BoardSnapshot) Write(w io.Writer) error {
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	return e.Encode(s)
+}
+
+// ReadSnapshot reads a json archive written by BoardSnapshot.Write.
+func ReadSnapshot(r io.Reader) (BoardSnapshot, error) {
+	var s BoardSnapshot
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return BoardSnapshot{}, err
+	}
+	if s.Version < 1 || SnapshotVersion < s.Version {
+		return BoardSnapshot{}, fmt.Errorf("unsupported snapshot version %d", s.Version)
+	}
+	return s, nil
+}
+
+// Snapshot captures the structure and content of the board with given identifier.
+func (c SimpleClient) Snapshot(ctx context.Context, boardID int) (BoardSnapshot, error) {
+	var data struct {
+		Boards []struct {
+			SnapshotBoard
+			Groups []struct {
+				Id, Title, Color string
+			} `json:"groups"`
+			Columns []struct {
+				Column
+				Description string
+			} `json:"columns"`
+			Tags        []SnapshotTag  `json:"tags"`
+			Subscribers []SnapshotUser `json:"subscribers"`
+		}
+	}
+	if err := c.Do(ctx, NewQueryPayload(
+		Boards.List(
+			[]BoardsField{
+				BoardsIDField(),
+				BoardsNameField(),
+				BoardsDescriptionField(),
+				BoardsKindField(),
+				NewBoardsGroupsFields([]GroupsField{
+					GroupsIDField(),
+					GroupsTitleField(),
+					GroupsColorField(),
+				}, nil),
+				NewBoardsColumnField(snapshotColumnsFields()),
+				NewBoardsTagsField([]TagsField{
+					TagsIDField(),
+					TagsNameField(),
+					TagsColorField(),
+				}, nil),
+				NewBoardsSubscribersField([]UsersField{
+					UsersIDField(),
+					UsersNameField(),
+					UsersEmailField(),
+				}, nil),
+			},
+			NewBoardsIDsArgument([]int{boardID}),
+		),
+	), &data); err != nil {
+		return BoardSnapshot{}, err
+	}
+	if len(data.Boards) != 1 {
+		return BoardSnapshot{}, fmt.Errorf("no boards returned for id %d", boardID)
+	}
+	b := data.Boards[0]
+	board := b.SnapshotBoard
+	board.Tags = b.Tags
+	board.Subscribers = b.Subscribers
+	for _, g := range b.Groups {
+		board.Groups = append(board.Groups, SnapshotGroup{ID: g.Id, Title: g.Title, Color: g.Color})
+	}
+	board.Columns = snapshotColumns(b.Columns)
+
+	var subitemsBoard string
+	for page := 1; ; page++ {
+		items, err := c.snapshotItems(ctx, boardID, page)
+		if err != nil {
+			return BoardSnapshot{}, err
+		}
+		for _, item := range items {
+			item.Item, err = c.snapshotUpdates(ctx, item.Item)
+			if err != nil {
+				return BoardSnapshot{}, err
+			}
+			for _, subitem := range item.Subitems {
+				subitemsBoard = subitem.Board.Id
+				subitem.SnapshotItem, err = c.snapshotUpdates(ctx, subitem.SnapshotItem)
+				if err != nil {
+					return BoardSnapshot{}, err
+				}
+				item.Item.Subitems = append(item.Item.Subitems, subitem.SnapshotItem)
+			}
+			board.Items = append(board.Items, item.Item)
+		}
+		if len(items) < snapshotPageSize {
+			break
+		}
+	}
+	if subitemsBoard != "" {
+		id, _ := strconv.Atoi(subitemsBoard)
+		columns, err := c.GetColumns(id)
+		if err != nil {
+			return BoardSnapshot{}, err
+		}
+		for _, col := range columns {
+			board.SubitemColumns = append(board.SubitemColumns, SnapshotColumn{
+				ID: col.Id, Title: col.Title, Type: col.Type, SettingsStr: col.SettingsStr,
+			})
+		}
+	}
+	return BoardSnapshot{
+		Version:   SnapshotVersion,
+		CreatedAt: time.Now().UTC(),
+		Board:     board,
+	}, nil
+}
+
+func snapshotColumnsFields() []ColumnsField {
+	return []ColumnsField{
+		ColumnsIDField(),
+		ColumnsTitleField(),
+		ColumnsTypeField(),
+		ColumnsDescriptionField(),
+		ColumnsSettingsStrField(),
+	}
+}
+
+func snapshotColumns(columns []struct {
+	Column
+	Description string
+}) []SnapshotColumn {
+	var snapshot []SnapshotColumn
+	for _, col := range columns {
+		snapshot = append(snapshot, SnapshotColumn{
+			ID:          col.Id,
+			Title:       col.Title,
+			Type:        col.Type,
+			Description: col.Description,
+			SettingsStr: col.SettingsStr,
+		})
+	}
+	return snapshot
+}
+
+// snapshotItem is the item as returned by the api, before it is converted to a SnapshotItem.
+type snapshotItem struct {
+	SnapshotItem
+	Group struct {
+		Id string
+	}
+	ColumnValues []struct {
+		Id, Text string
+		Value    *string
+	} `json:"column_values"`
+}
+
+// item returns the item with its group and column values filled in, and its updates in chronological order.
+func (i snapshotItem) item() SnapshotItem {
+	item := i.SnapshotItem
+	item.GroupID = i.Group.Id
+	item.ColumnValues = nil
+	for _, v := range i.ColumnValues {
+		value := SnapshotColumnValue{ID: v.Id, Text: v.Text}
+		if v.Value != nil {
+			value.Value = *v.Value
+		}
+		item.ColumnValues = append(item.ColumnValues, value)
+	}
+	item.Updates = nil
+	for n := len(i.Updates) - 1; 0 <= n; n-- {
+		item.Updates = append(item.Updates, i.Updates[n])
+	}
+	return item
+}
+
+func snapshotItemsFields() []ItemsField {
+	return []ItemsField{
+		ItemsIDField(),
+		ItemsNameField(),
+		ItemsCreatorIDField(),
+		ItemsCreatedAtField(),
+		ItemsUpdatedAtField(),
+		NewItemsGroupField([]GroupsField{GroupsIDField()}, nil),
+		NewItemsColumnValuesField([]ColumnValuesField{
+			ColumnValuesIDField(),
+			ColumnValuesTextField(),
+			ColumnValuesValueField(),
+		}, nil),
+	}
+}
+
+func snapshotUpdatesField(page int) ItemsField {
+	return NewItemsUpdatesField([]UpdatesField{
+		UpdatesIDField(),
+		UpdatesBodyField(),
+		UpdatesCreatorIDField(),
+		UpdatesCreatedAtField(),
+		NewUpdatesRepliesField([]RepliesField{
+			RepliesIDField(),
+			RepliesBodyField(),
+			RepliesCreatorIDField(),
+			RepliesCreatedAtField(),
+		}),
+	}, []UpdatesArgument{
+		NewUpdatesLimitArgument(snapshotUpdateLimit),
+		NewUpdatesPageArgument(page),
+	})
+}
+
+type snapshotPageItem struct {
+	Item     SnapshotItem
+	Subitems []snapshotSubitem
+}
+
+type snapshotSubitem struct {
+	SnapshotItem
+	Board struct {
+		Id string
+	}
+}
+
+// snapshotItems returns a page of items of the board, including the first page of updates of each item and subitem.
+func (c SimpleClient) snapshotItems(ctx context.Context, boardID, page int) ([]snapshotPageItem, error) {
+	subitemsFields := append(snapshotItemsFields(),
+		NewItemsBoardField([]BoardsField{BoardsIDField()}, nil),
+		snapshotUpdatesField(1),
+	)
+	var data struct {
+		Boards []struct {
+			Items []struct {
+				snapshotItem
+				Subitems []struct {
+					snapshotItem
+					Board struct {
+						Id string
+					}
+				} `json:"subitems"`
+			}
+		}
+	}
+	if err := c.Do(ctx, NewQueryPayload(
+		Boards.List(
+			[]BoardsField{
+				NewBoardsItemsFields(
+					append(snapshotItemsFields(),
+						NewItemsSubitemsField(subitemsFields, nil),
+						snapshotUpdatesField(1),
+					),
+					[]ItemsArgument{
+						NewItemsLimitArgument(snapshotPageSize),
+						NewItemsPageArgument(page),
+					},
+				),
+			},
+			NewBoardsIDsArgument([]int{boardID}),
+		),
+	), &data); err != nil {
+		return nil, err
+	}
+	if len(data.Boards) != 1 {
+		return nil, fmt.Errorf("no boards returned for id %d", boardID)
+	}
+	var items []snapshotPageItem
+	for _, i := range data.Boards[0].Items {
+		item := snapshotPageItem{Item: i.item()}
+		for _, s := range i.Subitems {
+			subitem := snapshotSubitem{SnapshotItem: s.item(), Board: s.Board}
+			subitem.GroupID = ""
+			item.Subitems = append(item.Subitems, subitem)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// snapshotUpdates fetches the remaining updates of an item that has more updates than fit in a single page.
+func (c SimpleClient) snapshotUpdates(ctx context.Context, item SnapshotItem) (SnapshotItem, error) {
+	if len(item.Updates) < snapshotUpdateLimit {
+		return item, nil
+	}
+	id, _ := strconv.Atoi(item.ID)
+	for page := 2; ; page++ {
+		var data struct {
+			Items []snapshotItem
+		}
+		if err := c.Do(ctx, NewQueryPayload(
+			Items.List(
+				[]ItemsField{snapshotUpdatesField(page)},
+				NewItemsIDsArgument([]int{id}),
+			),
+		), &data); err != nil {
+			return SnapshotItem{}, err
+		}
+		if len(data.Items) != 1 {
+			return SnapshotItem{}, fmt.Errorf("no items returned for id %d", id)
+		}
+		// Updates are returned newest first, so older pages go in front.
+		older := data.Items[0].item().Updates
+		item.Updates = append(older, item.Updates...)
+		if len(older) < snapshotUpdateLimit {
+			return item, nil
+		}
+	}
+}
+
+// RestoreResult maps the identifiers of the snapshot to the identifiers of the restored board.
+type RestoreResult struct {
+	BoardID int
+	Groups  map[string]string
+	Columns map[string]string
+	Items   map[string]string
+	Updates map[string]string
+	Tags    map[int]int
+	// Skipped describes the parts of the snapshot that could not be restored.
+	Skipped []string
+}
+
+// readOnlyColumns are column types of which the values are computed by monday and can not be set.
+var readOnlyColumns = map[string]bool{
+	"auto_number":   true,
+	"creation_log":  true,
+	"item_id":       true,
+	"last_updated":  true,
+	"name":          true,
+	"progress":      true,
+	"subtasks":      true,
+	"formula":       true,
+	"mirror":        true,
+	"time_tracking": true,
+	"vote":          true,
+}
+
+// Restore recreates the snapshot into a new board with given name, or the name of the snapshot if empty.
+// Updates and replies are posted by the owner of the token, since their creator and creation date can not be set.
+func (c SimpleClient) Restore(ctx context.Context, snapshot BoardSnapshot, name string) (RestoreResult, error) {
+	if snapshot.Version < 1 || SnapshotVersion < snapshot.Version {
+		return RestoreResult{}, fmt.Errorf("unsupported snapshot version %d", snapshot.Version)
+	}
+	s := snapshot.Board
+	if name == "" {
+		name = s.Name
+	}
+	result := RestoreResult{
+		Groups:  make(map[string]string),
+		Columns: make(map[string]string),
+		Items:   make(map[string]string),
+		Updates: make(map[string]string),
+		Tags:    make(map[int]int),
+	}
+
+	kind := BoardsKindPublic()
+	switch s.Kind {
+	case "private":
+		kind = BoardsKindPrivate()
+	case "share":
+		kind = BoardsKindShare()
+	}
+	var board struct {
+		Board struct {
+			Board
+			Groups []Group
+		} `json:"create_board"`
+	}
+	if err := c.Do(ctx, NewMutationPayload(
+		Boards.Create(name, kind, []BoardsField{
+			BoardsIDField(),
+			NewBoardsGroupsFields([]GroupsField{GroupsIDField()}, nil),
+		}),
+	), &board); err != nil {
+		return result, err
+	}
+	boardID := board.Board.ID()
+	result.BoardID = boardID
+	if s.Description != "" {
+		if err := c.Do(ctx, NewMutationPayload(
+			Boards.Update(boardID, BoardsAttributeDescription(), s.Description),
+		), nil); err != nil {
+			return result, err
+		}
+	}
+
+	// Groups, the default group of the new board is removed once the groups of the snapshot exist.
+	var previous string
+	for _, g := range s.Groups {
+		var data struct {
+			Group Group `json:"create_group"`
+		}
+		if err := c.Do(ctx, NewMutationPayload(
+			Groups.Create(boardID, g.Title, []GroupsField{GroupsIDField()}),
+		), &data); err != nil {
+			return result, err
+		}
+		id := data.Group.Id
+		result.Groups[g.ID] = id
+		mutations := []Mutation{}
+		if g.Color != "" {
+			mutations = append(mutations, Groups.Update(boardID, id, GroupsAttributeColor(), g.Color, nil).WithAlias("color"))
+		}
+		if previous != "" {
+			mutations = append(mutations, Groups.Update(boardID, id, GroupsAttributeRelativePositionAfter(), previous, nil).WithAlias("position"))
+		}
+		if len(mutations) != 0 {
+			if err := c.Do(ctx, NewMutationPayload(mutations...), nil); err != nil {
+				return result, err
+			}
+		}
+		previous = id
+	}
+	if len(s.Groups) != 0 {
+		for _, g := range board.Board.Groups {
+			if err := c.Do(ctx, NewMutationPayload(Groups.Delete(boardID, g.Id, nil)), nil); err != nil {
+				return result, err
+			}
+		}
+	}
+
+	// Columns.
+	columnTypes := make(map[string]string)
+	for _, col := range s.Columns {
+		columnTypes[col.ID] = col.Type
+		if col.Type == "name" {
+			result.Columns[col.ID] = col.ID
+			continue
+		}
+		id, err := c.restoreColumn(ctx, boardID, col)
+		if err != nil {
+			result.Skipped = append(result.Skipped, fmt.Sprintf("column %s: %v", col.ID, err))
+			continue
+		}
+		result.Columns[col.ID] = id
+	}
+
+	// Tags, which are referenced by the values of tags columns.
+	for _, t := range s.Tags {
+		var data struct {
+			Tag struct {
+				Id int
+			} `json:"create_or_get_tag"`
+		}
+		if err := c.Do(ctx, NewMutationPayload(
+			Tags.CreateOrGetByBoard(t.Name, boardID, []TagsField{TagsIDField()}),
+		), &data); err != nil {
+			return result, err
+		}
+		result.Tags[t.ID] = data.Tag.Id
+	}
+
+	// Subscribers, the owner of the token is subscribed automatically.
+	var subscribers []int
+	for _, u := range s.Subscribers {
+		subscribers = append(subscribers, u.ID)
+	}
+	if len(subscribers) != 0 {
+		if err := c.Do(ctx, NewMutationPayload(Boards.AddSubscribers(boardID, subscribers, nil)), nil); err != nil {
+			result.Skipped = append(result.Skipped, fmt.Sprintf("subscribers: %v", err))
+		}
+	}
+
+	// Items.
+	values := func(item SnapshotItem, columns, types map[string]string) []ColumnValue {
+		var values []ColumnValue
+		for _, v := range item.ColumnValues {
+			id, ok := columns[v.ID]
+			if !ok || v.Value == "" || readOnlyColumns[types[v.ID]] {
+				continue
+			}
+			value := v.Value
+			if types[v.ID] == "tags" {
+				value = remapTags(value, result.Tags)
+			}
+			values = append(values, NewJSONValue(id, value))
+		}
+		return values
+	}
+	var subitemsBoard int
+	subitemColumns := make(map[string]string)
+	subitemTypes := make(map[string]string)
+	for _, item := range s.Items {
+		group, ok := result.Groups[item.GroupID]
+		if !ok {
+			// without groups in the snapshot, the items go in the default group of the new board.
+			if len(s.Groups) != 0 || len(board.Board.Groups) == 0 {
+				return result, fmt.Errorf("item %s: no group %q in the snapshot", item.ID, item.GroupID)
+			}
+			group = board.Board.Groups[0].Id
+		}
+		var created struct {
+			Item Item `json:"create_item"`
+		}
+		if err := c.Do(ctx, NewMutationPayload(
+			Items.Create(boardID, group, item.Name, values(item, result.Columns, columnTypes), []ItemsField{ItemsIDField()}),
+		), &created); err != nil {
+			return result, fmt.Errorf("item %s: %v", item.ID, err)
+		}
+		result.Items[item.ID] = created.Item.Id
+
+		for _, subitem := range item.Subitems {
+			var data struct {
+				Subitem struct {
+					Item
+					Board struct {
+						Id string
+					}
+				} `json:"create_subitem"`
+			}
+			if err := c.Do(ctx, NewMutationPayload(
+				Items.CreateSubitem(created.Item.ID(), subitem.Name, nil, []ItemsField{
+					ItemsIDField(),
+					NewItemsBoardField([]BoardsField{BoardsIDField()}, nil),
+				}),
+			), &data); err != nil {
+				return result, fmt.Errorf("subitem %s: %v", subitem.ID, err)
+			}
+			result.Items[subitem.ID] = data.Subitem.Id
+			if subitemsBoard == 0 {
+				subitemsBoard, _ = strconv.Atoi(data.Subitem.Board.Id)
+				if err := c.restoreSubitemColumns(ctx, subitemsBoard, s.SubitemColumns, subitemColumns, subitemTypes); err != nil {
+					return result, err
+				}
+			}
+			if v := values(subitem, subitemColumns, subitemTypes); len(v) != 0 {
+				if err := c.Do(ctx, NewMutationPayload(
+					Columns.ChangeMultipleValues(data.Subitem.ID(), subitemsBoard, EncodeColumnValues(v), []ItemsField{ItemsIDField()}),
+				), nil); err != nil {
+					return result, fmt.Errorf("subitem %s: %v", subitem.ID, err)
+				}
+			}
+			for _, update := range subitem.Updates {
+				if err := c.restoreUpdate(ctx, data.Subitem.ID(), update, result.Updates); err != nil {
+					return result, fmt.Errorf("update %s: %v", update.ID, err)
+				}
+			}
+		}
+
+		for _, update := range item.Updates {
+			if err := c.restoreUpdate(ctx, created.Item.ID(), update, result.Updates); err != nil {
+				return result, fmt.Errorf("update %s: %v", update.ID, err)
+			}
+		}
+	}
+	return result, nil
+}
+
+// restoreColumn creates the column with its settings and returns the identifier of the new column.
+func (c SimpleClient) restoreColumn(ctx context.Context, boardID int, col SnapshotColumn) (string, error) {
+	typ, ok := columnsType(col.Type)
+	if !ok {
+		return "", fmt.Errorf("columns of type %s can not be created", col.Type)
+	}
+	settings := col.SettingsStr
+	if settings == "{}" {
+		settings = ""
+	}
+	var data struct {
+		Column Column `json:"create_column"`
+	}
+	if err := c.Do(ctx, NewMutationPayload(
+		Columns.CreateWithDefaults(boardID, col.Title, typ, settings, []ColumnsField{ColumnsIDField()}),
+	), &data); err != nil {
+		return "", err
+	}
+	if col.Description != "" {
+		if err := c.Do(ctx, NewMutationPayload(
+			Columns.ChangeMetadata(boardID, data.Column.Id, ColumnsPropertyDescription(), col.Description, nil),
+		), nil); err != nil {
+			return "", err
+		}
+	}
+	return data.Column.Id, nil
+}
+
+// restoreSubitemColumns matches the columns of the snapshot with the columns of the new subitems board by title,
+// missing columns are created.
+func (c SimpleClient) restoreSubitemColumns(ctx context.Context, boardID int, snapshot []SnapshotColumn, ids, types map[string]string) error {
+	columns, err := c.GetColumns(boardID)
+	if err != nil {
+		return err
+	}
+	for _, col := range snapshot {
+		types[col.ID] = col.Type
+		for _, v := range columns {
+			if v.Title == col.Title && v.Type == col.Type || col.Type == "name" && v.Type == "name" {
+				ids[col.ID] = v.Id
+				break
+			}
+		}
+		if _, ok := ids[col.ID]; ok {
+			continue
+		}
+		id, err := c.restoreColumn(ctx, boardID, col)
+		if err != nil {
+			return fmt.Errorf("subitem column %s: %v", col.ID, err)
+		}
+		ids[col.ID] = id
+	}
+	return nil
+}
+
+func (c SimpleClient) restoreUpdate(ctx context.Context, itemID int, update SnapshotUpdate, ids map[string]string) error {
+	var data struct {
+		Update struct {
+			Id string
+		} `json:"create_update"`
+	}
+	if err := c.Do(ctx, NewMutationPayload(
+		Updates.Create(itemID, update.Body, []UpdatesField{UpdatesIDField()}),
+	), &data); err != nil {
+		return err
+	}
+	ids[update.ID] = data.Update.Id
+	parentID, _ := strconv.Atoi(data.Update.Id)
+	for _, reply := range update.Replies {
+		var data struct {
+			Reply struct {
+				Id string
+			} `json:"create_update"`
+		}
+		if err := c.Do(ctx, NewMutationPayload(
+			Updates.CreateReply(itemID, parentID, reply.Body, []UpdatesField{UpdatesIDField()}),
+		), &data); err != nil {
+			return err
+		}
+		ids[reply.ID] = data.Reply.Id
+	}
+	return nil
+}
+
+// remapTags replaces the tag identifiers of a tags column value.
+func remapTags(value string, tags map[int]int) string {
+	var v struct {
+		TagIDs []int `json:"tag_ids"`
+	}
+	if err := json.Unmarshal([]byte(value), &v); err != nil {
+		return value
+	}
+	for i, id := range v.TagIDs {
+		if mapped, ok := tags[id]; ok {
+			v.TagIDs[i] = mapped
+		}
+	}
+	raw, _ := json.Marshal(v)
+	return string(raw)
+}
@@ -0,0 +1,116 @@
+package pdq
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	. "github.com/di-wu/monday"
+)
+
+func TestSnapshot(t *testing.T) {
+	ctx := context.Background()
+	board, err := c.CreateBoard("pdq snapshot")
+	if err != nil {
+		t.Fatal(err)
+	}
+	group, _ := c.CreateGroup(board.ID(), "Later")
+	status, _ := c.CreateStatusColumn(board.ID(), "Status", []string{"Open", "Closed"})
+	text, _ := c.CreateColumn(board.ID(), "Notes", ColumnsTypeText())
+	item, err := c.CreateItemWithColumnValues(board.ID(), group.Id, "a", []ColumnValue{
+		NewStatusLabelValue(status.Id, "Closed"),
+		NewTextValue(text.Id, "hello"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var update struct {
+		Update struct {
+			Id string
+		} `json:"create_update"`
+	}
+	if err := c.Do(ctx, NewMutationPayload(Updates.Create(item.ID(), "first", nil)), &update); err != nil {
+		t.Fatal(err)
+	}
+	parent := Item{Id: update.Update.Id}
+	if err := c.Do(ctx, NewMutationPayload(Updates.CreateReply(item.ID(), parent.ID(), "reply", nil)), nil); err != nil {
+		t.Fatal(err)
+	}
+	var subitem struct {
+		Subitem Item `json:"create_subitem"`
+	}
+	if err := c.Do(ctx, NewMutationPayload(Items.CreateSubitem(item.ID(), "sub", nil, nil)), &subitem); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Do(ctx, NewMutationPayload(Updates.Create(subitem.Subitem.ID(), "on sub", nil)), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	snapshot, err := c.Snapshot(ctx, board.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := snapshot.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if snapshot, err = ReadSnapshot(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if len(snapshot.Board.Items) != 1 || len(snapshot.Board.Items[0].Subitems) != 1 {
+		t.Fatalf("unexpected items %v", snapshot.Board.Items)
+	}
+
+	result, err := c.Restore(ctx, snapshot, "pdq snapshot restored")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Skipped) != 0 {
+		t.Errorf("unexpected skipped %v", result.Skipped)
+	}
+	restored, err := c.Snapshot(ctx, result.BoardID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := restored.Board
+	if len(r.Groups) != 2 || r.Groups[1].Title != "Later" {
+		t.Errorf("unexpected groups %v", r.Groups)
+	}
+	if len(r.Items) != 1 {
+		t.Fatalf("got %d items, expected 1", len(r.Items))
+	}
+	i := r.Items[0]
+	if i.GroupID != result.Groups[group.Id] || i.ID != result.Items[item.Id] {
+		t.Errorf("unexpected item %v", i)
+	}
+	values := make(map[string]string)
+	for _, v := range i.ColumnValues {
+		values[v.ID] = v.Text
+	}
+	if values[result.Columns[status.Id]] != "Closed" || values[result.Columns[text.Id]] != "hello" {
+		t.Errorf("unexpected values %v", values)
+	}
+	if len(i.Subitems) != 1 || i.Subitems[0].Name != "sub" ||
+		len(i.Subitems[0].Updates) != 1 || i.Subitems[0].Updates[0].Body != "on sub" {
+		t.Errorf("unexpected subitems %v", i.Subitems)
+	}
+	if len(i.Updates) != 1 || i.Updates[0].Body != "first" ||
+		len(i.Updates[0].Replies) != 1 || i.Updates[0].Replies[0].Body != "reply" {
+		t.Errorf("unexpected updates %v", i.Updates)
+	}
+
+	// Items of groups that are not in the snapshot can not be restored.
+	snapshot.Board.Items[0].GroupID = "unknown"
+	if _, err := c.Restore(ctx, snapshot, "pdq snapshot unknown group"); err == nil {
+		t.Error("expected an error for an unknown group")
+	}
+	// Without groups, items are restored in the default group.
+	snapshot.Board.Groups = nil
+	result, err = c.Restore(ctx, snapshot, "pdq snapshot without groups")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Items) != 2 {
+		t.Errorf("unexpected items %v", result.Items)
+	}
+}
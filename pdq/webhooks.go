@@ -0,0 +1,220 @@
+package pdq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"strconv"
+
+	. "github.com/di-wu/monday"
+)
+
+type Webhook struct {
+	Id, Event, Config string
+	BoardId           string `json:"board_id"`
+}
+
+func (w Webhook) ID() int {
+	id, _ := strconv.Atoi(w.Id)
+	return id
+}
+
+func (w Webhook) equals(other Webhook) bool {
+	if w.Id != other.Id || w.Event != other.Event || w.Config != other.Config {
+		return false
+	}
+	return true
+}
+
+// WebhookSpec describes a webhook subscription that should exist on a board.
+type WebhookSpec struct {
+	URL    string
+	Event  WebhookEventType
+	Config string
+}
+
+// WebhookRecord maps the identifiers of the webhooks created by EnsureWebhooks to their urls.
+// The Monday API does not expose the url of a webhook, so the record is the only way to know which webhooks point
+// at an old url. Persist it between runs, EnsureWebhooks updates it in place.
+type WebhookRecord map[string]string
+
+// matches reports whether the existing webhook corresponds with the spec.
+// The Monday API does not expose the url of a webhook, so only recorded webhooks can match.
+func (s WebhookSpec) matches(w Webhook, record WebhookRecord) bool {
+	if url, ok := record[w.Id]; !ok || url != s.URL {
+		return false
+	}
+	if s.Event.String() != w.Event {
+		return false
+	}
+	return equalConfig(s.Config, w.Config)
+}
+
+func equalConfig(a, b string) bool {
+	if a == b {
+		return true
+	}
+	var x, y interface{}
+	if json.Unmarshal([]byte(a), &x) != nil || json.Unmarshal([]byte(b), &y) != nil {
+		return false
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+// WebhookDiff reports the changes made by EnsureWebhooks.
+type WebhookDiff struct {
+	Created, Deleted, Unchanged []Webhook
+}
+
+// Changed reports whether any webhooks got created or deleted.
+func (d WebhookDiff) Changed() bool {
+	return len(d.Created) != 0 || len(d.Deleted) != 0
+}
+
+// EnsureWebhooks makes sure the board is subscribed to exactly the desired webhooks.
+// Missing webhooks are created, duplicates and recorded webhooks that are no longer desired are deleted.
+// Only the webhooks in the record are managed, the webhooks of other integrations are left untouched.
+//
+// Since the Monday API does not expose the url of a webhook, the record keeps the urls of the webhooks that
+// EnsureWebhooks created. Recorded webhooks only match a spec with the same url, so the webhooks of an old url
+// are deleted and replaced. The record is required, start with an empty one.
+func (c SimpleClient) EnsureWebhooks(boardID int, desired []WebhookSpec, record WebhookRecord) (WebhookDiff, error) {
+	if record == nil {
+		return WebhookDiff{}, fmt.Errorf("no webhook record given")
+	}
+	webhooks, err := c.GetWebhooks(boardID)
+	if err != nil {
+		return WebhookDiff{}, err
+	}
+
+	var diff WebhookDiff
+	kept := make(map[string]bool)
+	var missing []WebhookSpec
+	for _, spec := range desired {
+		var hit bool
+		for _, w := range webhooks {
+			if kept[w.Id] || !spec.matches(w, record) {
+				continue
+			}
+			hit = true
+			kept[w.Id] = true
+			diff.Unchanged = append(diff.Unchanged, w)
+			break
+		}
+		if !hit {
+			missing = append(missing, spec)
+		}
+	}
+
+	// Create the missing webhooks before deleting stale ones, so no events get lost in between.
+	for _, spec := range missing {
+		webhook, err := c.CreateWebhook(boardID, spec)
+		if err != nil {
+			return diff, err
+		}
+		record[webhook.Id] = spec.URL
+		diff.Created = append(diff.Created, webhook)
+	}
+	for _, w := range webhooks {
+		if _, recorded := record[w.Id]; kept[w.Id] || !recorded {
+			continue
+		}
+		if err := c.DeleteWebhook(w.ID()); err != nil {
+			return diff, err
+		}
+		delete(record, w.Id)
+		diff.Deleted = append(diff.Deleted, w)
+	}
+	return diff, nil
+}
+
+// CreateWebhook subscribes the given url to the event of the spec.
+func (c SimpleClient) CreateWebhook(boardID int, spec WebhookSpec) (Webhook, error) {
+	fields := []WebhookField{
+		WebhookIDField(),
+		WebhookBoardIDField(),
+	}
+	mutation := Webhooks.Create(boardID, spec.URL, spec.Event, fields)
+	if spec.Config != "" {
+		mutation = Webhooks.CreateWithConfig(boardID, spec.URL, spec.Event, spec.Config, fields)
+	}
+	resp, err := c.Exec(context.Background(), NewMutationPayload(mutation))
+	if err != nil {
+		return Webhook{}, err
+	}
+	raw, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return Webhook{}, err
+	}
+	var body struct {
+		Data struct {
+			Webhook *Webhook `json:"create_webhook"`
+		}
+	}
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return Webhook{}, err
+	}
+	if body.Data.Webhook == nil {
+		return Webhook{}, fmt.Errorf("no webhook created for board %d: %s", boardID, string(raw))
+	}
+	webhook := *body.Data.Webhook
+	webhook.Event = spec.Event.String()
+	webhook.Config = spec.Config
+	return webhook, nil
+}
+
+// DeleteWebhook deletes the webhook with the given identifier.
+func (c SimpleClient) DeleteWebhook(id int) error {
+	resp, err := c.Exec(context.Background(), NewMutationPayload(
+		Webhooks.Delete(id, nil),
+	))
+	if err != nil {
+		return err
+	}
+	raw, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	var body struct {
+		Data struct {
+			Webhook *Webhook `json:"delete_webhook"`
+		}
+	}
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return err
+	}
+	if body.Data.Webhook == nil {
+		return fmt.Errorf("no webhook deleted for id %d: %s", id, string(raw))
+	}
+	return nil
+}
+
+// GetWebhooks returns all the webhooks of the board.
+func (c SimpleClient) GetWebhooks(boardID int) ([]Webhook, error) {
+	resp, err := c.Exec(context.Background(), NewQueryPayload(
+		Webhooks.List(boardID, []WebhookField{
+			WebhookIDField(),
+			WebhookBoardIDField(),
+			WebhookEventField(),
+			WebhookConfigField(),
+		}),
+	))
+	if err != nil {
+		return nil, err
+	}
+	raw, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var body struct {
+		Data struct {
+			Webhooks []Webhook
+		}
+	}
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return nil, err
+	}
+	return body.Data.Webhooks, nil
+}
@@ -0,0 +1,136 @@
+package pdq
+
+import (
+	"testing"
+
+	. "github.com/di-wu/monday"
+	"github.com/di-wu/monday/mondaytest"
+)
+
+const testWebhookURL = "https://example.com/monday"
+
+func TestWebhooks(t *testing.T) {
+	board, _, _ := c.EnsureBoard(testBoardName)
+	desired := []WebhookSpec{
+		{URL: testWebhookURL, Event: WebhookEventTypeCreateItem()},
+	}
+	record := make(WebhookRecord)
+	if _, err := c.EnsureWebhooks(board.ID(), desired, record); err != nil {
+		t.Error(err)
+	}
+
+	diff, err := c.EnsureWebhooks(board.ID(), desired, record)
+	if err != nil {
+		t.Error(err)
+	}
+	if diff.Changed() {
+		t.Errorf("expected no changes, got %v", diff)
+	}
+
+	webhooks, err := c.GetWebhooks(board.ID())
+	if err != nil {
+		t.Error(err)
+	}
+	var hits []Webhook
+	for _, w := range webhooks {
+		if desired[0].matches(w, record) {
+			hits = append(hits, w)
+		}
+	}
+	if len(hits) != 1 || len(diff.Unchanged) != 1 {
+		t.Errorf("got %d webhooks, expected 1", len(hits))
+		return
+	}
+	if !hits[0].equals(diff.Unchanged[0]) {
+		t.Errorf("got %v, expected %v", hits[0], diff.Unchanged[0])
+	}
+}
+
+func TestWebhooksURLChange(t *testing.T) {
+	server := mondaytest.NewServer()
+	defer server.Close()
+	c := NewSimpleClientWithHTTPClient("mondaytest", server.Client())
+	board, err := c.CreateBoard(testBoardName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	record := make(WebhookRecord)
+	old := []WebhookSpec{{URL: testWebhookURL, Event: WebhookEventTypeCreateItem(), Config: `{"a":1,"b":[2]}`}}
+	created, err := c.EnsureWebhooks(board.ID(), old, record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(created.Created) != 1 || record[created.Created[0].Id] != testWebhookURL {
+		t.Fatalf("unexpected diff %v and record %v", created, record)
+	}
+
+	// the config is compared by its value, not by its formatting.
+	same := []WebhookSpec{{URL: testWebhookURL, Event: WebhookEventTypeCreateItem(), Config: `{"b": [2], "a": 1}`}}
+	if diff, err := c.EnsureWebhooks(board.ID(), same, record); err != nil || diff.Changed() {
+		t.Fatalf("unexpected diff %v: %v", diff, err)
+	}
+
+	moved := []WebhookSpec{{URL: testWebhookURL + "/v2", Event: WebhookEventTypeCreateItem(), Config: old[0].Config}}
+	diff, err := c.EnsureWebhooks(board.ID(), moved, record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diff.Created) != 1 || len(diff.Deleted) != 1 || len(diff.Unchanged) != 0 ||
+		diff.Deleted[0].Id != created.Created[0].Id {
+		t.Fatalf("unexpected diff %v", diff)
+	}
+	if len(record) != 1 || record[diff.Created[0].Id] != moved[0].URL {
+		t.Errorf("unexpected record %v", record)
+	}
+	webhooks, err := c.GetWebhooks(board.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(webhooks) != 1 || webhooks[0].Id != diff.Created[0].Id {
+		t.Errorf("unexpected webhooks %v", webhooks)
+	}
+}
+
+func TestWebhooksOtherIntegrations(t *testing.T) {
+	server := mondaytest.NewServer()
+	defer server.Close()
+	c := NewSimpleClientWithHTTPClient("mondaytest", server.Client())
+	board, err := c.CreateBoard(testBoardName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spec := WebhookSpec{URL: testWebhookURL, Event: WebhookEventTypeCreateItem()}
+	other, err := c.CreateWebhook(board.ID(), WebhookSpec{URL: "https://example.com/other", Event: spec.Event})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.EnsureWebhooks(board.ID(), []WebhookSpec{spec}, nil); err == nil {
+		t.Error("expected an error without a record")
+	}
+
+	// webhooks that are not recorded never match a spec and are never deleted.
+	record := make(WebhookRecord)
+	diff, err := c.EnsureWebhooks(board.ID(), []WebhookSpec{spec}, record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diff.Created) != 1 || len(diff.Unchanged) != 0 || len(diff.Deleted) != 0 {
+		t.Fatalf("unexpected diff %v", diff)
+	}
+	diff, err = c.EnsureWebhooks(board.ID(), nil, record)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diff.Deleted) != 1 || diff.Deleted[0].Id == other.Id || len(record) != 0 {
+		t.Fatalf("unexpected diff %v and record %v", diff, record)
+	}
+	webhooks, err := c.GetWebhooks(board.ID())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(webhooks) != 1 || webhooks[0].Id != other.Id {
+		t.Errorf("unexpected webhooks %v", webhooks)
+	}
+}
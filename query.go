@@ -10,12 +10,44 @@ func NewQueryPayload(queries ...Query) Payload {
 }
 
 type Query struct {
+	alias  string
 	name   string
 	fields []field
 	args   []argument
+	// scalar queries select a scalar with arguments and therefore have no fields.
+	scalar bool
+	// fragment is the fragment that is spread, instead of a field.
+	fragment *Fragment
+}
+
+// WithAlias returns the query under the given alias, so the same query can be used multiple times in a payload.
+// The result of the query is returned under the alias instead of the name of the query.
+func (q Query) WithAlias(alias string) Query {
+	q.alias = alias
+	return q
+}
+
+// Name returns the name of the root field of the query (e.g. boards).
+func (q Query) Name() string {
+	return q.name
+}
+
+// Alias returns the alias of the query, or an empty string if it has none.
+func (q Query) Alias() string {
+	return q.alias
 }
 
 func (q Query) stringify() string {
+	if q.fragment != nil && q.fragment.inline() {
+		fields := make([]string, 0)
+		for _, field := range q.fragment.fields {
+			fields = append(fields, field.stringify())
+		}
+		return fmt.Sprintf(`... on %s{%s}`, q.fragment.on, strings.Join(fields, " "))
+	}
+	if q.fragment != nil {
+		return "..." + q.fragment.name
+	}
 	fields := make([]string, 0)
 	for _, field := range q.fields {
 		fields = append(fields, field.stringify())
@@ -24,13 +56,23 @@ func (q Query) stringify() string {
 	for _, arg := range q.args {
 		args = append(args, arg.stringify())
 	}
-	if len(fields) == 0 {
+	if len(fields) == 0 && !q.scalar {
 		return ``
 	}
+	name := q.name
+	if q.alias != "" {
+		name = fmt.Sprintf(`%s:%s`, q.alias, q.name)
+	}
+	if q.scalar && len(args) == 0 {
+		return name
+	}
+	if q.scalar {
+		return fmt.Sprintf(`%s(%s)`, name, strings.Join(args, ","))
+	}
 	if len(args) == 0 {
-		return fmt.Sprintf(`%s{%s}`, q.name, strings.Join(fields, " "))
+		return fmt.Sprintf(`%s{%s}`, name, strings.Join(fields, " "))
 	}
-	return fmt.Sprintf(`%s(%s){%s}`, q.name, strings.Join(args, ","), strings.Join(fields, " "))
+	return fmt.Sprintf(`%s(%s){%s}`, name, strings.Join(args, ","), strings.Join(fields, " "))
 }
 
 type field struct {
@@ -50,43 +92,35 @@ type argument struct {
 	value    interface{}
 }
 
+// stringify returns the argument as it is written in a document, the values are checked by checkArguments first.
 func (a argument) stringify() string {
-	switch a.argument {
-	case "column_id", "column_value":
-		return fmt.Sprintf("%s:%q", a.argument, a.value)
-	case "ids":
-		if strs, ok := a.value.([]string); ok {
-			switch {
-			case len(strs) == 1:
-				return fmt.Sprintf("ids:%q", strs[0])
-			case len(strs) > 1:
-				return fmt.Sprintf("ids:%v", strings.Replace(fmt.Sprintf("%q", strs), " ", ",", -1))
-			default:
-				return ""
-			}
-		}
-		switch ids := a.value.([]int); {
-		case len(ids) == 1:
-			return fmt.Sprintf("ids:%v", ids[0])
-		case len(ids) > 1:
-			return fmt.Sprintf("ids:%v", strings.Replace(fmt.Sprint(ids), " ", ",", -1))
-		default:
-			return ""
+	value, _ := encodeValue(a.value)
+	return fmt.Sprintf("%s:%s", a.argument, value)
+}
+
+// checkArguments returns an error for the first argument in the fields whose value can not be encoded.
+func checkArguments(fields []field, args []argument, checked map[*Fragment]bool) error {
+	for _, a := range args {
+		if _, err := encodeValue(a.value); err != nil {
+			return fmt.Errorf("argument %s: %w", a.argument, err)
 		}
-	default:
-		switch a.value.(type) {
-		case string:
-			return fmt.Sprintf("%s:%q", a.argument, a.value)
-		case BoardsKind:
-			return fmt.Sprintf("%s:%v", a.argument, a.value.(BoardsKind).kind)
-		case UsersKind:
-			return fmt.Sprintf("%s:%v", a.argument, a.value.(UsersKind).kind)
-		case ColumnsType:
-			return fmt.Sprintf("%s:%v", a.argument, a.value.(ColumnsType).typ)
-		case NotificationType:
-			return fmt.Sprintf("%s:%v", a.argument, a.value.(NotificationType).kind)
+	}
+	for _, f := range fields {
+		switch {
+		case f.value == nil:
+		case f.value.fragment != nil:
+			if checked[f.value.fragment] {
+				continue
+			}
+			checked[f.value.fragment] = true
+			if err := checkArguments(f.value.fragment.fields, nil, checked); err != nil {
+				return err
+			}
 		default:
-			return fmt.Sprintf("%s:%v", a.argument, a.value)
+			if err := checkArguments(f.value.fields, f.value.args, checked); err != nil {
+				return err
+			}
 		}
 	}
+	return nil
 }
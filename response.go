@@ -0,0 +1,54 @@
+package monday
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+// Do executes the payload and decodes the data of the response into v.
+// Errors reported by the api are returned as an error, the data that is returned with them (e.g. the results of
+// the mutations that succeeded) is still decoded into v.
+func (c *Client) Do(ctx context.Context, payload Payload, v interface{}) error {
+	resp, err := c.Exec(ctx, payload)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	raw, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	var body struct {
+		Data   json.RawMessage
+		Errors []struct {
+			Message string
+		}
+		ErrorCode    string `json:"error_code"`
+		ErrorMessage string `json:"error_message"`
+	}
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return fmt.Errorf("invalid response (%s): %s", resp.Status, string(raw))
+	}
+	var decodeErr error
+	if v != nil && len(body.Data) != 0 {
+		decodeErr = json.Unmarshal(body.Data, v)
+	}
+	if len(body.Errors) != 0 {
+		var messages []string
+		for _, e := range body.Errors {
+			messages = append(messages, e.Message)
+		}
+		return errors.New(strings.Join(messages, "; "))
+	}
+	if body.ErrorMessage != "" {
+		if body.ErrorCode != "" {
+			return fmt.Errorf("%s: %s", body.ErrorCode, body.ErrorMessage)
+		}
+		return errors.New(body.ErrorMessage)
+	}
+	return decodeErr
+}
@@ -0,0 +1,68 @@
+package monday
+
+// Field is an untyped field of a selection set.
+// The typed fields of the services (e.g. BoardsField) are preferred,
+// untyped fields are the building blocks of code that is generated from the schema of the api.
+type Field struct {
+	field field
+}
+
+// NewField returns a field that selects a scalar, the arguments are optional.
+func NewField(name string, args []Argument) Field {
+	if len(args) == 0 {
+		return Field{field{name, nil}}
+	}
+	return Field{field{name, &Query{name: name, args: arguments(args), scalar: true}}}
+}
+
+// NewObjectField returns a field that selects the given fields of an object.
+func NewObjectField(name string, fields []Field, args []Argument) Field {
+	query := NewQuery(name, fields, args)
+	return Field{field{name, &query}}
+}
+
+// Argument is an untyped argument of a field.
+type Argument struct {
+	argument argument
+}
+
+// NewArgument returns an argument with the given name and value.
+func NewArgument(name string, value interface{}) Argument {
+	return Argument{argument{name, value}}
+}
+
+// NewQuery returns a query of the root field with the given name.
+func NewQuery(name string, fields []Field, args []Argument) Query {
+	return Query{
+		name:   name,
+		fields: selection(fields),
+		args:   arguments(args),
+	}
+}
+
+// NewMutation returns a mutation of the root field with the given name.
+// Without fields the mutation is expected to return a scalar.
+func NewMutation(name string, fields []Field, args []Argument) Mutation {
+	return Mutation{
+		name:   name,
+		fields: selection(fields),
+		args:   arguments(args),
+		scalar: len(fields) == 0,
+	}
+}
+
+func selection(fields []Field) []field {
+	var selection []field
+	for _, f := range fields {
+		selection = append(selection, f.field)
+	}
+	return selection
+}
+
+func arguments(args []Argument) []argument {
+	var arguments []argument
+	for _, a := range args {
+		arguments = append(arguments, a.argument)
+	}
+	return arguments
+}
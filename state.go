@@ -5,6 +5,11 @@ type State struct {
 	state string
 }
 
+// EnumValue returns the value as it appears in a query.
+func (s State) EnumValue() string {
+	return s.state
+}
+
 var (
 	allState      = State{"all"}
 	activeState   = State{"active"}
@@ -30,6 +30,18 @@ func (*UpdateService) Create(id int, body string, updatesFields []UpdatesField)
 	}
 }
 
+// CreateReply returns a mutation that allows you to reply to an update of an item.
+// - id: the item's unique identifier.
+// - parentID: the unique identifier of the update to reply to.
+// - body: the reply text.
+//
+// DOCS: https://monday.com/developers/v2#mutations-section-updates
+func (*UpdateService) CreateReply(id, parentID int, body string, updatesFields []UpdatesField) Mutation {
+	reply := Updates.Create(id, body, updatesFields)
+	reply.args = append(reply.args, argument{"parent_id", parentID})
+	return reply
+}
+
 // List returns a query that gets one or a collection of updates.
 //
 // DOCS: https://monday.com/developers/v2#queries-section-updates
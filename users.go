@@ -204,6 +204,11 @@ type UsersKind struct {
 	kind string
 }
 
+// EnumValue returns the value as it appears in a query.
+func (k UsersKind) EnumValue() string {
+	return k.kind
+}
+
 var (
 	allUsersKind       = UsersKind{"all"}
 	nonGuestsUsersKind = UsersKind{"non_guests"}
@@ -250,3 +255,22 @@ func NewUsersNewestFirstArgument(first bool) UsersArgument {
 func NewUsersLimitArgument(value int) UsersArgument {
 	return UsersArgument{argument{"limit", value}}
 }
+
+// The user's graphql fragment, a named selection of user's fields that is defined once per payload.
+type UsersFragment struct {
+	fragment Fragment
+}
+
+// NewUsersFragment returns the fragment with the given name of the user's fields.
+func NewUsersFragment(name string, usersFields []UsersField) UsersFragment {
+	var fields []field
+	for _, f := range usersFields {
+		fields = append(fields, f.field)
+	}
+	return UsersFragment{Fragment{name: name, on: "User", fields: fields}}
+}
+
+// Spreads the fragment of the user's fields.
+func UsersFragmentField(fragment UsersFragment) UsersField {
+	return UsersField{fragment.fragment.spread()}
+}
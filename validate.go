@@ -0,0 +1,304 @@
+package monday
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/di-wu/monday/introspection"
+)
+
+// ValidationError is a problem found in a query or mutation of a payload.
+type ValidationError struct {
+	// Path is the path of the field with the problem, e.g. boards.items.column_values.
+	Path    string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// ValidationErrors are all the problems found in a payload.
+type ValidationErrors []ValidationError
+
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Validate checks the queries and mutations of the payload against the schema of the api, before sending them.
+// It reports unknown fields and arguments, arguments of the wrong type, missing required arguments
+// and selection sets that are missing on objects or given for scalars.
+// The problems are returned as ValidationErrors. Raw documents are not validated.
+//
+// The schema of the api is bundled with the api package, see api.Schema.
+func (p Payload) Validate(schema *introspection.Schema) error {
+	v := validator{schema: schema}
+	if len(p.queries) != 0 {
+		root := schema.Query()
+		if root == nil {
+			return ValidationErrors{{"query", "the schema has no query type"}}
+		}
+		for _, q := range p.queries {
+			v.field(root, "", q.name, q.fields, q.args)
+		}
+	}
+	if len(p.mutations) != 0 {
+		root := schema.Mutation()
+		if root == nil {
+			return ValidationErrors{{"mutation", "the schema has no mutation type"}}
+		}
+		for _, m := range p.mutations {
+			v.field(root, "", m.name, m.fields, m.args)
+		}
+	}
+	if len(v.errs) != 0 {
+		return v.errs
+	}
+	return nil
+}
+
+type validator struct {
+	schema *introspection.Schema
+	errs   ValidationErrors
+	// spreading are the fragments that are being validated, to detect cycles.
+	spreading map[string]bool
+}
+
+func (v *validator) errorf(path, format string, args ...interface{}) {
+	v.errs = append(v.errs, ValidationError{path, fmt.Sprintf(format, args...)})
+}
+
+// field validates the selection of the field with the given name of the parent type.
+func (v *validator) field(parent *introspection.Type, path, name string, fields []field, args []argument) {
+	if path != "" {
+		path += "."
+	}
+	path += name
+	if name == "__typename" {
+		return
+	}
+	f := parent.Field(name)
+	if f == nil {
+		v.errorf(path, "unknown field %q on type %s", name, parent.Name)
+		return
+	}
+	v.arguments(path, f, args)
+
+	typ := v.schema.Type(f.Type.Named().Name)
+	if typ == nil {
+		v.errorf(path, "unknown type %s", f.Type.Named().Name)
+		return
+	}
+	switch typ.Kind {
+	case introspection.KindObject, introspection.KindInterface:
+		if len(fields) == 0 {
+			v.errorf(path, "missing selection set on type %s", typ.Name)
+		}
+		v.selection(typ, path, fields)
+	case introspection.KindUnion:
+		if len(fields) == 0 {
+			v.errorf(path, "missing selection set on type %s", typ.Name)
+		}
+		v.selection(typ, path, fields)
+	default:
+		if len(fields) != 0 {
+			v.errorf(path, "selection set on %s %s", strings.ToLower(string(typ.Kind)), typ.Name)
+		}
+	}
+}
+
+// selection validates the fields of a selection on the given type.
+func (v *validator) selection(typ *introspection.Type, path string, fields []field) {
+	for _, sub := range fields {
+		switch {
+		case sub.value == nil:
+			v.field(typ, path, sub.field, nil, nil)
+		case sub.value.fragment != nil:
+			v.fragment(typ, path, sub.value.fragment)
+		default:
+			v.field(typ, path, sub.value.name, sub.value.fields, sub.value.args)
+		}
+	}
+}
+
+// fragment validates a fragment that is spread into a selection on the given type.
+func (v *validator) fragment(typ *introspection.Type, path string, f *Fragment) {
+	on := typ
+	if f.on != typ.Name {
+		on = nil
+		for _, possible := range typ.PossibleTypes {
+			if possible.Name == f.on {
+				on = v.schema.Type(f.on)
+			}
+		}
+	}
+	if on == nil && f.inline() {
+		v.errorf(path, "inline fragment on %s can not be spread on type %s", f.on, typ.Name)
+		return
+	}
+	if on == nil {
+		v.errorf(path, "fragment %s on %s can not be spread on type %s", f.name, f.on, typ.Name)
+		return
+	}
+	if f.inline() {
+		v.selection(on, path, f.fields)
+		return
+	}
+	if v.spreading[f.name] {
+		v.errorf(path, "fragment %s spreads itself", f.name)
+		return
+	}
+	if v.spreading == nil {
+		v.spreading = make(map[string]bool)
+	}
+	v.spreading[f.name] = true
+	v.selection(on, path, f.fields)
+	delete(v.spreading, f.name)
+}
+
+func (v *validator) arguments(path string, f *introspection.Field, args []argument) {
+	given := make(map[string]bool)
+	for _, a := range args {
+		given[a.argument] = true
+		arg := f.Arg(a.argument)
+		if arg == nil {
+			v.errorf(path, "unknown argument %q", a.argument)
+			continue
+		}
+		if msg := v.value(arg.Type, reflect.ValueOf(a.value)); msg != "" {
+			v.errorf(path, "argument %q: %s", a.argument, msg)
+		}
+	}
+	for _, arg := range f.Args {
+		if arg.Type.NonNull() && arg.DefaultValue == nil && !given[arg.Name] {
+			v.errorf(path, "missing required argument %q of type %s", arg.Name, arg.Type)
+		}
+	}
+}
+
+// value checks whether the value can be used as the given input type, it returns a description of the problem.
+func (v *validator) value(t introspection.TypeRef, value reflect.Value) string {
+	if !value.IsValid() || ((value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface) && value.IsNil()) {
+		if t.NonNull() {
+			return fmt.Sprintf("expected %s, got null", t)
+		}
+		return ""
+	}
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		value = value.Elem()
+	}
+	if _, ok := value.Interface().(InputValue); ok {
+		// values that encode themselves can not be checked.
+		return ""
+	}
+	switch t.Kind {
+	case introspection.KindNonNull:
+		return v.value(t.Elem(), value)
+	case introspection.KindList:
+		if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+			// input coercion accepts a single value for a list.
+			return v.value(t.Elem(), value)
+		}
+		for i := 0; i < value.Len(); i++ {
+			if msg := v.value(t.Elem(), value.Index(i)); msg != "" {
+				return fmt.Sprintf("element %d: %s", i, msg)
+			}
+		}
+		return ""
+	}
+
+	typ := v.schema.Type(t.Name)
+	if typ == nil {
+		return fmt.Sprintf("unknown type %s", t.Name)
+	}
+	got := value.Type().String()
+	switch typ.Kind {
+	case introspection.KindScalar:
+		if !scalarValue(typ.Name, value) {
+			return fmt.Sprintf("expected %s, got %s", typ.Name, got)
+		}
+	case introspection.KindEnum:
+		name, ok := enumValue(value)
+		if !ok {
+			return fmt.Sprintf("expected enum %s, got %s", typ.Name, got)
+		}
+		for _, e := range typ.EnumValues {
+			if e.Name == name {
+				return ""
+			}
+		}
+		return fmt.Sprintf("%q is not a value of enum %s", name, typ.Name)
+	case introspection.KindInputObject:
+		// structs are encoded with the names of their json tags, like encodeReflectValue does.
+		var given []structField
+		switch {
+		case value.Kind() == reflect.Map && value.Type().Key().Kind() == reflect.String:
+			for _, key := range value.MapKeys() {
+				given = append(given, structField{key.String(), value.MapIndex(key)})
+			}
+		case value.Kind() == reflect.Struct:
+			given = structFields(value)
+		default:
+			return fmt.Sprintf("expected input object %s, got %s", typ.Name, got)
+		}
+		fields := make(map[string]introspection.InputValue)
+		for _, f := range typ.InputFields {
+			fields[f.Name] = f
+		}
+		present := make(map[string]bool)
+		for _, g := range given {
+			f, ok := fields[g.name]
+			if !ok {
+				return fmt.Sprintf("unknown field %q of input object %s", g.name, typ.Name)
+			}
+			if msg := v.value(f.Type, g.value); msg != "" {
+				return fmt.Sprintf("field %q: %s", g.name, msg)
+			}
+			present[g.name] = true
+		}
+		for _, f := range typ.InputFields {
+			if f.Type.NonNull() && f.DefaultValue == nil && !present[f.Name] {
+				return fmt.Sprintf("missing field %q of input object %s", f.Name, typ.Name)
+			}
+		}
+	default:
+		return fmt.Sprintf("%s is not an input type", typ.Name)
+	}
+	return ""
+}
+
+// scalarValue reports whether the value can be used for the scalar with the given name.
+func scalarValue(name string, value reflect.Value) bool {
+	if _, ok := enumValue(value); ok {
+		return false
+	}
+	kind := value.Kind()
+	switch name {
+	case "Int":
+		return isInt(kind)
+	case "Float":
+		return isInt(kind) || kind == reflect.Float32 || kind == reflect.Float64
+	case "Boolean":
+		return kind == reflect.Bool
+	case "ID":
+		return isInt(kind) || kind == reflect.String
+	case "String":
+		return kind == reflect.String
+	}
+	// custom scalars like JSON and dates are passed as strings or numbers.
+	return kind == reflect.String || isInt(kind) || kind == reflect.Float64
+}
+
+func isInt(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return false
+}
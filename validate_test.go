@@ -0,0 +1,143 @@
+package monday
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/di-wu/monday/introspection"
+)
+
+func loadSchema(t *testing.T) *introspection.Schema {
+	t.Helper()
+	f, err := os.Open("api/schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	schema, err := introspection.Parse(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return schema
+}
+
+// columnMapping is the ColumnMappingInput input object, its fields are named by their json tags.
+type columnMapping struct {
+	Source string `json:"source,omitempty"`
+	Target string `json:"target,omitempty"`
+}
+
+func TestValidate(t *testing.T) {
+	schema := loadSchema(t)
+	for _, payload := range []Payload{
+		NewQueryPayload(Boards.List(
+			[]BoardsField{
+				BoardsNameField(),
+				NewBoardsItemsFields([]ItemsField{ItemsIDField()}, []ItemsArgument{NewItemsLimitArgument(5)}),
+			},
+			NewBoardsIDsArgument([]int{1, 2}),
+		)),
+		NewMutationPayload(Boards.Create("name", BoardsKindPublic(), nil)),
+		NewMutationPayload(Boards.Update(1, BoardsAttributeDescription(), "description")),
+		NewQueryPayload(NewQuery("boards", []Field{NewField("__typename", nil)}, nil)),
+	} {
+		if err := payload.Validate(schema); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+
+	for _, test := range []struct {
+		payload Payload
+		errors  []string
+	}{
+		{
+			NewQueryPayload(NewQuery("boards", []Field{NewField("nope", nil)}, nil)),
+			[]string{`boards.nope: unknown field "nope" on type Board`},
+		},
+		{
+			NewQueryPayload(NewQuery("nope", []Field{NewField("id", nil)}, nil)),
+			[]string{`nope: unknown field "nope" on type Query`},
+		},
+		{
+			NewQueryPayload(NewQuery("boards", []Field{NewField("id", nil)}, []Argument{
+				NewArgument("limit", "ten"),
+				NewArgument("ids", []string{"1"}),
+				NewArgument("board_kind", "public"),
+				NewArgument("order", 1),
+			})),
+			[]string{
+				`boards: argument "limit": expected Int, got string`,
+				`boards: argument "ids": element 0: expected Int, got string`,
+				`boards: argument "board_kind": expected enum BoardKind, got string`,
+				`boards: unknown argument "order"`,
+			},
+		},
+		{
+			NewMutationPayload(NewMutation("create_board", []Field{NewField("id", nil)}, []Argument{
+				NewArgument("board_name", "name"),
+			})),
+			[]string{`create_board: missing required argument "board_kind" of type BoardKind!`},
+		},
+		{
+			NewQueryPayload(NewQuery("boards", []Field{
+				NewObjectField("name", []Field{NewField("id", nil)}, nil),
+				NewField("owner", nil),
+			}, nil)),
+			[]string{
+				"boards.name: selection set on scalar String",
+				"boards.owner: missing selection set on type User",
+			},
+		},
+		{
+			NewMutationPayload(Groups.Update(1, "topics", GroupsAttributeColor(), "red", nil)),
+			nil,
+		},
+		{
+			NewMutationPayload(NewMutation("move_item_to_board", []Field{NewField("id", nil)}, []Argument{
+				NewArgument("board_id", 1),
+				NewArgument("group_id", "topics"),
+				NewArgument("item_id", 10),
+				NewArgument("columns_mapping", []columnMapping{{Source: "text", Target: "notes"}, {Source: "status"}}),
+				NewArgument("subitems_columns_mapping", []map[string]interface{}{{"source": "text", "target": "notes"}}),
+			})),
+			nil,
+		},
+		{
+			NewMutationPayload(NewMutation("move_item_to_board", []Field{NewField("id", nil)}, []Argument{
+				NewArgument("board_id", 1),
+				NewArgument("group_id", "topics"),
+				NewArgument("item_id", 10),
+				NewArgument("columns_mapping", []columnMapping{{Target: "notes"}}),
+				NewArgument("subitems_columns_mapping", []struct {
+					Source string `json:"source"`
+					Column string
+				}{{"text", "notes"}}),
+			})),
+			[]string{
+				`move_item_to_board: argument "columns_mapping": element 0: missing field "source" of input object ColumnMappingInput`,
+				`move_item_to_board: argument "subitems_columns_mapping": element 0: unknown field "Column" of input object ColumnMappingInput`,
+			},
+		},
+	} {
+		err := test.payload.Validate(schema)
+		if len(test.errors) == 0 {
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			continue
+		}
+		errs, ok := err.(ValidationErrors)
+		if !ok {
+			t.Errorf("expected validation errors, got %v", err)
+			continue
+		}
+		var got []string
+		for _, e := range errs {
+			got = append(got, e.Error())
+		}
+		if strings.Join(got, "\n") != strings.Join(test.errors, "\n") {
+			t.Errorf("got errors:\n%s\nexpected:\n%s", strings.Join(got, "\n"), strings.Join(test.errors, "\n"))
+		}
+	}
+}
@@ -11,7 +11,7 @@ type WebhooksService service
 // After the mutation runs, the webhook will report the wanted event on the specific board given to the wanted URL.
 // - id: the board's unique identifier.
 // - url: the webhook URL.
-// - event: the event to listen to (change_column_value / create_item / create_update).
+// - event: the event to listen to (e.g. change_column_value / create_item / create_update).
 //
 // DOCS: https://monday.com/developers/v2#mutations-section-webhooks-create
 func (*WebhooksService) Create(id int, url string, event WebhookEventType, webhookFields []WebhookField) Mutation {
@@ -29,11 +29,25 @@ func (*WebhooksService) Create(id int, url string, event WebhookEventType, webho
 		args: []argument{
 			{"board_id", id},
 			{"url", url},
-			{"event", event.typ},
+			{"event", event},
 		},
 	}
 }
 
+// CreateWithConfig returns a mutation that allows you to create a new webhook with an event specific configuration.
+// Some events, like change_specific_column_value, require a config to know what to listen to.
+// - id: the board's unique identifier.
+// - url: the webhook URL.
+// - event: the event to listen to.
+// - config: the webhook's configuration in json format (e.g. {"columnId": "status"}).
+//
+// DOCS: https://monday.com/developers/v2#mutations-section-webhooks-create
+func (*WebhooksService) CreateWithConfig(id int, url string, event WebhookEventType, config string, webhookFields []WebhookField) Mutation {
+	webhook := Webhooks.Create(id, url, event, webhookFields)
+	webhook.args = append(webhook.args, argument{"config", config})
+	return webhook
+}
+
 // Delete returns a mutation that deletes a webhook.
 // After the mutation runs it will no longer report events to the URL given.
 // - id: the webhook's unique identifier.
@@ -57,6 +71,32 @@ func (*WebhooksService) Delete(id int, webhookFields []WebhookField) Mutation {
 	}
 }
 
+// List returns a query that gets the webhooks subscribed to a specific board.
+// - boardID: the board's unique identifier.
+//
+// DOCS: https://monday.com/developers/v2#queries-section-webhooks
+func (*WebhooksService) List(boardID int, webhookFields []WebhookField, webhookArgs ...WebhookArgument) Query {
+	if len(webhookFields) == 0 {
+		webhookFields = append(webhookFields, webhookIDField)
+	}
+
+	var fields []field
+	for _, wf := range webhookFields {
+		fields = append(fields, wf.field)
+	}
+	args := []argument{
+		{"board_id", boardID},
+	}
+	for _, wa := range webhookArgs {
+		args = append(args, wa.arg)
+	}
+	return Query{
+		name:   "webhooks",
+		fields: fields,
+		args:   args,
+	}
+}
+
 // The webhook's graphql field(s).
 type WebhookField struct {
 	field field
@@ -64,6 +104,8 @@ type WebhookField struct {
 
 var (
 	webhookBoardIDField = WebhookField{field{"board_id", nil}}
+	webhookConfigField  = WebhookField{field{"config", nil}}
+	webhookEventField   = WebhookField{field{"event", nil}}
 	webhookIDField      = WebhookField{field{"id", nil}}
 )
 
@@ -72,20 +114,65 @@ func WebhookBoardIDField() WebhookField {
 	return webhookBoardIDField
 }
 
+// The webhook's configuration in json format.
+func WebhookConfigField() WebhookField {
+	return webhookConfigField
+}
+
+// The event the webhook listens to.
+func WebhookEventField() WebhookField {
+	return webhookEventField
+}
+
 // The webhook's unique identifier.
 func WebhookIDField() WebhookField {
 	return webhookIDField
 }
 
+// The webhook's graphql argument(s).
+type WebhookArgument struct {
+	arg argument
+}
+
+// Only return the webhooks created by the app initiating the request.
+func NewWebhookAppWebhooksOnlyArgument(value bool) WebhookArgument {
+	return WebhookArgument{argument{"app_webhooks_only", value}}
+}
+
 // The webhook's target type.
 type WebhookEventType struct {
 	typ string
 }
 
+// EnumValue returns the value as it appears in a query.
+func (t WebhookEventType) EnumValue() string {
+	return t.typ
+}
+
+// String returns the event type as it is known by the Monday API (e.g. create_item).
+func (t WebhookEventType) String() string {
+	return t.typ
+}
+
 var (
-	webhookEventTypeChangeColumnValue = WebhookEventType{"change_column_value"}
-	webhookEventTypeCreateItem        = WebhookEventType{"create_item"}
-	webhookEventTypeCreateUpdate      = WebhookEventType{"create_update"}
+	webhookEventTypeChangeColumnValue         = WebhookEventType{"change_column_value"}
+	webhookEventTypeChangeName                = WebhookEventType{"change_name"}
+	webhookEventTypeChangeSpecificColumnValue = WebhookEventType{"change_specific_column_value"}
+	webhookEventTypeChangeStatusColumnValue   = WebhookEventType{"change_status_column_value"}
+	webhookEventTypeChangeSubitemColumnValue  = WebhookEventType{"change_subitem_column_value"}
+	webhookEventTypeChangeSubitemName         = WebhookEventType{"change_subitem_name"}
+	webhookEventTypeCreateColumn              = WebhookEventType{"create_column"}
+	webhookEventTypeCreateItem                = WebhookEventType{"create_item"}
+	webhookEventTypeCreateSubitem             = WebhookEventType{"create_subitem"}
+	webhookEventTypeCreateSubitemUpdate       = WebhookEventType{"create_subitem_update"}
+	webhookEventTypeCreateUpdate              = WebhookEventType{"create_update"}
+	webhookEventTypeItemArchived              = WebhookEventType{"item_archived"}
+	webhookEventTypeItemDeleted               = WebhookEventType{"item_deleted"}
+	webhookEventTypeItemMovedToAnyGroup       = WebhookEventType{"item_moved_to_any_group"}
+	webhookEventTypeItemRestored              = WebhookEventType{"item_restored"}
+	webhookEventTypeMoveItemToGroup           = WebhookEventType{"move_item_to_group"}
+	webhookEventTypeSubitemArchived           = WebhookEventType{"subitem_archived"}
+	webhookEventTypeSubitemDeleted            = WebhookEventType{"subitem_deleted"}
 )
 
 // Column value changed on board.
@@ -93,12 +180,87 @@ func WebhookEventTypeChangeColumnValue() WebhookEventType {
 	return webhookEventTypeChangeColumnValue
 }
 
+// An item name changed on board.
+func WebhookEventTypeChangeName() WebhookEventType {
+	return webhookEventTypeChangeName
+}
+
+// A specific column value changed on board, requires a config with the column id (e.g. {"columnId": "status"}).
+func WebhookEventTypeChangeSpecificColumnValue() WebhookEventType {
+	return webhookEventTypeChangeSpecificColumnValue
+}
+
+// A status column value changed on board, optionally configured with a column id and label index.
+func WebhookEventTypeChangeStatusColumnValue() WebhookEventType {
+	return webhookEventTypeChangeStatusColumnValue
+}
+
+// A subitem column value changed on board.
+func WebhookEventTypeChangeSubitemColumnValue() WebhookEventType {
+	return webhookEventTypeChangeSubitemColumnValue
+}
+
+// A subitem name changed on board.
+func WebhookEventTypeChangeSubitemName() WebhookEventType {
+	return webhookEventTypeChangeSubitemName
+}
+
+// A column was created on board.
+func WebhookEventTypeCreateColumn() WebhookEventType {
+	return webhookEventTypeCreateColumn
+}
+
 // An item was created on board.
 func WebhookEventTypeCreateItem() WebhookEventType {
 	return webhookEventTypeCreateItem
 }
 
+// A subitem was created on board.
+func WebhookEventTypeCreateSubitem() WebhookEventType {
+	return webhookEventTypeCreateSubitem
+}
+
+// An update was posted on a subitem.
+func WebhookEventTypeCreateSubitemUpdate() WebhookEventType {
+	return webhookEventTypeCreateSubitemUpdate
+}
+
 // An update was posted on board item.
 func WebhookEventTypeCreateUpdate() WebhookEventType {
 	return webhookEventTypeCreateUpdate
 }
+
+// An item was archived on board.
+func WebhookEventTypeItemArchived() WebhookEventType {
+	return webhookEventTypeItemArchived
+}
+
+// An item was deleted on board.
+func WebhookEventTypeItemDeleted() WebhookEventType {
+	return webhookEventTypeItemDeleted
+}
+
+// An item was moved to any group on board.
+func WebhookEventTypeItemMovedToAnyGroup() WebhookEventType {
+	return webhookEventTypeItemMovedToAnyGroup
+}
+
+// An item was restored on board.
+func WebhookEventTypeItemRestored() WebhookEventType {
+	return webhookEventTypeItemRestored
+}
+
+// An item was moved to a specific group, optionally configured with a group id (e.g. {"groupId": "topics"}).
+func WebhookEventTypeMoveItemToGroup() WebhookEventType {
+	return webhookEventTypeMoveItemToGroup
+}
+
+// A subitem was archived on board.
+func WebhookEventTypeSubitemArchived() WebhookEventType {
+	return webhookEventTypeSubitemArchived
+}
+
+// A subitem was deleted on board.
+func WebhookEventTypeSubitemDeleted() WebhookEventType {
+	return webhookEventTypeSubitemDeleted
+}
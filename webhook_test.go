@@ -0,0 +1,28 @@
+package monday
+
+import "testing"
+
+func TestWebhooks(t *testing.T) {
+	for _, test := range []struct {
+		stringer interface{ stringify() string }
+		str      string
+	}{
+		{
+			stringer: Webhooks.CreateWithConfig(1, "https://example.com", WebhookEventTypeChangeSpecificColumnValue(),
+				`{"columnId":"status"}`, nil),
+			str: `create_webhook(board_id:1,url:"https://example.com",event:change_specific_column_value,config:"{\"columnId\":\"status\"}"){id}`,
+		},
+		{
+			stringer: Webhooks.List(1, []WebhookField{
+				WebhookIDField(),
+				WebhookEventField(),
+				WebhookConfigField(),
+			}),
+			str: `webhooks(board_id:1){id event config}`,
+		},
+	} {
+		if str := test.stringer.stringify(); str != test.str {
+			t.Errorf("got: %s, expected: %s", str, test.str)
+		}
+	}
+}